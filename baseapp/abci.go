@@ -1,6 +1,9 @@
 package baseapp
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
@@ -10,6 +13,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -123,6 +128,10 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		app.deliverState.ctx = app.deliverState.ctx.
 			WithBlockHeader(req.Header).
 			WithBlockHeight(req.Header.Height)
+
+		if app.stateCollector != nil {
+			app.stateCollector.setBlock(req.Header.Height)
+		}
 	}
 
 	// add block gas meter
@@ -149,6 +158,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		app.deliverState.ms = app.deliverState.ms.SetTracingContext(nil).(sdk.CacheMultiStore)
 	}
 
+	if app.stateCollector != nil {
+		app.stateCollector.endTxs()
+	}
+
 	if app.endBlocker != nil {
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
@@ -162,6 +175,10 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 // internal CheckTx state if the AnteHandler passes. Otherwise, the ResponseCheckTx
 // will contain releveant error information. Regardless of tx execution outcome,
 // the ResponseCheckTx will contain relevant gas execution context.
+//
+// The priority of a passing tx, set by the AnteHandler, is returned in the
+// priority attribute of a tx event if it is not zero. Tendermint v0.33 does
+// not read it, so it does not change the order of the txs in a block.
 func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
@@ -181,17 +198,25 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	gInfo, result, err := app.runTx(mode, req.Tx, tx)
+	gInfo, result, priority, err := app.runTx(mode, req.Tx, tx)
 	if err != nil {
 		return sdkerrors.ResponseCheckTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
 
+	// ResponseCheckTx has no priority field, so the priority is returned as an event
+	events := result.Events
+	if priority != 0 {
+		events = append(events, sdk.Events{sdk.NewEvent(
+			sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyPriority, fmt.Sprintf("%d", priority)),
+		)}.ToABCIEvents()...)
+	}
+
 	return abci.ResponseCheckTx{
 		GasWanted: int64(gInfo.GasWanted), // TODO: Should type accept unsigned ints?
 		GasUsed:   int64(gInfo.GasUsed),   // TODO: Should type accept unsigned ints?
 		Log:       result.Log,
 		Data:      result.Data,
-		Events:    result.Events,
+		Events:    events,
 	}
 }
 
@@ -201,12 +226,22 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
 func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	if app.stateCollector != nil {
+		app.stateCollector.beginTx()
+	}
+
 	tx, err := app.txDecoder(req.Tx)
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, 0, 0)
 	}
 
-	gInfo, result, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	gInfo, result, _, err := app.runTx(runTxModeDeliver, req.Tx, tx)
+	return responseDeliverTx(gInfo, result, err)
+}
+
+// responseDeliverTx returns the ResponseDeliverTx of a tx executed in
+// DeliverTx mode.
+func responseDeliverTx(gInfo sdk.GasInfo, result *sdk.Result, err error) abci.ResponseDeliverTx {
 	if err != nil {
 		return sdkerrors.ResponseDeliverTx(err, gInfo.GasWanted, gInfo.GasUsed)
 	}
@@ -237,6 +272,12 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	if app.stateCollector != nil {
+		for _, err := range app.stateCollector.commit(header.Height) {
+			app.logger.Error("failed to stream state changes", "height", header.Height, "err", err)
+		}
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
@@ -256,6 +297,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 		halt = true
 	}
 
+	if app.snapshotManager != nil && app.snapshotInterval > 0 && uint64(header.Height)%app.snapshotInterval == 0 {
+		go app.snapshot(header.Height)
+	}
+
 	if halt {
 		// Halt the binary and allow Tendermint to receive the ResponseCommit
 		// response with the commit ID hash. This will allow the node to successfully
@@ -291,6 +336,88 @@ func (app *BaseApp) halt() {
 	os.Exit(0)
 }
 
+// ListSnapshots returns the state sync snapshots available from the node's
+// snapshot store, newest first. It mirrors the ABCI ListSnapshots call.
+//
+// NOTE: The state sync calls are not yet part of the ABCI interface of the
+// Tendermint version in use, hence they operate on the SDK snapshot types.
+func (app *BaseApp) ListSnapshots() ([]*snapshottypes.Snapshot, error) {
+	if app.snapshotManager == nil {
+		return []*snapshottypes.Snapshot{}, nil
+	}
+
+	return app.snapshotManager.List()
+}
+
+// LoadSnapshotChunk returns a chunk of a local snapshot, or nil if it does not
+// exist. It mirrors the ABCI LoadSnapshotChunk call.
+func (app *BaseApp) LoadSnapshotChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	if app.snapshotManager == nil {
+		return nil, nil
+	}
+
+	return app.snapshotManager.LoadChunk(height, format, chunk)
+}
+
+// OfferSnapshot begins restoring the given snapshot into the CommitMultiStore.
+// The appHash is the trusted application hash for the snapshot height, which
+// the restored state must reproduce. It mirrors the ABCI OfferSnapshot call.
+func (app *BaseApp) OfferSnapshot(snapshot *snapshottypes.Snapshot, appHash []byte) error {
+	if app.snapshotManager == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state sync is not enabled on this node")
+	}
+
+	if snapshot == nil {
+		return sdkerrors.Wrap(snapshottypes.ErrInvalidMetadata, "snapshot cannot be nil")
+	}
+
+	if len(appHash) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trusted app hash cannot be empty")
+	}
+
+	if err := app.snapshotManager.Restore(*snapshot); err != nil {
+		app.logger.Error("failed to restore snapshot", "height", snapshot.Height, "format", snapshot.Format, "err", err)
+		return err
+	}
+
+	app.snapshotAppHash = appHash
+	return nil
+}
+
+// ApplySnapshotChunk applies the next chunk of the snapshot being restored. It
+// returns true once the final chunk has been applied and the restored state has
+// been verified against the trusted app hash. It mirrors the ABCI
+// ApplySnapshotChunk call.
+func (app *BaseApp) ApplySnapshotChunk(chunk []byte) (bool, error) {
+	if app.snapshotManager == nil {
+		return false, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "state sync is not enabled on this node")
+	}
+
+	done, err := app.snapshotManager.RestoreChunk(chunk)
+	if err != nil {
+		app.logger.Error("failed to restore snapshot chunk", "err", err)
+		return false, err
+	}
+
+	if !done {
+		return false, nil
+	}
+
+	appHash := app.snapshotAppHash
+	app.snapshotAppHash = nil
+
+	lastCommitID := app.cms.LastCommitID()
+	if !bytes.Equal(lastCommitID.Hash, appHash) {
+		return false, sdkerrors.Wrapf(
+			snapshottypes.ErrAppHashMismatch, "expected %X, got %X (height %d)",
+			appHash, lastCommitID.Hash, lastCommitID.Version,
+		)
+	}
+
+	app.logger.Info("restored state snapshot", "height", lastCommitID.Version, "hash", fmt.Sprintf("%X", lastCommitID.Hash))
+	return true, nil
+}
+
 // Query implements the ABCI interface. It delegates to CommitMultiStore if it
 // implements Queryable.
 func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
@@ -324,7 +451,7 @@ func (app *BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
 }
 
 func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQuery) abci.ResponseQuery {
-	ctx, err := app.createQueryContext(req)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
@@ -339,13 +466,15 @@ func (app *BaseApp) handleQueryGRPC(handler GRPCQueryHandler, req abci.RequestQu
 	return res
 }
 
-func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, error) {
+// createQueryContext creates a new sdk.Context for a query, taking as args
+// the block height and whether the query needs a proof or not.
+func (app *BaseApp) createQueryContext(height int64, prove bool) (sdk.Context, error) {
 	// when a client did not provide a query height, manually inject the latest
-	if req.Height == 0 {
-		req.Height = app.LastBlockHeight()
+	if height == 0 {
+		height = app.LastBlockHeight()
 	}
 
-	if req.Height <= 1 && req.Prove {
+	if height <= 1 && prove {
 		return sdk.Context{},
 			sdkerrors.Wrap(
 				sdkerrors.ErrInvalidRequest,
@@ -353,19 +482,26 @@ func (app *BaseApp) createQueryContext(req abci.RequestQuery) (sdk.Context, erro
 			)
 	}
 
-	cacheMS, err := app.cms.CacheMultiStoreWithVersion(req.Height)
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if errors.Is(err, storetypes.ErrVersionPruned) {
+		// keep the error's ABCI code and earliest available height, so that the
+		// client can retry the query on a node retaining the height
+		return sdk.Context{},
+			sdkerrors.Wrapf(err, "failed to load state at height %d (latest height: %d)", height, app.LastBlockHeight())
+	}
+
 	if err != nil {
 		return sdk.Context{},
 			sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidRequest,
-				"failed to load state at height %d; %s (latest height: %d)", req.Height, err, app.LastBlockHeight(),
+				"failed to load state at height %d; %s (latest height: %d)", height, err, app.LastBlockHeight(),
 			)
 	}
 
 	// cache wrap the commit-multistore for safety
 	ctx := sdk.NewContext(
 		cacheMS, app.checkState.ctx.BlockHeader(), true, app.logger,
-	).WithMinGasPrices(app.minGasPrices)
+	).WithMinGasPrices(app.minGasPrices).WithKVGasConfigs(app.kvGasConfigs)
 
 	return ctx, nil
 }
@@ -409,6 +545,18 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 				Value:     []byte(app.appVersion),
 			}
 
+		case "pruning":
+			bz, err := json.Marshal(app.PruningInfo())
+			if err != nil {
+				return sdkerrors.QueryResult(sdkerrors.Wrap(err, "failed to JSON encode pruning info"))
+			}
+
+			return abci.ResponseQuery{
+				Codespace: sdkerrors.RootCodespace,
+				Height:    req.Height,
+				Value:     bz,
+			}
+
 		default:
 			return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query: %s", path))
 		}
@@ -417,7 +565,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) abci.Res
 	return sdkerrors.QueryResult(
 		sdkerrors.Wrap(
 			sdkerrors.ErrUnknownRequest,
-			"expected second parameter to be either 'simulate' or 'version', neither was present",
+			"expected second parameter to be either 'simulate', 'version' or 'pruning', neither was present",
 		),
 	)
 }
@@ -492,7 +640,7 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) abci.
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no custom querier found for route %s", path[1]))
 	}
 
-	ctx, err := app.createQueryContext(req)
+	ctx, err := app.createQueryContext(req.Height, req.Prove)
 	if err != nil {
 		return sdkerrors.QueryResult(err)
 	}
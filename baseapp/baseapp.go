@@ -13,6 +13,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -61,6 +62,18 @@ type BaseApp struct { // nolint: maligned
 	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
 	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
+	// manages snapshots, i.e. dumps of app state at certain intervals
+	snapshotManager    *snapshots.Manager
+	snapshotInterval   uint64 // block interval between state sync snapshots
+	snapshotKeepRecent uint32 // recent state sync snapshots to keep
+	snapshotAppHash    []byte // trusted app hash of the snapshot being restored
+
+	// collects the state changes of each block for the registered StateListeners
+	stateCollector *stateCollector
+
+	// gas configs of the KVStores of the given keys, overriding the default one
+	kvGasConfigs map[sdk.StoreKey]sdk.GasConfig
+
 	// volatile states:
 	//
 	// checkState is set on InitChain and reset on Commit
@@ -249,6 +262,24 @@ func (app *BaseApp) LastBlockHeight() int64 {
 	return app.cms.LastCommitID().Version
 }
 
+// PruningInfo returns the pruning strategy of the app's multistore and the
+// range of heights it retains, which can be queried with the "/app/pruning"
+// ABCI query.
+func (app *BaseApp) PruningInfo() store.PruningInfo {
+	pruning := app.cms.GetPruning()
+	info := store.PruningInfo{
+		KeepEvery:     pruning.KeepEvery,
+		SnapshotEvery: pruning.SnapshotEvery,
+		LatestVersion: app.LastBlockHeight(),
+	}
+
+	if versions := app.cms.AvailableVersions(); len(versions) > 0 {
+		info.EarliestVersion = versions[0]
+	}
+
+	return info
+}
+
 func (app *BaseApp) init() error {
 	if app.sealed {
 		panic("cannot call initFromMainStore: baseapp already sealed")
@@ -277,6 +308,33 @@ func (app *BaseApp) setInterBlockCache(cache sdk.MultiStorePersistentCache) {
 	app.interBlockCache = cache
 }
 
+// snapshot takes a state sync snapshot at the given height and prunes old
+// snapshots according to the configured retention. It is meant to be run
+// asynchronously after Commit.
+func (app *BaseApp) snapshot(height int64) {
+	app.logger.Info("creating state snapshot", "height", height)
+
+	snapshot, err := app.snapshotManager.Create(uint64(height))
+	if err != nil {
+		app.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+
+	app.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format)
+
+	if app.snapshotKeepRecent > 0 {
+		app.logger.Debug("pruning state snapshots")
+
+		pruned, err := app.snapshotManager.Prune(app.snapshotKeepRecent)
+		if err != nil {
+			app.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+
+		app.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -306,9 +364,13 @@ func (app *BaseApp) IsSealed() bool { return app.sealed }
 // on Commit.
 func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
+	ctx := sdk.NewContext(ms, header, true, app.logger).
+		WithMinGasPrices(app.minGasPrices).
+		WithKVGasConfigs(app.kvGasConfigs)
+
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.logger).WithMinGasPrices(app.minGasPrices),
+		ctx: ctx,
 	}
 }
 
@@ -318,9 +380,13 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 // Commit.
 func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
+	if app.stateCollector != nil {
+		app.stateCollector.listen(ms, header.Height)
+	}
+
 	app.deliverState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.logger),
+		ctx: sdk.NewContext(ms, header, false, app.logger).WithKVGasConfigs(app.kvGasConfigs),
 	}
 }
 
@@ -485,20 +551,28 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // if all messages get executed successfully and the execution mode is DeliverTx.
 // Note, gas execution info is always returned. A reference to a Result is
 // returned if the tx does not run out of gas and if all the messages are valid
-// and execute successfully. An error is returned otherwise.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, err error) {
+// and execute successfully. An error is returned otherwise. The priority of the
+// tx set by the AnteHandler is returned if the AnteHandler passes.
+func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext processes a transaction like runTx does, using the provided
+// Context instead of the one of the execution mode's state.
+func (app *BaseApp) runTxWithContext(
+	ctx sdk.Context, mode runTxMode, txBytes []byte, tx sdk.Tx,
+) (gInfo sdk.GasInfo, result *sdk.Result, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
 	if mode == runTxModeDeliver && ctx.BlockGasMeter().IsOutOfGas() {
 		gInfo = sdk.GasInfo{GasUsed: ctx.BlockGasMeter().GasConsumed()}
-		return gInfo, nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
+		return gInfo, nil, 0, sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx")
 	}
 
 	var startingGas uint64
@@ -534,7 +608,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 
 	msgs := tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
-		return sdk.GasInfo{}, nil, err
+		return sdk.GasInfo{}, nil, 0, err
 	}
 
 	var events sdk.Events
@@ -570,9 +644,10 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 
 		// GasMeter expected to be set in AnteHandler
 		gasWanted = ctx.GasMeter().Limit()
+		priority = ctx.Priority()
 
 		if err != nil {
-			return gInfo, nil, err
+			return gInfo, nil, 0, err
 		}
 
 		msCache.Write()
@@ -596,7 +671,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.
 		}
 	}
 
-	return gInfo, result, err
+	return gInfo, result, priority, err
 }
 
 // runMsgs iterates through a list of messages and executes them with the provided
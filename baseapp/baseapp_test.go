@@ -491,6 +491,23 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestSetKVGasConfig(t *testing.T) {
+	gasConfig := store.KVGasConfig()
+	gasConfig.ReadCostFlat = 10 * gasConfig.ReadCostFlat
+
+	app := setupBaseApp(t, SetKVGasConfig(capKey1, gasConfig))
+	app.InitChain(abci.RequestInitChain{})
+
+	for _, ctx := range []sdk.Context{app.checkState.ctx, app.deliverState.ctx} {
+		require.Equal(t, gasConfig, ctx.KVGasConfig(capKey1))
+		require.Equal(t, store.KVGasConfig(), ctx.KVGasConfig(capKey2))
+
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		ctx.KVStore(capKey1).Get([]byte("key"))
+		require.Equal(t, gasConfig.ReadCostFlat, ctx.GasMeter().GasConsumed())
+	}
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -869,6 +886,39 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithPriority(tx.(txTest).Counter), nil
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	for _, counter := range []int64{0, 1, 3} {
+		txBytes, err := codec.MarshalBinaryBare(newTxCounter(counter, 0))
+		require.NoError(t, err)
+
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+		// a zero priority is not returned
+		if counter == 0 {
+			require.Empty(t, res.Events)
+			continue
+		}
+
+		priorityEvent := sdk.Events{sdk.NewEvent(
+			sdk.EventTypeTx, sdk.NewAttribute(sdk.AttributeKeyPriority, fmt.Sprintf("%d", counter)),
+		)}.ToABCIEvents()
+		require.Equal(t, priorityEvent, res.Events)
+	}
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1596,6 +1646,49 @@ func TestGRPCQuery(t *testing.T) {
 	require.Equal(t, "Hello foo!", res.Greeting)
 }
 
+func TestQueryPrunedHeight(t *testing.T) {
+	grpcQueryOpt := func(bapp *BaseApp) {
+		testdata.RegisterTestServiceServer(
+			bapp.GRPCQueryRouter(),
+			testdata.TestServiceImpl{},
+		)
+	}
+
+	app := setupBaseApp(t, grpcQueryOpt, SetPruning(store.PruneEverything))
+
+	app.InitChain(abci.RequestInitChain{})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.Commit()
+	}
+
+	req := testdata.SayHelloRequest{Name: "foo"}
+	reqBz, err := req.Marshal()
+	require.NoError(t, err)
+
+	// only the latest height is retained
+	for _, query := range []abci.RequestQuery{
+		{Path: "/cosmos_sdk.codec.v1.TestService/SayHello", Data: reqBz, Height: 1},
+		{Path: "/store/key1/key", Data: []byte("hello"), Height: 2},
+	} {
+		res := app.Query(query)
+		require.Equal(t, store.StoreCodespace, res.Codespace, res)
+		require.Equal(t, store.ErrVersionPruned.ABCICode(), res.Code, res)
+		require.Contains(t, res.Log, "earliest available version: 3")
+	}
+
+	res := app.Query(abci.RequestQuery{Path: "/cosmos_sdk.codec.v1.TestService/SayHello", Data: reqBz, Height: 3})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res)
+
+	// the pruning settings are reported along with the retained heights
+	res = app.Query(abci.RequestQuery{Path: "/app/pruning"})
+	require.Equal(t, abci.CodeTypeOK, res.Code, res)
+
+	var info store.PruningInfo
+	require.NoError(t, json.Unmarshal(res.Value, &info))
+	require.Equal(t, store.PruningInfo{KeepEvery: 1, SnapshotEvery: 0, EarliestVersion: 3, LatestVersion: 3}, info)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -0,0 +1,104 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+)
+
+// RegisterGRPCServer registers gRPC services directly with the gRPC server.
+// Every query is executed against a query context of the latest committed
+// height, or of the height provided in the GRPCBlockHeightHeader request
+// header. The height that was queried is returned in the same header.
+func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
+	// Define an interceptor for all gRPC queries: this interceptor will create
+	// a new sdk.Context, and pass it into the query handler.
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		height, err := heightFromIncomingContext(grpcCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		if height == 0 {
+			height = app.LastBlockHeight()
+		}
+
+		// proofs are not supported through gRPC yet
+		sdkCtx, err := app.createQueryContext(height, false)
+		if errors.Is(err, storetypes.ErrVersionPruned) {
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		}
+
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		md := metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		if err := grpc.SetHeader(grpcCtx, md); err != nil {
+			return nil, err
+		}
+
+		return handler(sdk.WrapSDKContext(sdkCtx), req)
+	}
+
+	// Loop through all services and methods, add the interceptor, and register
+	// the service.
+	for _, data := range app.grpcQueryRouter.serviceData {
+		desc := data.serviceDesc
+		newMethods := make([]grpc.MethodDesc, len(desc.Methods))
+
+		for i, method := range desc.Methods {
+			methodHandler := method.Handler
+			newMethods[i] = grpc.MethodDesc{
+				MethodName: method.MethodName,
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					return methodHandler(srv, ctx, dec, interceptor)
+				},
+			}
+		}
+
+		newDesc := &grpc.ServiceDesc{
+			ServiceName: desc.ServiceName,
+			HandlerType: desc.HandlerType,
+			Methods:     newMethods,
+			Streams:     desc.Streams,
+			Metadata:    desc.Metadata,
+		}
+
+		server.RegisterService(newDesc, data.handler)
+	}
+}
+
+// heightFromIncomingContext returns the block height requested through the
+// GRPCBlockHeightHeader header of an incoming gRPC request, or 0 if none was
+// provided.
+func heightFromIncomingContext(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heightHeaders) == 0 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+	if err != nil || height < 0 {
+		return 0, status.Errorf(
+			codes.InvalidArgument, "invalid %s header: %q", grpctypes.GRPCBlockHeightHeader, heightHeaders[0],
+		)
+	}
+
+	return height, nil
+}
@@ -7,27 +7,31 @@ import (
 )
 
 func (app *BaseApp) Check(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeCheck, nil, tx)
+	gInfo, result, _, err := app.runTx(runTxModeCheck, nil, tx)
+	return gInfo, result, err
 }
 
 func (app *BaseApp) Simulate(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeSimulate, txBytes, tx)
+	gInfo, result, _, err := app.runTx(runTxModeSimulate, txBytes, tx)
+	return gInfo, result, err
 }
 
 func (app *BaseApp) Deliver(tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
-	return app.runTx(runTxModeDeliver, nil, tx)
+	gInfo, result, _, err := app.runTx(runTxModeDeliver, nil, tx)
+	return gInfo, result, err
 }
 
 // Context with current {check, deliver}State of the app used by tests.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
 		return sdk.NewContext(app.checkState.ms, header, true, app.logger).
-			WithMinGasPrices(app.minGasPrices)
+			WithMinGasPrices(app.minGasPrices).
+			WithKVGasConfigs(app.kvGasConfigs)
 	}
 
-	return sdk.NewContext(app.deliverState.ms, header, false, app.logger)
+	return sdk.NewContext(app.deliverState.ms, header, false, app.logger).WithKVGasConfigs(app.kvGasConfigs)
 }
 
 func (app *BaseApp) NewUncachedContext(isCheckTx bool, header abci.Header) sdk.Context {
-	return sdk.NewContext(app.cms, header, isCheckTx, app.logger)
+	return sdk.NewContext(app.cms, header, isCheckTx, app.logger).WithKVGasConfigs(app.kvGasConfigs)
 }
@@ -6,6 +6,7 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -44,6 +45,32 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetSnapshotStore sets the snapshot store.
+func SetSnapshotStore(snapshotStore *snapshots.Store) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotStore(snapshotStore) }
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func SetSnapshotInterval(interval uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
+}
+
+// SetSnapshotKeepRecent sets the recent snapshots to keep.
+func SetSnapshotKeepRecent(keepRecent uint32) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetSnapshotKeepRecent(keepRecent) }
+}
+
+// SetStateListeners sets the StateListeners notified of the state changes made
+// to the given stores by each block.
+func SetStateListeners(keys []sdk.StoreKey, listeners ...StateListener) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateListeners(keys, listeners...) }
+}
+
+// SetKVGasConfig sets the gas config of the KVStore of the given key.
+func SetKVGasConfig(key sdk.StoreKey, config sdk.GasConfig) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetKVGasConfig(key, config) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -164,3 +191,58 @@ func (app *BaseApp) SetRouter(router sdk.Router) {
 	}
 	app.router = router
 }
+
+// SetSnapshotStore sets the snapshot store.
+func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
+	if app.sealed {
+		panic("SetSnapshotStore() on sealed BaseApp")
+	}
+	if snapshotStore == nil {
+		app.snapshotManager = nil
+		return
+	}
+	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
+}
+
+// SetSnapshotInterval sets the snapshot interval.
+func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
+	if app.sealed {
+		panic("SetSnapshotInterval() on sealed BaseApp")
+	}
+	app.snapshotInterval = snapshotInterval
+}
+
+// SetSnapshotKeepRecent sets the number of recent snapshots to keep.
+func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
+	if app.sealed {
+		panic("SetSnapshotKeepRecent() on sealed BaseApp")
+	}
+	app.snapshotKeepRecent = snapshotKeepRecent
+}
+
+// SetStateListeners sets the StateListeners notified of the state changes made
+// to the given stores by each block. Any previously set listeners are replaced.
+func (app *BaseApp) SetStateListeners(keys []sdk.StoreKey, listeners ...StateListener) {
+	if app.sealed {
+		panic("SetStateListeners() on sealed BaseApp")
+	}
+	if len(keys) == 0 || len(listeners) == 0 {
+		app.stateCollector = nil
+		return
+	}
+	app.stateCollector = newStateCollector(keys, listeners)
+}
+
+// SetKVGasConfig sets the gas config of the KVStore of the given key, which
+// the contexts of the app use instead of the default KVGasConfig. This allows
+// to price the accesses to the stores of some modules differently.
+func (app *BaseApp) SetKVGasConfig(key sdk.StoreKey, config sdk.GasConfig) {
+	if app.sealed {
+		panic("SetKVGasConfig() on sealed BaseApp")
+	}
+
+	if app.kvGasConfigs == nil {
+		app.kvGasConfigs = make(map[sdk.StoreKey]sdk.GasConfig)
+	}
+	app.kvGasConfigs[key] = config
+}
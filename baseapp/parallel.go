@@ -0,0 +1,194 @@
+package baseapp
+
+import (
+	"math"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelTx holds the outcome of the optimistic execution of a tx on its own
+// branch of the deliver state.
+type parallelTx struct {
+	tx        sdk.Tx
+	decodeErr error
+
+	branch   sdk.CacheMultiStore
+	trackers map[sdk.StoreKey]*accesskv.Store
+	blockGas uint64
+
+	gInfo  sdk.GasInfo
+	result *sdk.Result
+	err    error
+}
+
+// DeliverTxs executes the txs of a block and returns their responses in the
+// same order. It is equivalent to calling DeliverTx for each of the txs in
+// order, and results in the same state.
+//
+// The txs are first executed optimistically on the given number of workers,
+// each one on its own branch of the deliver state recording the keys it reads
+// and writes. The branches are then written in block order, and a tx which read
+// a key written by a preceding tx of the block is executed again on the updated
+// state instead. Txs exceeding the block gas limit are executed again as well,
+// so that they fail exactly as they would with DeliverTx.
+//
+// DeliverTxs is not used by the ABCI methods, as Tendermint delivers the txs of
+// a block one at a time through DeliverTx. It is a helper for tests and
+// benchmarks executing whole blocks. The txs are executed sequentially with
+// less than 2 workers, when the app has no AnteHandler, when tracing is enabled
+// or when StateListeners are set.
+//
+// CONTRACT: the AnteHandler and the message handlers must only keep state in
+// the multi-store, as a tx may be executed more than once, and the keepers they
+// use must be safe for concurrent use. For instance, the x/staking validator
+// cache is not.
+func (app *BaseApp) DeliverTxs(reqs []abci.RequestDeliverTx, workers int) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+
+	cms, ok := app.deliverState.ms.(cachemulti.Store)
+	if !ok || workers < 2 || app.anteHandler == nil ||
+		app.stateCollector != nil || cms.TracingEnabled() {
+		for i, req := range reqs {
+			res[i] = app.DeliverTx(req)
+		}
+
+		return res
+	}
+
+	txs := app.executeTxsOptimistically(cms, reqs, workers)
+
+	// store keys written by the txs already applied to the deliver state
+	written := make(map[sdk.StoreKey]map[string]struct{})
+
+	for i, ptx := range txs {
+		if ptx.decodeErr != nil {
+			res[i] = sdkerrors.ResponseDeliverTx(ptx.decodeErr, 0, 0)
+			continue
+		}
+
+		if app.mustReExecute(ptx, written) {
+			ptx.branch, ptx.trackers = cms.CacheMultiStoreWithAccessTracking()
+			ctx := app.deliverState.ctx.WithMultiStore(ptx.branch)
+
+			ptx.gInfo, ptx.result, _, ptx.err = app.runTxWithContext(
+				app.parallelTxContext(ctx, reqs[i].Tx), runTxModeDeliver, reqs[i].Tx, ptx.tx,
+			)
+		} else {
+			app.deliverState.ctx.BlockGasMeter().ConsumeGas(ptx.blockGas, "block gas meter")
+		}
+
+		ptx.branch.Write()
+
+		for key, tracker := range ptx.trackers {
+			writes := tracker.Writes()
+			if len(writes) == 0 {
+				continue
+			}
+
+			if written[key] == nil {
+				written[key] = make(map[string]struct{}, len(writes))
+			}
+
+			for k := range writes {
+				written[key][k] = struct{}{}
+			}
+		}
+
+		res[i] = responseDeliverTx(ptx.gInfo, ptx.result, ptx.err)
+	}
+
+	return res
+}
+
+// executeTxsOptimistically decodes and executes the txs on the given number of
+// workers. Each tx is executed on its own tracked branch of the
+// deliver state, with its own block gas meter.
+func (app *BaseApp) executeTxsOptimistically(cms cachemulti.Store, reqs []abci.RequestDeliverTx, workers int) []*parallelTx {
+	txs := make([]*parallelTx, len(reqs))
+	indexes := make(chan int, len(reqs))
+
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				txBytes := reqs[i].Tx
+
+				tx, err := app.txDecoder(txBytes)
+				if err != nil {
+					txs[i] = &parallelTx{decodeErr: err}
+					continue
+				}
+
+				branch, trackers := cms.CacheMultiStoreWithAccessTracking()
+				blockGasMeter := sdk.NewInfiniteGasMeter()
+				ctx := app.deliverState.ctx.
+					WithMultiStore(branch).
+					WithBlockGasMeter(blockGasMeter)
+
+				ptx := &parallelTx{tx: tx, branch: branch, trackers: trackers}
+				ptx.gInfo, ptx.result, _, ptx.err = app.runTxWithContext(
+					app.parallelTxContext(ctx, txBytes), runTxModeDeliver, txBytes, tx,
+				)
+				ptx.blockGas = blockGasMeter.GasConsumed()
+
+				txs[i] = ptx
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return txs
+}
+
+// parallelTxContext returns the context for a tx like getContextForTx does,
+// based off of the provided deliver state context with its own gas meter and
+// event manager.
+func (app *BaseApp) parallelTxContext(ctx sdk.Context, txBytes []byte) sdk.Context {
+	ctx = ctx.
+		WithGasMeter(sdk.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager()).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos)
+
+	return ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+}
+
+// mustReExecute returns true if the optimistic execution of the tx is not
+// valid anymore, i.e. if it read a key written by a preceding tx or if it
+// would not fit in the block gas limit.
+func (app *BaseApp) mustReExecute(ptx *parallelTx, written map[sdk.StoreKey]map[string]struct{}) bool {
+	for key, tracker := range ptx.trackers {
+		if keys, ok := written[key]; ok && tracker.ReadsAny(keys) {
+			return true
+		}
+	}
+
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	if blockGasMeter.IsOutOfGas() {
+		return true
+	}
+
+	consumed := blockGasMeter.GasConsumed()
+	if ptx.blockGas > math.MaxUint64-consumed {
+		return true
+	}
+
+	limit := blockGasMeter.Limit()
+	return limit > 0 && consumed+ptx.blockGas > limit
+}
@@ -0,0 +1,117 @@
+package baseapp
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setupParallelTestApp returns a BaseApp whose txs write a key of their own in
+// the AnteHandler and increment a counter shared with other txs in the message
+// handler, so that txs of a block conflict with each other.
+func setupParallelTestApp(t *testing.T, maxGas int64) *BaseApp {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+			txTest := tx.(txTest)
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(10000))
+
+			if txTest.FailOnAnte {
+				return newCtx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			store := newCtx.KVStore(capKey1)
+			setIntOnStore(store, []byte(fmt.Sprintf("ante-%d", txTest.Counter)), txTest.Counter)
+			newCtx.EventManager().EmitEvents(counterEvent("ante_handler", txTest.Counter))
+
+			return newCtx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(*msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			store := ctx.KVStore(capKey2)
+			key := []byte(fmt.Sprintf("shared-%d", m.Counter))
+			count := getIntFromStore(store, key) + 1
+			setIntOnStore(store, key, count)
+
+			return &sdk.Result{Data: []byte(fmt.Sprintf("%d", count))}, nil
+		})
+		bapp.Router().AddRoute(r)
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{
+				MaxGas: maxGas,
+			},
+		},
+	})
+
+	return app
+}
+
+func TestDeliverTxs(t *testing.T) {
+	cdc := codec.New()
+	registerTestCodec(cdc)
+
+	testCases := []struct {
+		name   string
+		maxGas int64
+	}{
+		{"no block gas limit", 0},
+		{"block gas limit", 100000},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			seqApp := setupParallelTestApp(t, tc.maxGas)
+			parApp := setupParallelTestApp(t, tc.maxGas)
+
+			for height := int64(1); height <= 3; height++ {
+				reqs := []abci.RequestDeliverTx{{Tx: []byte("invalid")}}
+				for i := int64(0); i < 20; i++ {
+					counter := height*100 + i
+					tx := newTxCounter(counter, i%3, i%5)
+					tx.setFailOnAnte(i%7 == 6)
+					if i%8 == 7 {
+						tx.setFailOnHandler(true)
+					}
+
+					txBytes, err := cdc.MarshalBinaryBare(tx)
+					require.NoError(t, err)
+					reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+				}
+
+				header := abci.Header{Height: height}
+				seqApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+				parApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+				seqRes := make([]abci.ResponseDeliverTx, len(reqs))
+				for i, req := range reqs {
+					seqRes[i] = seqApp.DeliverTx(req)
+				}
+				parRes := parApp.DeliverTxs(reqs, 4)
+				require.Equal(t, seqRes, parRes)
+
+				seqApp.EndBlock(abci.RequestEndBlock{})
+				parApp.EndBlock(abci.RequestEndBlock{})
+
+				require.Equal(t, seqApp.Commit(), parApp.Commit())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package baseapp
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StateListener receives the state changes made by each block. Listeners are
+// registered on a BaseApp with SetStateListeners.
+type StateListener interface {
+	// ListenCommit is called on Commit with the Sets and Deletes made to the
+	// listened stores by the block, in the order they were applied. Changes made
+	// by failed transactions are not included.
+	ListenCommit(height int64, changes []*storetypes.StoreKVPair) error
+}
+
+// stateCollector is a WriteListener attached to the DeliverTx state. It
+// buffers the writes made by the current block, tagging each one with the block
+// height and the index of the transaction that made it, and hands them over to
+// the StateListeners on Commit.
+type stateCollector struct {
+	keys      []sdk.StoreKey
+	listeners []StateListener
+
+	height  int64
+	txIndex int64
+	changes []*storetypes.StoreKVPair
+}
+
+var _ storetypes.WriteListener = (*stateCollector)(nil)
+
+func newStateCollector(keys []sdk.StoreKey, listeners []StateListener) *stateCollector {
+	return &stateCollector{
+		keys:      keys,
+		listeners: listeners,
+		txIndex:   -1,
+	}
+}
+
+// OnWrite implements the WriteListener interface.
+func (c *stateCollector) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) {
+	c.changes = append(c.changes, &storetypes.StoreKVPair{
+		StoreKey:    storeKey.Name(),
+		Delete:      delete,
+		Key:         key,
+		Value:       value,
+		BlockHeight: c.height,
+		TxIndex:     c.txIndex,
+	})
+}
+
+// listen attaches the collector to the listened stores of a DeliverTx state
+// multi-store.
+func (c *stateCollector) listen(ms sdk.MultiStore, height int64) {
+	for _, key := range c.keys {
+		ms.AddListeners(key, []storetypes.WriteListener{c})
+	}
+	c.setBlock(height)
+}
+
+// setBlock sets the height of the block being executed. Subsequent changes are
+// attributed to the block itself until the first transaction begins.
+func (c *stateCollector) setBlock(height int64) {
+	c.height = height
+	c.txIndex = -1
+}
+
+// beginTx attributes subsequent changes to the next transaction of the block.
+func (c *stateCollector) beginTx() {
+	c.txIndex++
+}
+
+// endTxs attributes subsequent changes to the block itself, e.g. in EndBlock.
+func (c *stateCollector) endTxs() {
+	c.txIndex = -1
+}
+
+// commit hands the buffered changes over to the listeners and resets the
+// buffer. Listener errors are returned, but don't stop the remaining listeners
+// from being called.
+func (c *stateCollector) commit(height int64) []error {
+	changes := c.changes
+	c.changes = nil
+
+	var errs []error
+	for _, listener := range c.listeners {
+		if err := listener.ListenCommit(height, changes); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
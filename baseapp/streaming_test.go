@@ -0,0 +1,122 @@
+package baseapp
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/streaming"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ StateListener = (*streaming.FileListener)(nil)
+
+type mockStateListener struct {
+	heights []int64
+	changes [][]*storetypes.StoreKVPair
+}
+
+func (l *mockStateListener) ListenCommit(height int64, changes []*storetypes.StoreKVPair) error {
+	l.heights = append(l.heights, height)
+	l.changes = append(l.changes, changes)
+	return nil
+}
+
+func TestStateListeners(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	fileListener, err := streaming.NewFileListener(dir)
+	require.NoError(t, err)
+	listener := &mockStateListener{}
+
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	beginKey := []byte("begin-key")
+	endKey := []byte("end-key")
+
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey)))
+	}
+	blockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set(beginKey, []byte{1})
+			ctx.KVStore(capKey2).Set(beginKey, []byte{2})
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Delete(beginKey)
+			ctx.KVStore(capKey1).Set(endKey, []byte{3})
+			return abci.ResponseEndBlock{}
+		})
+	}
+	listenOpt := SetStateListeners([]sdk.StoreKey{capKey1}, listener, fileListener)
+
+	app := setupBaseApp(t, anteOpt, routerOpt, blockerOpt, listenOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	for i := int64(0); i < 3; i++ {
+		tx := newTxCounter(i, i)
+		// the handler of the last tx fails, so only its ante handler writes are kept
+		tx.setFailOnHandler(i == 2)
+		txBytes, err := codec.MarshalBinaryBare(tx)
+		require.NoError(t, err)
+		app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	pair := func(key []byte, value []byte, txIndex int64) *storetypes.StoreKVPair {
+		return &storetypes.StoreKVPair{
+			StoreKey:    capKey1.Name(),
+			Delete:      value == nil,
+			Key:         key,
+			Value:       value,
+			BlockHeight: 1,
+			TxIndex:     txIndex,
+		}
+	}
+	counter := func(i int64) []byte {
+		bz := make([]byte, binary.MaxVarintLen64)
+		return bz[:binary.PutVarint(bz, i)]
+	}
+	expected := []*storetypes.StoreKVPair{
+		pair(beginKey, []byte{1}, -1),
+		pair(anteKey, counter(1), 0),
+		pair(deliverKey, counter(1), 0),
+		pair(anteKey, counter(2), 1),
+		pair(deliverKey, counter(2), 1),
+		pair(anteKey, counter(3), 2),
+		pair(beginKey, nil, -1),
+		pair(endKey, []byte{3}, -1),
+	}
+
+	require.Equal(t, []int64{1}, listener.heights)
+	require.Equal(t, expected, listener.changes[0])
+
+	changes, err := streaming.ReadFile(filepath.Join(dir, streaming.FileName(1)))
+	require.NoError(t, err)
+	require.Equal(t, expected, changes)
+
+	// an empty block should still be handed over
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Equal(t, []int64{1, 2}, listener.heights)
+	require.Len(t, listener.changes[1], 3)
+	require.EqualValues(t, 2, listener.changes[1][0].BlockHeight)
+}
@@ -1,15 +1,19 @@
 package client
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strings"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/mempool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 // BroadcastTx broadcasts a transactions either synchronously or asynchronously
@@ -141,3 +145,48 @@ func (ctx Context) BroadcastTxAsync(txBytes []byte) (sdk.TxResponse, error) {
 
 	return sdk.NewResponseFormatBroadcastTx(res), err
 }
+
+// TxServiceBroadcast broadcasts the transaction of a tx service BroadcastTx
+// request through the Tendermint RPC client of clientCtx in the requested mode.
+func TxServiceBroadcast(clientCtx Context, req *tx.BroadcastTxRequest) (*tx.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "empty tx")
+	}
+
+	var mode string
+	switch req.Mode {
+	case tx.BroadcastModeBlock:
+		mode = flags.BroadcastBlock
+	case tx.BroadcastModeSync:
+		mode = flags.BroadcastSync
+	case tx.BroadcastModeAsync:
+		mode = flags.BroadcastAsync
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported broadcast mode %s", req.Mode)
+	}
+
+	res, err := clientCtx.WithBroadcastMode(mode).BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tx.BroadcastTxResponse{
+		TxResponse: &tx.TxResponse{
+			Height:    res.Height,
+			Txhash:    res.TxHash,
+			Codespace: res.Codespace,
+			Code:      res.Code,
+			Data:      data,
+			RawLog:    res.RawLog,
+			Info:      res.Info,
+			GasWanted: res.GasWanted,
+			GasUsed:   res.GasUsed,
+			Tx:        req.TxBytes,
+		},
+	}, nil
+}
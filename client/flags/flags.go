@@ -53,6 +53,7 @@ const (
 	FlagMemo             = "memo"
 	FlagFees             = "fees"
 	FlagGasPrices        = "gas-prices"
+	FlagFeeAccount       = "fee-account"
 	FlagBroadcastMode    = "broadcast-mode"
 	FlagDryRun           = "dry-run"
 	FlagGenerateOnly     = "generate-only"
@@ -107,6 +108,7 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 		c.Flags().String(FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uatom)")
+		c.Flags().String(FlagFeeAccount, "", "Address of an account that granted the signer a fee allowance to pay the transaction fee")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
@@ -3,28 +3,75 @@ package client
 import (
 	gocontext "context"
 	"fmt"
+	"strconv"
 
 	gogogrpc "github.com/gogo/protobuf/grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
+	"google.golang.org/grpc/metadata"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 var _ gogogrpc.ClientConn = Context{}
 
 var protoCodec = encoding.GetCodec(proto.Name)
 
-// Invoke implements the grpc ClientConn.Invoke method
-func (ctx Context) Invoke(_ gocontext.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+// Invoke implements the grpc ClientConn.Invoke method. The query height may be
+// set through the x-cosmos-block-height outgoing metadata of the context, and
+// the height the query was executed at is returned through any grpc.Header
+// call option.
+//
+// Transactions of tx service BroadcastTx requests are broadcast directly through
+// the Tendermint RPC client instead of an ABCI query, as an ABCI query cannot
+// wait on the processing of a transaction by the same application.
+func (ctx Context) Invoke(grpcCtx gocontext.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	if req, ok := args.(*tx.BroadcastTxRequest); ok {
+		res, err := TxServiceBroadcast(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		*reply.(*tx.BroadcastTxResponse) = *res
+		return nil
+	}
+
+	if md, ok := metadata.FromOutgoingContext(grpcCtx); ok {
+		if heights := md.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+			height, err := strconv.ParseInt(heights[0], 10, 64)
+			if err != nil || height < 0 {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height: %s", heights[0])
+			}
+
+			ctx = ctx.WithHeight(height)
+		}
+	}
+
 	reqBz, err := protoCodec.Marshal(args)
 	if err != nil {
 		return err
 	}
-	resBz, _, err := ctx.QueryWithData(method, reqBz)
+
+	resBz, height, err := ctx.QueryWithData(method, reqBz)
 	if err != nil {
 		return err
 	}
-	return protoCodec.Unmarshal(resBz, reply)
+
+	err = protoCodec.Unmarshal(resBz, reply)
+	if err != nil {
+		return err
+	}
+
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+		}
+	}
+
+	return nil
 }
 
 // NewStream implements the grpc ClientConn.NewStream method
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -68,11 +70,29 @@ func printNodeStatus(_ *cobra.Command, _ []string) error {
 }
 
 // NodeInfoResponse defines a response type that contains node status and version
-// information.
+// information, along with the pruning settings of the application if it reports
+// them.
 type NodeInfoResponse struct {
 	p2p.DefaultNodeInfo `json:"node_info"`
 
-	ApplicationVersion version.Info `json:"application_version"`
+	ApplicationVersion version.Info            `json:"application_version"`
+	Pruning            *storetypes.PruningInfo `json:"pruning,omitempty"`
+}
+
+// getPruningInfo queries the pruning settings of the application, and the range
+// of heights it retains.
+func getPruningInfo(clientCtx client.Context) (*storetypes.PruningInfo, error) {
+	bz, _, err := clientCtx.Query("/app/pruning")
+	if err != nil {
+		return nil, err
+	}
+
+	var info storetypes.PruningInfo
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
 }
 
 // REST handler for node info
@@ -87,6 +107,11 @@ func NodeInfoRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
 			DefaultNodeInfo:    status.NodeInfo,
 			ApplicationVersion: version.NewInfo(),
 		}
+
+		// the pruning settings are left out for applications not reporting them
+		if pruning, err := getPruningInfo(clientCtx); err == nil {
+			resp.Pruning = pruning
+		}
 		rest.PostProcessResponseBare(w, clientCtx, resp)
 	}
 }
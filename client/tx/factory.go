@@ -1,6 +1,7 @@
 package tx
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/spf13/viper"
@@ -26,6 +27,7 @@ type Factory struct {
 	memo               string
 	fees               sdk.Coins
 	gasPrices          sdk.DecCoins
+	feeGranter         sdk.AccAddress
 	signMode           signing.SignMode
 	simulateAndExecute bool
 }
@@ -35,7 +37,10 @@ const (
 	signModeAminoJSON = "amino-json"
 )
 
-func NewFactoryFromCLI(input io.Reader) Factory {
+// NewFactoryFromCLI creates a new Factory from the CLI flags. It returns an
+// error if the keyring cannot be opened or the fee account is not a valid
+// address.
+func NewFactoryFromCLI(input io.Reader) (Factory, error) {
 	kb, err := keyring.New(
 		sdk.KeyringServiceName(),
 		viper.GetString(flags.FlagKeyringBackend),
@@ -43,7 +48,7 @@ func NewFactoryFromCLI(input io.Reader) Factory {
 		input,
 	)
 	if err != nil {
-		panic(err)
+		return Factory{}, err
 	}
 
 	signModeStr := viper.GetString(flags.FlagSignMode)
@@ -70,7 +75,16 @@ func NewFactoryFromCLI(input io.Reader) Factory {
 	f = f.WithFees(viper.GetString(flags.FlagFees))
 	f = f.WithGasPrices(viper.GetString(flags.FlagGasPrices))
 
-	return f
+	if feeAccount := viper.GetString(flags.FlagFeeAccount); feeAccount != "" {
+		feeGranter, err := sdk.AccAddressFromBech32(feeAccount)
+		if err != nil {
+			return Factory{}, fmt.Errorf("invalid --%s address %s: %w", flags.FlagFeeAccount, feeAccount, err)
+		}
+
+		f = f.WithFeeGranter(feeGranter)
+	}
+
+	return f, nil
 }
 
 func (f Factory) AccountNumber() uint64                     { return f.accountNumber }
@@ -82,6 +96,7 @@ func (f Factory) ChainID() string                           { return f.chainID }
 func (f Factory) Memo() string                              { return f.memo }
 func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
+func (f Factory) FeeGranter() sdk.AccAddress                { return f.feeGranter }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
@@ -134,6 +149,12 @@ func (f Factory) WithGasPrices(gasPrices string) Factory {
 	return f
 }
 
+// WithFeeGranter returns a copy of the Factory with an updated fee granter.
+func (f Factory) WithFeeGranter(feeGranter sdk.AccAddress) Factory {
+	f.feeGranter = feeGranter
+	return f
+}
+
 // WithKeybase returns a copy of the Factory with updated Keybase.
 func (f Factory) WithKeybase(keybase keyring.Keyring) Factory {
 	f.keybase = keybase
@@ -2,13 +2,13 @@ package tx
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"os"
-	"strings"
 
-	"github.com/gogo/protobuf/jsonpb"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,6 +16,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
@@ -23,7 +24,12 @@ import (
 // GenerateOrBroadcastTx will either generate and print and unsigned transaction
 // or sign it and broadcast it returning an error upon failure.
 func GenerateOrBroadcastTx(clientCtx client.Context, msgs ...sdk.Msg) error {
-	txf := NewFactoryFromCLI(clientCtx.Input).WithTxGenerator(clientCtx.TxGenerator).WithAccountRetriever(clientCtx.AccountRetriever)
+	txf, err := NewFactoryFromCLI(clientCtx.Input)
+	if err != nil {
+		return err
+	}
+
+	txf = txf.WithTxGenerator(clientCtx.TxGenerator).WithAccountRetriever(clientCtx.AccountRetriever)
 	return GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...)
 }
 
@@ -47,7 +53,7 @@ func GenerateTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 			return errors.New("cannot estimate gas in offline mode")
 		}
 
-		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
 		if err != nil {
 			return err
 		}
@@ -74,7 +80,7 @@ func BroadcastTx(clientCtx client.Context, txf Factory, msgs ...sdk.Msg) error {
 	}
 
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
-		_, adjusted, err := CalculateGas(clientCtx.QueryWithData, txf, msgs...)
+		_, adjusted, err := CalculateGas(clientCtx, txf, msgs...)
 		if err != nil {
 			return err
 		}
@@ -160,7 +166,7 @@ func WriteGeneratedTxResponse(
 			return
 		}
 
-		_, adjusted, err := CalculateGas(ctx.QueryWithData, txf, msgs...)
+		_, adjusted, err := CalculateGas(ctx, txf, msgs...)
 		if rest.CheckInternalServerError(w, err) {
 			return
 		}
@@ -190,7 +196,7 @@ func WriteGeneratedTxResponse(
 
 // BuildUnsignedTx builds a transaction to be signed given a set of messages. The
 // transaction is initially created via the provided factory's generator. Once
-// created, the fee, memo, and messages are set.
+// created, the fee, fee granter, memo, and messages are set.
 func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	if txf.chainID == "" {
 		return nil, fmt.Errorf("chain ID required but not specified")
@@ -223,6 +229,7 @@ func BuildUnsignedTx(txf Factory, msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetMemo(txf.memo)
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(txf.gas)
+	tx.SetFeeGranter(txf.feeGranter)
 
 	return tx, nil
 }
@@ -247,27 +254,23 @@ func BuildSimTx(txf Factory, msgs ...sdk.Msg) ([]byte, error) {
 	return txf.txGenerator.MarshalTx(tx.GetTx())
 }
 
-// CalculateGas simulates the execution of a transaction and returns the
-// simulation response obtained by the query and the adjusted gas amount.
+// CalculateGas simulates the execution of a transaction with the tx service
+// and returns the simulation response and the adjusted gas amount.
 func CalculateGas(
-	queryFunc func(string, []byte) ([]byte, int64, error), txf Factory, msgs ...sdk.Msg,
-) (sdk.SimulationResponse, uint64, error) {
+	clientConn gogogrpc.ClientConn, txf Factory, msgs ...sdk.Msg,
+) (*txtypes.SimulateResponse, uint64, error) {
 	txBytes, err := BuildSimTx(txf, msgs...)
 	if err != nil {
-		return sdk.SimulationResponse{}, 0, err
+		return nil, 0, err
 	}
 
-	bz, _, err := queryFunc("/app/simulate", txBytes)
+	txSvcClient := txtypes.NewServiceClient(clientConn)
+	simRes, err := txSvcClient.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: txBytes})
 	if err != nil {
-		return sdk.SimulationResponse{}, 0, err
-	}
-
-	var simRes sdk.SimulationResponse
-	if err := jsonpb.Unmarshal(strings.NewReader(string(bz)), &simRes); err != nil {
-		return sdk.SimulationResponse{}, 0, err
+		return nil, 0, err
 	}
 
-	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasUsed)), nil
+	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GetGasUsed())), nil
 }
 
 // PrepareFactory ensures the account defined by ctx.GetFromAddress() exists and
@@ -1,6 +1,7 @@
 package tx_test
 
 import (
+	gocontext "context"
 	"errors"
 	"testing"
 
@@ -10,13 +11,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -26,30 +30,35 @@ func NewTestTxGenerator() client.TxGenerator {
 	return types.StdTxGenerator{Cdc: cdc}
 }
 
-func TestCalculateGas(t *testing.T) {
-	makeQueryFunc := func(gasUsed uint64, wantErr bool) func(string, []byte) ([]byte, int64, error) {
-		return func(string, []byte) ([]byte, int64, error) {
-			if wantErr {
-				return nil, 0, errors.New("query failed")
-			}
-			simRes := &sdk.SimulationResponse{
-				GasInfo: sdk.GasInfo{GasUsed: gasUsed, GasWanted: gasUsed},
-				Result:  &sdk.Result{Data: []byte("tx data"), Log: "log"},
-			}
+// mockContext is a gRPC client connection answering Simulate requests of the
+// tx service.
+type mockContext struct {
+	gasUsed uint64
+	wantErr bool
+}
 
-			bz, err := codec.ProtoMarshalJSON(simRes)
-			if err != nil {
-				return nil, 0, err
-			}
+func (m mockContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error {
+	if m.wantErr {
+		return errors.New("simulation failed")
+	}
 
-			return bz, 0, nil
-		}
+	*(reply.(*txtypes.SimulateResponse)) = txtypes.SimulateResponse{
+		GasInfo: &sdk.GasInfo{GasUsed: m.gasUsed, GasWanted: m.gasUsed},
+		Result:  &sdk.Result{Data: []byte("tx data"), Log: "log"},
 	}
 
+	return nil
+}
+
+func (mockContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestCalculateGas(t *testing.T) {
 	type args struct {
-		queryFuncGasUsed uint64
-		queryFuncWantErr bool
-		adjustment       float64
+		mockGasUsed uint64
+		mockWantErr bool
+		adjustment  float64
 	}
 
 	testCases := []struct {
@@ -68,8 +77,11 @@ func TestCalculateGas(t *testing.T) {
 		txf := tx.Factory{}.WithChainID("test-chain").WithTxGenerator(NewTestTxGenerator())
 
 		t.Run(stc.name, func(t *testing.T) {
-			queryFunc := makeQueryFunc(stc.args.queryFuncGasUsed, stc.args.queryFuncWantErr)
-			simRes, gotAdjusted, err := tx.CalculateGas(queryFunc, txf.WithGasAdjustment(stc.args.adjustment))
+			mockClientCtx := mockContext{
+				gasUsed: stc.args.mockGasUsed,
+				wantErr: stc.args.mockWantErr,
+			}
+			simRes, gotAdjusted, err := tx.CalculateGas(mockClientCtx, txf.WithGasAdjustment(stc.args.adjustment))
 			if stc.expPass {
 				require.NoError(t, err)
 				require.Equal(t, simRes.GasInfo.GasUsed, stc.wantEstimate)
@@ -77,7 +89,7 @@ func TestCalculateGas(t *testing.T) {
 				require.NotNil(t, simRes.Result)
 			} else {
 				require.Error(t, err)
-				require.Nil(t, simRes.Result)
+				require.Nil(t, simRes)
 			}
 		})
 	}
@@ -164,3 +176,22 @@ func TestSign(t *testing.T) {
 	err = tx.Sign(txf, "non_existing_key", txn)
 	require.Error(t, err)
 }
+
+func TestNewFactoryFromCLIFeeAccount(t *testing.T) {
+	dir, clean := tests.NewTestCaseDir(t)
+	t.Cleanup(clean)
+
+	viper.Set(flags.FlagHome, dir)
+	viper.Set(flags.FlagKeyringBackend, keyring.BackendTest)
+	t.Cleanup(func() { viper.Set(flags.FlagFeeAccount, "") })
+
+	feeGranter := sdk.AccAddress("feegranter__________")
+	viper.Set(flags.FlagFeeAccount, feeGranter.String())
+	txf, err := tx.NewFactoryFromCLI(nil)
+	require.NoError(t, err)
+	require.Equal(t, feeGranter, txf.FeeGranter())
+
+	viper.Set(flags.FlagFeeAccount, "invalid")
+	_, err = tx.NewFactoryFromCLI(nil)
+	require.Error(t, err)
+}
@@ -10,6 +10,16 @@ for dir in $proto_dirs; do
   --gocosmos_out=plugins=interfacetype+grpc,\
 Mgoogle/protobuf/any.proto=github.com/cosmos/cosmos-sdk/codec/types:. \
   $(find "${dir}" -maxdepth 1 -name '*.proto')
+
+  # generate the gRPC gateway handlers (*.pb.gw.go) of the query and tx services
+  service_files=$(find "${dir}" -maxdepth 1 \( -name 'query.proto' -o -name 'service.proto' \))
+  if [[ -n "$service_files" ]]; then
+    protoc \
+    -I "proto" \
+    -I "third_party/proto" \
+    --grpc-gateway_out=logtostderr=true:. \
+    $service_files
+  fi
 done
 
 cp -r github.com/cosmos/cosmos-sdk/* ./
@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/gateway"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/rakyll/statik/fs"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
@@ -25,8 +27,9 @@ import (
 
 // Server defines the server's API interface.
 type Server struct {
-	Router    *mux.Router
-	ClientCtx client.Context
+	Router            *mux.Router
+	GRPCGatewayRouter *runtime.ServeMux
+	ClientCtx         client.Context
 
 	logger   log.Logger
 	metrics  *telemetry.Metrics
@@ -34,10 +37,19 @@ type Server struct {
 }
 
 func New(clientCtx client.Context) *Server {
+	// The default JSON marshaler of the gRPC gateway is unable to marshal gogoproto
+	// types such as non-nullable message fields, so the gogoproto based one is used.
+	marshalerOption := &gateway.JSONPb{
+		EmitDefaults: true,
+		Indent:       "  ",
+		OrigName:     true,
+	}
+
 	return &Server{
-		Router:    mux.NewRouter(),
-		ClientCtx: clientCtx,
-		logger:    log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "api-server"),
+		Router:            mux.NewRouter(),
+		GRPCGatewayRouter: runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, marshalerOption)),
+		ClientCtx:         clientCtx,
+		logger:            log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "api-server"),
 	}
 }
 
@@ -45,6 +57,9 @@ func New(clientCtx client.Context) *Server {
 // JSON RPC server. Configuration options are provided via config.APIConfig
 // and are delegated to the Tendermint JSON RPC server. The process is
 // non-blocking, so an external signal handler must be used.
+//
+// The gRPC gateway routes are served for every path that is not matched by
+// a route registered on the Router, so legacy REST routes take precedence.
 func (s *Server) Start(cfg config.Config) error {
 	if cfg.API.Swagger {
 		s.registerSwaggerUI()
@@ -60,6 +75,8 @@ func (s *Server) Start(cfg config.Config) error {
 		s.registerMetrics()
 	}
 
+	s.registerGRPCGatewayRoutes()
+
 	tmCfg := tmrpcserver.DefaultConfig()
 	tmCfg.MaxOpenConnections = int(cfg.API.MaxOpenConnections)
 	tmCfg.ReadTimeout = time.Duration(cfg.API.RPCReadTimeout) * time.Second
@@ -93,7 +110,13 @@ func (s *Server) registerSwaggerUI() {
 	}
 
 	staticServer := http.FileServer(statikFS)
-	s.Router.PathPrefix("/").Handler(staticServer)
+	s.Router.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
+}
+
+// registerGRPCGatewayRoutes mounts the gRPC gateway router as the fallback
+// handler of the API server.
+func (s *Server) registerGRPCGatewayRoutes() {
+	s.Router.PathPrefix("/").Handler(s.GRPCGatewayRouter)
 }
 
 func (s *Server) registerMetrics() {
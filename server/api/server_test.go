@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// balanceQueryClient answers Balance queries with a fixed coin amount and
+// panics on every other bank query.
+type balanceQueryClient struct {
+	banktypes.QueryClient
+}
+
+func (balanceQueryClient) Balance(_ context.Context, req *banktypes.QueryBalanceRequest, opts ...grpc.CallOption) (*banktypes.QueryBalanceResponse, error) {
+	for _, opt := range opts {
+		if header, ok := opt.(grpc.HeaderCallOption); ok {
+			*header.HeaderAddr = metadata.Pairs(grpctypes.GRPCBlockHeightHeader, "7")
+		}
+	}
+
+	balance := sdk.NewInt64Coin(req.Denom, 100)
+	return &banktypes.QueryBalanceResponse{Balance: &balance}, nil
+}
+
+func TestGRPCGatewayRoutes(t *testing.T) {
+	s := New(client.Context{})
+	err := banktypes.RegisterQueryHandlerClient(context.Background(), s.GRPCGatewayRouter, balanceQueryClient{})
+	require.NoError(t, err)
+
+	s.Router.HandleFunc("/bank/balances/{address}", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	}).Methods("GET")
+	s.registerGRPCGatewayRoutes()
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+
+	// gRPC gateway routes are served alongside the legacy routes
+	rec := httptest.NewRecorder()
+	path := "/cosmos/bank/balances/" + base64.URLEncoding.EncodeToString(addr) + "/stake"
+	s.Router.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "7", rec.Header().Get("Grpc-Metadata-"+grpctypes.GRPCBlockHeightHeader))
+
+	var res struct {
+		Balance sdk.Coin `json:"balance"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), res.Balance)
+
+	rec = httptest.NewRecorder()
+	s.Router.ServeHTTP(rec, httptest.NewRequest("GET", "/bank/balances/"+addr.String(), nil))
+	require.Equal(t, http.StatusTeapot, rec.Code)
+
+	rec = httptest.NewRecorder()
+	s.Router.ServeHTTP(rec, httptest.NewRequest("GET", "/cosmos/unknown", nil))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,37 @@
+package grpc
+
+import (
+	"net"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// Application defines the application interface required to serve its
+// registered gRPC query services.
+type Application interface {
+	RegisterGRPCServer(gogogrpc.Server)
+}
+
+// StartGRPCServer starts a gRPC server on the given address serving all gRPC
+// query services of the application. Server reflection is enabled so that
+// clients can discover the exposed services and methods. The process is
+// non-blocking, the returned server must be stopped by the caller.
+func StartGRPCServer(app Application, address string) (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	grpcSrv := grpc.NewServer()
+	app.RegisterGRPCServer(grpcSrv)
+	reflection.Register(grpcSrv)
+
+	go func() {
+		// Serve only returns once the server is stopped or the listener fails
+		_ = grpcSrv.Serve(listener)
+	}()
+
+	return grpcSrv, nil
+}
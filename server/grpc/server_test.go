@@ -0,0 +1,127 @@
+package grpc_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+
+	"github.com/cosmos/cosmos-sdk/codec/testdata"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	app  *simapp.SimApp
+	addr sdk.AccAddress
+	srv  *grpc.Server
+	conn *grpc.ClientConn
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.addr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	genAccs := []authtypes.GenesisAccount{authtypes.NewBaseAccountWithAddress(s.addr)}
+	balances := banktypes.Balance{Address: s.addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100))}
+	s.app = simapp.SetupWithGenesisAccounts(genAccs, balances)
+
+	// commit a second block changing the balance, so both heights can be queried
+	ctx := s.app.BaseApp.NewContext(false, abci.Header{Height: s.app.LastBlockHeight() + 1})
+	s.Require().NoError(s.app.BankKeeper.SetBalances(ctx, s.addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 50))))
+	s.app.EndBlock(abci.RequestEndBlock{Height: s.app.LastBlockHeight() + 1})
+	s.app.Commit()
+	s.Require().Equal(int64(2), s.app.LastBlockHeight())
+
+	address := freeAddress(s.T())
+
+	var err error
+	s.srv, err = servergrpc.StartGRPCServer(s.app, address)
+	s.Require().NoError(err)
+
+	s.conn, err = grpc.Dial(address, grpc.WithInsecure())
+	s.Require().NoError(err)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.conn.Close()
+	s.srv.Stop()
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_TestService() {
+	testClient := testdata.NewTestServiceClient(s.conn)
+	res, err := testClient.Echo(context.Background(), &testdata.EchoRequest{Message: "hello"})
+	s.Require().NoError(err)
+	s.Require().Equal("hello", res.Message)
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_BankBalance() {
+	bankClient := banktypes.NewQueryClient(s.conn)
+	req := &banktypes.QueryBalanceRequest{Address: s.addr, Denom: "foocoin"}
+
+	// latest height
+	var header metadata.MD
+	res, err := bankClient.Balance(context.Background(), req, grpc.Header(&header))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("foocoin", 50), *res.Balance)
+	s.Require().Equal([]string{"2"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	// pinned height
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, "1")
+	res, err = bankClient.Balance(ctx, req, grpc.Header(&header))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("foocoin", 100), *res.Balance)
+	s.Require().Equal([]string{"1"}, header.Get(grpctypes.GRPCBlockHeightHeader))
+
+	// invalid heights
+	for _, height := range []string{"foo", "-1", "10"} {
+		ctx = metadata.AppendToOutgoingContext(context.Background(), grpctypes.GRPCBlockHeightHeader, height)
+		_, err = bankClient.Balance(ctx, req)
+		s.Require().Error(err, height)
+	}
+}
+
+func (s *IntegrationTestSuite) TestGRPCServer_Reflection() {
+	stream, err := rpb.NewServerReflectionClient(s.conn).ServerReflectionInfo(context.Background())
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
+	}))
+
+	res, err := stream.Recv()
+	s.Require().NoError(err)
+
+	services := []string{}
+	for _, svc := range res.GetListServicesResponse().Service {
+		services = append(services, svc.Name)
+	}
+
+	s.Require().Contains(services, "cosmos.bank.Query")
+	s.Require().Contains(services, "cosmos_sdk.codec.v1.TestService")
+}
+
+// freeAddress returns a local address with a port that is free to listen on.
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	return listener.Addr().String()
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
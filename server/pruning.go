@@ -31,3 +31,21 @@ func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
 		return store.PruningOptions{}, fmt.Errorf("unknown pruning strategy %s", strategy)
 	}
 }
+
+// validateSnapshotInterval ensures that state sync snapshots are only taken at
+// heights that are never pruned, i.e. that the snapshot interval is a multiple of
+// the pruning snapshot interval.
+func validateSnapshotInterval(opts store.PruningOptions, interval uint64) error {
+	if interval == 0 {
+		return nil
+	}
+
+	if opts.SnapshotEvery <= 0 || interval%uint64(opts.SnapshotEvery) != 0 {
+		return fmt.Errorf(
+			"state sync snapshot interval %d must be a multiple of the pruning snapshot interval %d",
+			interval, opts.SnapshotEvery,
+		)
+	}
+
+	return nil
+}
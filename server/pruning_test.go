@@ -57,3 +57,30 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSnapshotInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     store.PruningOptions
+		interval uint64
+		wantErr  bool
+	}{
+		{"disabled", store.PruneSyncable, 0, false},
+		{"multiple", store.PruningOptions{KeepEvery: 100, SnapshotEvery: 1000}, 3000, false},
+		{"equal", store.PruningOptions{KeepEvery: 100, SnapshotEvery: 1000}, 1000, false},
+		{"not multiple", store.PruningOptions{KeepEvery: 100, SnapshotEvery: 1000}, 1500, true},
+		{"no pruning snapshots", store.PruningOptions{KeepEvery: 1}, 1000, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSnapshotInterval(tt.opts, tt.interval)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,11 +17,13 @@ import (
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
+	servergrpc "github.com/cosmos/cosmos-sdk/server/grpc"
 )
 
 // Tendermint full-node start flags
@@ -38,6 +40,14 @@ const (
 	FlagHaltTime             = "halt-time"
 	FlagInterBlockCache      = "inter-block-cache"
 	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+
+	// state sync-related flags
+	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
+	FlagStateSyncSnapshotKeepRecent = "state-sync.snapshot-keep-recent"
+
+	// gRPC-related flags
+	flagGRPCEnable  = "grpc.enable"
+	flagGRPCAddress = "grpc.address"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -63,12 +73,23 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+State sync snapshots of the application state can be taken every '--state-sync.snapshot-interval'
+blocks, keeping the '--state-sync.snapshot-keep-recent' most recent ones. The snapshot interval must
+be a multiple of the pruning snapshot interval so that snapshotted heights are never pruned.
+
+The gRPC server serving the application's query services is enabled by default and listens on
+'--grpc.address'. It can be disabled with '--grpc.enable=false'.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
 		PreRunE: func(cmd *cobra.Command, args []string) error {
-			_, err := GetPruningOptionsFromFlags()
-			return err
+			pruningOpts, err := GetPruningOptionsFromFlags()
+			if err != nil {
+				return err
+			}
+
+			return validateSnapshotInterval(pruningOpts, viper.GetUint64(FlagStateSyncSnapshotInterval))
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !viper.GetBool(flagWithTendermint) {
@@ -100,9 +121,21 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 
+	// add state sync flags
+	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval (0 to disable)")
+	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep (0 to keep all)")
+
+	// add gRPC flags
+	cmd.Flags().Bool(flagGRPCEnable, true, "Define if the gRPC server should be enabled")
+	cmd.Flags().String(flagGRPCAddress, config.DefaultGRPCAddress, "The gRPC server address to listen on")
+
 	viper.BindPFlag(flagPruning, cmd.Flags().Lookup(flagPruning))
 	viper.BindPFlag(flagPruningKeepEvery, cmd.Flags().Lookup(flagPruningKeepEvery))
 	viper.BindPFlag(flagPruningSnapshotEvery, cmd.Flags().Lookup(flagPruningSnapshotEvery))
+	viper.BindPFlag(FlagStateSyncSnapshotInterval, cmd.Flags().Lookup(FlagStateSyncSnapshotInterval))
+	viper.BindPFlag(FlagStateSyncSnapshotKeepRecent, cmd.Flags().Lookup(FlagStateSyncSnapshotKeepRecent))
+	viper.BindPFlag(flagGRPCEnable, cmd.Flags().Lookup(flagGRPCEnable))
+	viper.BindPFlag(flagGRPCAddress, cmd.Flags().Lookup(flagGRPCAddress))
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -125,6 +158,10 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 
 	app := appCreator(ctx.Logger, db, traceWriter)
 
+	// the app has no Tendermint RPC client when running standalone, so only the
+	// tx service methods that don't query the node, such as Simulate, work
+	app.RegisterTxService(client.Context{}.WithHomeDir(home))
+
 	svr, err := server.NewServer(addr, "socket", app)
 	if err != nil {
 		return fmt.Errorf("error creating listener: %v", err)
@@ -188,6 +225,24 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		return err
 	}
 
+	genDoc, err := genDocProvider()
+	if err != nil {
+		return err
+	}
+
+	// TODO: Since this is running in process, do we need to provide a verifier
+	// and set TrustNode=false? If so, we need to add additional logic that
+	// waits for a block to be committed first before starting the API server.
+	clientCtx := client.Context{}.
+		WithHomeDir(home).
+		WithChainID(genDoc.ChainID).
+		WithJSONMarshaler(cdc).
+		WithClient(local.New(tmNode)).
+		WithTrustNode(true)
+
+	// the tx service must be registered before the node starts serving queries
+	app.RegisterTxService(clientCtx)
+
 	if err := tmNode.Start(); err != nil {
 		return err
 	}
@@ -195,22 +250,7 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 	config := config.GetConfig()
 	var apiSrv *api.Server
 	if config.API.Enable {
-		genDoc, err := genDocProvider()
-		if err != nil {
-			return err
-		}
-
-		// TODO: Since this is running in process, do we need to provide a verifier
-		// and set TrustNode=false? If so, we need to add additional logic that
-		// waits for a block to be committed first before starting the API server.
-		ctx := client.Context{}.
-			WithHomeDir(home).
-			WithChainID(genDoc.ChainID).
-			WithJSONMarshaler(cdc).
-			WithClient(local.New(tmNode)).
-			WithTrustNode(true)
-
-		apiSrv = api.New(ctx)
+		apiSrv = api.New(clientCtx)
 		app.RegisterAPIRoutes(apiSrv)
 
 		if err := apiSrv.Start(config); err != nil {
@@ -218,6 +258,14 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 		}
 	}
 
+	var grpcSrv *grpc.Server
+	if config.GRPC.Enable {
+		grpcSrv, err = servergrpc.StartGRPCServer(app, config.GRPC.Address)
+		if err != nil {
+			return err
+		}
+	}
+
 	var cpuProfileCleanup func()
 
 	if cpuProfile := viper.GetString(flagCPUProfile); cpuProfile != "" {
@@ -251,6 +299,10 @@ func startInProcess(ctx *Context, cdc codec.JSONMarshaler, appCreator AppCreator
 			_ = apiSrv.Close()
 		}
 
+		if grpcSrv != nil {
+			grpcSrv.Stop()
+		}
+
 		ctx.Logger.Info("exiting...")
 	})
 
@@ -0,0 +1,37 @@
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	feegrantante "github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	feegranttypes "github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	feemarketante "github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	feemarketkeeper "github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	ibcante "github.com/cosmos/cosmos-sdk/x/ibc/ante"
+	ibckeeper "github.com/cosmos/cosmos-sdk/x/ibc/keeper"
+)
+
+// NewAnteHandler returns the AnteHandler of the SimApp. It mirrors the x/feegrant
+// AnteHandler, charging and burning the x/feemarket base fee once the fees
+// have been deducted.
+func NewAnteHandler(
+	ak authante.AccountKeeper, bankKeeper feegranttypes.BankKeeper, feeGrantKeeper feegrantkeeper.Keeper,
+	feeMarketKeeper feemarketkeeper.Keeper, ibcKeeper ibckeeper.Keeper, sigGasConsumer authante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		feegrantante.NewDeductGrantedFeeDecorator(ak, bankKeeper, feeGrantKeeper),
+		feemarketante.NewBaseFeeDecorator(feeMarketKeeper), // BaseFeeDecorator must be called after the fees are deducted
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak),
+		authante.NewIncrementSequenceDecorator(ak),
+		ibcante.NewProofVerificationDecorator(ibcKeeper.ClientKeeper, ibcKeeper.ChannelKeeper), // innermost AnteDecorator
+	)
+}
@@ -0,0 +1,181 @@
+package simapp
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// setupParallelApp returns a SimApp with numAccs funded accounts and as many
+// empty recipient accounts.
+func setupParallelApp(
+	tb testing.TB, numAccs int, maxGas int64,
+) (*SimApp, []crypto.PrivKey, []sdk.AccAddress) {
+	db := dbm.NewMemDB()
+	app := NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, DefaultNodeHome, 0)
+
+	privs := make([]crypto.PrivKey, numAccs)
+	addrs := make([]sdk.AccAddress, 2*numAccs)
+	genAccs := make([]authtypes.GenesisAccount, 2*numAccs)
+	balances := make([]banktypes.Balance, numAccs)
+	totalSupply := sdk.NewCoins()
+
+	for i := 0; i < numAccs; i++ {
+		privs[i] = secp256k1.GenPrivKeySecp256k1([]byte{byte(i), byte(i >> 8)})
+		addrs[i] = sdk.AccAddress(privs[i].PubKey().Address())
+		addrs[numAccs+i] = sdk.AccAddress(secp256k1.GenPrivKeySecp256k1([]byte{byte(i), byte(i >> 8), 1}).PubKey().Address())
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000000))
+		balances[i] = banktypes.Balance{Address: addrs[i], Coins: coins}
+		totalSupply = totalSupply.Add(coins...)
+	}
+
+	for i, addr := range addrs {
+		genAccs[i] = authtypes.NewBaseAccount(addr, nil, 0, 0)
+	}
+
+	genesisState := NewDefaultGenesisState()
+	genesisState[authtypes.ModuleName] = app.Codec().MustMarshalJSON(
+		authtypes.NewGenesisState(authtypes.DefaultParams(), genAccs),
+	)
+	genesisState[banktypes.ModuleName] = app.Codec().MustMarshalJSON(
+		banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, totalSupply, []banktypes.Metadata{}),
+	)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
+	require.NoError(tb, err)
+
+	consensusParams := *DefaultConsensusParams
+	consensusParams.Block = &abci.BlockParams{
+		MaxBytes: DefaultConsensusParams.Block.MaxBytes,
+		MaxGas:   maxGas,
+	}
+
+	app.InitChain(
+		abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			ConsensusParams: &consensusParams,
+			AppStateBytes:   stateBytes,
+		},
+	)
+	app.Commit()
+
+	return app, privs, addrs
+}
+
+// genParallelSendTxs returns txsPerAcc encoded bank send txs for each of the
+// funded accounts, sending coins to the account's recipient. The txs of the
+// different accounts are independent of each other, while the txs of a same
+// account conflict on the account's sequence.
+func genParallelSendTxs(
+	tb testing.TB, app *SimApp, privs []crypto.PrivKey, addrs []sdk.AccAddress, txsPerAcc int,
+) []abci.RequestDeliverTx {
+	ctx := app.NewContext(true, abci.Header{})
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	var reqs []abci.RequestDeliverTx
+	for seq := 0; seq < txsPerAcc; seq++ {
+		for i, priv := range privs {
+			acc := app.AccountKeeper.GetAccount(ctx, addrs[i])
+			msg := banktypes.NewMsgSend(addrs[i], addrs[len(privs)+i], coins)
+
+			tx := helpers.GenTx(
+				[]sdk.Msg{msg}, sdk.Coins{}, helpers.DefaultGenTxGas, "",
+				[]uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence() + uint64(seq)}, priv,
+			)
+
+			txBytes, err := app.Codec().MarshalBinaryBare(tx)
+			require.NoError(tb, err)
+
+			reqs = append(reqs, abci.RequestDeliverTx{Tx: txBytes})
+		}
+	}
+
+	return reqs
+}
+
+func TestDeliverTxsParallel(t *testing.T) {
+	testCases := []struct {
+		name   string
+		maxGas int64
+	}{
+		{"no block gas limit", -1},
+		{"block gas limit", DefaultConsensusParams.Block.MaxGas},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			seqApp, privs, addrs := setupParallelApp(t, 20, tc.maxGas)
+			parApp, _, _ := setupParallelApp(t, 20, tc.maxGas)
+
+			for height := int64(2); height <= 3; height++ {
+				reqs := genParallelSendTxs(t, seqApp, privs, addrs, 3)
+
+				header := abci.Header{Height: height}
+				seqApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+				parApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+				seqRes := make([]abci.ResponseDeliverTx, len(reqs))
+				for i, req := range reqs {
+					seqRes[i] = seqApp.DeliverTx(req)
+				}
+				require.Equal(t, seqRes, parApp.DeliverTxs(reqs, 4))
+
+				seqApp.EndBlock(abci.RequestEndBlock{Height: height})
+				parApp.EndBlock(abci.RequestEndBlock{Height: height})
+
+				require.Equal(t, seqApp.Commit(), parApp.Commit())
+			}
+		})
+	}
+}
+
+func BenchmarkDeliverTxsSequential(b *testing.B) {
+	benchmarkDeliverTxs(b, 1)
+}
+
+func BenchmarkDeliverTxsParallel(b *testing.B) {
+	benchmarkDeliverTxs(b, runtime.NumCPU())
+}
+
+// benchmarkDeliverTxs delivers blocks of independent bank send txs with the
+// given number of workers.
+func benchmarkDeliverTxs(b *testing.B, workers int) {
+	app, privs, addrs := setupParallelApp(b, 500, -1)
+
+	blocks := make([][]abci.RequestDeliverTx, b.N)
+	for i := range blocks {
+		// each block increments the account sequences by one
+		reqs := genParallelSendTxs(b, app, privs, addrs, i+1)
+		blocks[i] = reqs[i*len(privs):]
+	}
+
+	b.ResetTimer()
+
+	for i, reqs := range blocks {
+		height := int64(i) + 2
+
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		for _, res := range app.DeliverTxs(reqs, workers) {
+			if !res.IsOK() {
+				b.Fatal(res.Log)
+			}
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+}
@@ -0,0 +1,151 @@
+package snapshots_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func setupManager(t *testing.T, target types.Snapshotter) (*snapshots.Manager, func()) {
+	tempdir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	store, err := snapshots.NewStore(dbm.NewMemDB(), tempdir)
+	require.NoError(t, err)
+	return snapshots.NewManager(store, target), func() { os.RemoveAll(tempdir) }
+}
+
+func TestManager_List(t *testing.T) {
+	manager, teardown := setupManager(t, &mockSnapshotter{chunks: [][]byte{{1, 2, 3}}})
+	defer teardown()
+
+	for _, height := range []uint64{1, 2, 3} {
+		_, err := manager.Create(height)
+		require.NoError(t, err)
+	}
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.EqualValues(t, 3, list[0].Height)
+	assert.EqualValues(t, 1, list[2].Height)
+
+	// creating a snapshot at or below the latest height should error
+	_, err = manager.Create(3)
+	require.Error(t, err)
+}
+
+func TestManager_LoadChunk(t *testing.T) {
+	manager, teardown := setupManager(t, &mockSnapshotter{chunks: [][]byte{{1, 2, 3}, {4, 5, 6}}})
+	defer teardown()
+
+	_, err := manager.Create(1)
+	require.NoError(t, err)
+
+	chunk, err := manager.LoadChunk(1, types.CurrentFormat, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{4, 5, 6}, chunk)
+
+	// missing chunks should return nil
+	chunk, err = manager.LoadChunk(1, types.CurrentFormat, 2)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+	chunk, err = manager.LoadChunk(2, types.CurrentFormat, 0)
+	require.NoError(t, err)
+	assert.Nil(t, chunk)
+}
+
+func TestManager_Prune(t *testing.T) {
+	manager, teardown := setupManager(t, &mockSnapshotter{chunks: [][]byte{{1}}})
+	defer teardown()
+
+	for _, height := range []uint64{1, 2, 3} {
+		_, err := manager.Create(height)
+		require.NoError(t, err)
+	}
+
+	pruned, err := manager.Prune(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, pruned)
+
+	list, err := manager.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+
+	// prune should error while a snapshot is being taken
+	busy, teardown := setupBusyManager(t)
+	defer teardown()
+	_, err = busy.Prune(2)
+	require.Error(t, err)
+}
+
+func TestManager_Restore(t *testing.T) {
+	target := &mockSnapshotter{}
+	manager, teardown := setupManager(t, target)
+	defer teardown()
+
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	// unknown formats should be rejected
+	err := manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   2,
+		Chunks:   3,
+		Hash:     []byte{1, 2, 3},
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrUnknownFormat))
+
+	// invalid metadata should be rejected
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   3,
+		Hash:     []byte{1, 2, 3},
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks[:2])},
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrInvalidMetadata))
+
+	err = manager.Restore(types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Chunks:   3,
+		Hash:     []byte{1, 2, 3},
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	})
+	require.NoError(t, err)
+
+	// other operations should error while a restore is in progress
+	_, err = manager.Create(4)
+	require.Error(t, err)
+	_, err = manager.Prune(1)
+	require.Error(t, err)
+
+	// a chunk with a bad hash should be rejected, but the restore should stay active
+	_, err = manager.RestoreChunk([]byte{9, 9, 9})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == len(chunks)-1, done)
+	}
+	assert.Equal(t, chunks, target.chunks)
+
+	// the restore should now be complete, so more chunks should error
+	_, err = manager.RestoreChunk([]byte{1})
+	require.Error(t, err)
+
+	_, err = manager.Create(4)
+	require.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a stateless validation of the snapshot, ensuring it is
+// self-consistent.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot height cannot be 0")
+	}
+	if s.Format == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot format cannot be 0")
+	}
+	if s.Chunks == 0 {
+		return sdkerrors.Wrap(ErrInvalidMetadata, "snapshot has no chunks")
+	}
+	if len(s.Metadata.ChunkHashes) != int(s.Chunks) {
+		return sdkerrors.Wrapf(
+			ErrInvalidMetadata, "snapshot has %d chunk hashes, but %d chunks",
+			len(s.Metadata.ChunkHashes), s.Chunks,
+		)
+	}
+	for i, hash := range s.Metadata.ChunkHashes {
+		if len(hash) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "snapshot chunk %d hash cannot be empty", i)
+		}
+	}
+
+	return nil
+}
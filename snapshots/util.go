@@ -0,0 +1,30 @@
+package snapshots
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DrainChunks drains and closes all remaining chunks from a chunk channel.
+func DrainChunks(chunks <-chan io.ReadCloser) {
+	for chunk := range chunks {
+		_ = chunk.Close()
+	}
+}
+
+// ValidRestoreHeight checks that a snapshot height is usable for a restore.
+func ValidRestoreHeight(format uint32, height uint64) error {
+	if format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(1<<63-1) {
+		return sdkerrors.Wrapf(types.ErrInvalidSnapshotVersion,
+			"snapshot height %v cannot exceed %v", height, int64(1<<63-1))
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package accesskv
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = &Store{}
+
+// keyRange is the domain [start, end) of an iterator. A nil start or end
+// leaves the domain open on that side.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the key is within the domain of the range.
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// Store implements the KVStore interface and records the read and write sets
+// of the operations made through it. Reads are the keys passed to Get and Has
+// and the domains of the created iterators, writes are the keys passed to Set
+// and Delete. All operations are delegated to the parent KVStore.
+//
+// A Store is meant to be placed between a cache-wrapped store and its parent,
+// so that the reads missing the cache and the writes of the cache are recorded.
+type Store struct {
+	parent types.KVStore
+
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string]struct{}
+}
+
+// NewStore returns a reference to a new accesskv.Store given a parent KVStore.
+func NewStore(parent types.KVStore) *Store {
+	return &Store{
+		parent: parent,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string]struct{}),
+	}
+}
+
+// Get implements the KVStore interface. It records the key as read and
+// delegates the Get call to the parent KVStore.
+func (s *Store) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Get(key)
+}
+
+// Has implements the KVStore interface. It records the key as read and
+// delegates the Has call to the parent KVStore.
+func (s *Store) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+	return s.parent.Has(key)
+}
+
+// Set implements the KVStore interface. It records the key as written and
+// delegates the Set call to the parent KVStore.
+func (s *Store) Set(key []byte, value []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.parent.Set(key, value)
+}
+
+// Delete implements the KVStore interface. It records the key as written and
+// delegates the Delete call to the parent KVStore.
+func (s *Store) Delete(key []byte) {
+	s.writes[string(key)] = struct{}{}
+	s.parent.Delete(key)
+}
+
+// Iterator implements the KVStore interface. It records the domain of the
+// iterator as read and delegates the Iterator call to the parent KVStore.
+func (s *Store) Iterator(start, end []byte) types.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.Iterator(start, end)
+}
+
+// ReverseIterator implements the KVStore interface. It records the domain of
+// the iterator as read and delegates the ReverseIterator call to the parent
+// KVStore.
+func (s *Store) ReverseIterator(start, end []byte) types.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: start, end: end})
+	return s.parent.ReverseIterator(start, end)
+}
+
+// GetStoreType implements the KVStore interface. It returns the underlying
+// KVStore type.
+func (s *Store) GetStoreType() types.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements the KVStore interface. The reads missing the cache and
+// the writes made when the cache is written are recorded by this store.
+func (s *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (s *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Writes returns the set of keys written through the store.
+func (s *Store) Writes() map[string]struct{} {
+	return s.writes
+}
+
+// ReadsAny returns true if any of the given keys was read through the store,
+// either directly or by an iterator whose domain contains it.
+func (s *Store) ReadsAny(keys map[string]struct{}) bool {
+	for key := range keys {
+		if _, ok := s.reads[key]; ok {
+			return true
+		}
+
+		for _, r := range s.ranges {
+			if r.contains([]byte(key)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
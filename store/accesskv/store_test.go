@@ -0,0 +1,115 @@
+package accesskv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newAccessKVStore() *accesskv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	memDB.Set([]byte("a"), []byte("1"))
+	memDB.Set([]byte("c"), []byte("3"))
+
+	return accesskv.NewStore(memDB)
+}
+
+func keySet(keys ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		set[key] = struct{}{}
+	}
+
+	return set
+}
+
+func TestAccessKVStoreReads(t *testing.T) {
+	store := newAccessKVStore()
+
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.False(t, store.Has([]byte("b")))
+
+	require.True(t, store.ReadsAny(keySet("a")))
+	require.True(t, store.ReadsAny(keySet("b")))
+	require.True(t, store.ReadsAny(keySet("x", "b")))
+	require.False(t, store.ReadsAny(keySet("c")))
+	require.False(t, store.ReadsAny(keySet()))
+	require.Empty(t, store.Writes())
+}
+
+func TestAccessKVStoreIterators(t *testing.T) {
+	testCases := []struct {
+		name       string
+		start, end []byte
+		reverse    bool
+		read       []string
+		notRead    []string
+	}{
+		{"bounded", []byte("b"), []byte("d"), false, []string{"b", "c", "cz"}, []string{"a", "d"}},
+		{"bounded reverse", []byte("b"), []byte("d"), true, []string{"b", "c"}, []string{"a", "d", "e"}},
+		{"open start", nil, []byte("b"), false, []string{"", "a", "ab"}, []string{"b", "c"}},
+		{"open end", []byte("b"), nil, true, []string{"b", "zzz"}, []string{"a"}},
+		{"unbounded", nil, nil, false, []string{"a", "b", "z"}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			store := newAccessKVStore()
+
+			var iter types.Iterator
+			if tc.reverse {
+				iter = store.ReverseIterator(tc.start, tc.end)
+			} else {
+				iter = store.Iterator(tc.start, tc.end)
+			}
+			iter.Close()
+
+			for _, key := range tc.read {
+				require.True(t, store.ReadsAny(keySet(key)), key)
+			}
+			for _, key := range tc.notRead {
+				require.False(t, store.ReadsAny(keySet(key)), key)
+			}
+		})
+	}
+}
+
+func TestAccessKVStoreWrites(t *testing.T) {
+	store := newAccessKVStore()
+
+	store.Set([]byte("b"), []byte("2"))
+	store.Delete([]byte("a"))
+
+	require.Equal(t, keySet("a", "b"), store.Writes())
+	require.False(t, store.ReadsAny(store.Writes()))
+
+	// writes are delegated to the parent
+	require.Equal(t, []byte("2"), store.Get([]byte("b")))
+	require.Nil(t, store.Get([]byte("a")))
+}
+
+func TestAccessKVStoreCacheWrap(t *testing.T) {
+	store := newAccessKVStore()
+	cache := store.CacheWrap().(types.CacheKVStore)
+
+	// writes to the cache are recorded when the cache is written
+	cache.Set([]byte("b"), []byte("2"))
+	require.Empty(t, store.Writes())
+
+	// reads served by the cache don't reach the store
+	require.Equal(t, []byte("2"), cache.Get([]byte("b")))
+	require.False(t, store.ReadsAny(keySet("b")))
+
+	require.Equal(t, []byte("3"), cache.Get([]byte("c")))
+	require.True(t, store.ReadsAny(keySet("c")))
+
+	cache.Write()
+	require.Equal(t, keySet("b"), store.Writes())
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
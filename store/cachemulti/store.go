@@ -6,8 +6,10 @@ import (
 
 	dbm "github.com/tendermint/tm-db"
 
+	"github.com/cosmos/cosmos-sdk/store/accesskv"
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -25,16 +27,21 @@ type Store struct {
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CacheMultiStore = Store{}
 
 // NewFromKVStore creates a new Store object from a mapping of store keys to
 // CacheWrapper objects and a KVStore as the database. Each CacheWrapper store
-// is cache-wrapped.
+// is cache-wrapped. Stores with listeners in the given mapping are wrapped with
+// a listenkv.Store first, so the listeners are notified of the writes made to
+// the underlying store when the cache is written.
 func NewFromKVStore(
 	store types.KVStore, stores map[types.StoreKey]types.CacheWrapper,
 	keys map[string]types.StoreKey, traceWriter io.Writer, traceContext types.TraceContext,
+	listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 	cms := Store{
 		db:           cachekv.NewStore(store),
@@ -42,9 +49,14 @@ func NewFromKVStore(
 		keys:         keys,
 		traceWriter:  traceWriter,
 		traceContext: traceContext,
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 
 	for key, store := range stores {
+		if len(listeners[key]) > 0 {
+			store = listenkv.NewStore(store.(types.KVStore), key, listeners[key])
+		}
+
 		if cms.TracingEnabled() {
 			cms.stores[key] = store.CacheWrapWithTrace(cms.traceWriter, cms.traceContext)
 		} else {
@@ -59,10 +71,10 @@ func NewFromKVStore(
 // CacheWrapper objects. Each CacheWrapper store is cache-wrapped.
 func NewStore(
 	db dbm.DB, stores map[types.StoreKey]types.CacheWrapper, keys map[string]types.StoreKey,
-	traceWriter io.Writer, traceContext types.TraceContext,
+	traceWriter io.Writer, traceContext types.TraceContext, listeners map[types.StoreKey][]types.WriteListener,
 ) Store {
 
-	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext)
+	return NewFromKVStore(dbadapter.Store{DB: db}, stores, keys, traceWriter, traceContext, listeners)
 }
 
 func newCacheMultiStoreFromCMS(cms Store) Store {
@@ -71,7 +83,7 @@ func newCacheMultiStoreFromCMS(cms Store) Store {
 		stores[k] = v
 	}
 
-	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext)
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, cms.listeners)
 }
 
 // SetTracer sets the tracer for the MultiStore that the underlying
@@ -102,6 +114,19 @@ func (cms Store) TracingEnabled() bool {
 	return cms.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for the KVStore belonging
+// to the given StoreKey.
+func (cms Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(cms.listeners[key]) > 0
+}
+
+// AddListeners adds WriteListeners for the KVStore belonging to the given
+// StoreKey. The listeners are notified of writes made through GetKVStore, and
+// of the writes made to nested cache-wrapped stores when they are written.
+func (cms Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	cms.listeners[key] = append(cms.listeners[key], listeners...)
+}
+
 // GetStoreType returns the type of the store.
 func (cms Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -130,6 +155,25 @@ func (cms Store) CacheMultiStore() types.CacheMultiStore {
 	return newCacheMultiStoreFromCMS(cms)
 }
 
+// CacheMultiStoreWithAccessTracking cache-wraps the MultiStore like
+// CacheMultiStore, but places an accesskv.Store between each store and its
+// cache. The accesskv stores are returned by store key, they record the reads
+// missing the caches and the writes made when the returned CacheMultiStore is
+// written.
+func (cms Store) CacheMultiStoreWithAccessTracking() (types.CacheMultiStore, map[types.StoreKey]*accesskv.Store) {
+	trackers := make(map[types.StoreKey]*accesskv.Store, len(cms.stores))
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(cms.stores))
+
+	for key := range cms.stores {
+		// GetKVStore wraps the store with the listeners, if any
+		tracker := accesskv.NewStore(cms.GetKVStore(key))
+		trackers[key] = tracker
+		stores[key] = tracker
+	}
+
+	return NewFromKVStore(cms.db, stores, nil, cms.traceWriter, cms.traceContext, nil), trackers
+}
+
 // CacheMultiStoreWithVersion implements the MultiStore interface. It will panic
 // as an already cached multi-store cannot load previous versions.
 //
@@ -150,5 +194,11 @@ func (cms Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if key == nil {
 		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
 	}
-	return store.(types.KVStore)
+
+	kvStore := store.(types.KVStore)
+	if cms.ListeningEnabled(key) {
+		kvStore = listenkv.NewStore(kvStore, key, cms.listeners[key])
+	}
+
+	return kvStore
 }
@@ -3,17 +3,30 @@ package gaskv
 import (
 	"io"
 
+	"github.com/armon/go-metrics"
+
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 var _ types.KVStore = &Store{}
 
+// telemetry metric keys
+var (
+	metricBytesRead     = []string{"store", "bytes_read"}
+	metricBytesWritten  = []string{"store", "bytes_written"}
+	metricIteratorSteps = []string{"store", "iterator_steps"}
+)
+
 // Store applies gas tracking to an underlying KVStore. It implements the
 // KVStore interface.
 type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+
+	// labels of the telemetry metrics, nil if they are not reported
+	labels []metrics.Label
 }
 
 // NewStore returns a reference to a new GasKVStore.
@@ -27,6 +40,16 @@ func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.Gas
 	return kvs
 }
 
+// NewStoreWithTelemetry returns a reference to a new GasKVStore which also
+// reports the bytes of the keys and values it reads and writes and its
+// iterator steps to telemetry, labelled by the given store key.
+func NewStoreWithTelemetry(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig, key types.StoreKey) *Store {
+	kvs := NewStore(parent, gasMeter, gasConfig)
+	kvs.labels = []metrics.Label{telemetry.NewLabel(telemetry.MetricLabelNameStoreKey, key.Name())}
+
+	return kvs
+}
+
 // Implements Store.
 func (gs *Store) GetStoreType() types.StoreType {
 	return gs.parent.GetStoreType()
@@ -39,6 +62,7 @@ func (gs *Store) Get(key []byte) (value []byte) {
 
 	// TODO overflow-safe math?
 	gs.gasMeter.ConsumeGas(gs.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasReadPerByteDesc)
+	gs.incrCounter(metricBytesRead, len(key)+len(value))
 
 	return value
 }
@@ -49,6 +73,7 @@ func (gs *Store) Set(key []byte, value []byte) {
 	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
 	// TODO overflow-safe math?
 	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(value)), types.GasWritePerByteDesc)
+	gs.incrCounter(metricBytesWritten, len(key)+len(value))
 	gs.parent.Set(key, value)
 }
 
@@ -98,25 +123,38 @@ func (gs *Store) iterator(start, end []byte, ascending bool) types.Iterator {
 		parent = gs.parent.ReverseIterator(start, end)
 	}
 
-	gi := newGasIterator(gs.gasMeter, gs.gasConfig, parent)
+	gi := newGasIterator(gs, parent)
 	if gi.Valid() {
-		gi.(*gasIterator).consumeSeekGas()
+		gi.consumeSeekGas()
 	}
+	gi.reportStep()
 
 	return gi
 }
 
+// incrCounter increments the given telemetry counter if the store reports
+// metrics.
+func (gs *Store) incrCounter(keys []string, val int) {
+	if gs.labels == nil {
+		return
+	}
+
+	telemetry.IncrCounterWithLabels(keys, float32(val), gs.labels)
+}
+
 type gasIterator struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.Iterator
+	store     *Store
 }
 
-func newGasIterator(gasMeter types.GasMeter, gasConfig types.GasConfig, parent types.Iterator) types.Iterator {
+func newGasIterator(store *Store, parent types.Iterator) *gasIterator {
 	return &gasIterator{
-		gasMeter:  gasMeter,
-		gasConfig: gasConfig,
+		gasMeter:  store.gasMeter,
+		gasConfig: store.gasConfig,
 		parent:    parent,
+		store:     store,
 	}
 }
 
@@ -139,6 +177,7 @@ func (gi *gasIterator) Next() {
 	}
 
 	gi.parent.Next()
+	gi.reportStep()
 }
 
 // Key implements the Iterator interface. It returns the current key and it does
@@ -173,3 +212,14 @@ func (gi *gasIterator) consumeSeekGas() {
 	gi.gasMeter.ConsumeGas(gi.gasConfig.ReadCostPerByte*types.Gas(len(value)), types.GasValuePerByteDesc)
 	gi.gasMeter.ConsumeGas(gi.gasConfig.IterNextCostFlat, types.GasIterNextCostFlatDesc)
 }
+
+// reportStep reports an iterator step to the current key/value pair to
+// telemetry if the store reports metrics and the iterator is valid.
+func (gi *gasIterator) reportStep() {
+	if gi.store.labels == nil || !gi.Valid() {
+		return
+	}
+
+	gi.store.incrCounter(metricIteratorSteps, 1)
+	gi.store.incrCounter(metricBytesRead, len(gi.Key())+len(gi.Value()))
+}
@@ -1,6 +1,7 @@
 package gaskv_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"github.com/stretchr/testify/require"
 )
@@ -95,3 +97,47 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewGasMeter(100000)
+	st := gaskv.NewStoreWithTelemetry(mem, meter, types.KVGasConfig(), types.NewKVStoreKey("mystore"))
+
+	st.Set(keyFmt(1), valFmt(1))
+	st.Set(keyFmt(2), valFmt(2))
+	require.Equal(t, valFmt(1), st.Get(keyFmt(1)))
+
+	iterator := st.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+	}
+	iterator.Close()
+
+	gr, err := m.Gather(telemetry.FormatText)
+	require.NoError(t, err)
+
+	var jsonMetrics struct {
+		Counters []struct {
+			Name   string
+			Count  int
+			Sum    float64
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(gr.Metrics, &jsonMetrics))
+
+	sums := make(map[string]float64)
+	for _, counter := range jsonMetrics.Counters {
+		require.Equal(t, "mystore", counter.Labels[telemetry.MetricLabelNameStoreKey])
+		sums[counter.Name] = counter.Sum
+	}
+
+	// keys and values are 11 and 13 bytes long
+	require.Equal(t, map[string]float64{
+		"test.store.bytes_written":  2 * 24,
+		"test.store.bytes_read":     3 * 24,
+		"test.store.iterator_steps": 2,
+	}, sums)
+}
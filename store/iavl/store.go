@@ -26,10 +26,11 @@ const (
 )
 
 var (
-	_ types.KVStore       = (*Store)(nil)
-	_ types.CommitStore   = (*Store)(nil)
-	_ types.CommitKVStore = (*Store)(nil)
-	_ types.Queryable     = (*Store)(nil)
+	_ types.KVStore         = (*Store)(nil)
+	_ types.CommitStore     = (*Store)(nil)
+	_ types.CommitKVStore   = (*Store)(nil)
+	_ types.Queryable       = (*Store)(nil)
+	_ types.CommitmentStore = (*Store)(nil)
 )
 
 // Store Implements types.KVStore and CommitKVStore.
@@ -121,6 +122,17 @@ func (st *Store) GetImmutable(version int64) (*Store, error) {
 	}, nil
 }
 
+// GetImmutableVersion implements types.CommitmentStore. It returns the store
+// returned by GetImmutable.
+func (st *Store) GetImmutableVersion(version int64) (types.KVStore, error) {
+	store, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
 // Commit commits the current store state and returns a CommitID with the new
 // version and hash.
 func (st *Store) Commit() types.CommitID {
@@ -170,6 +182,19 @@ func (st *Store) VersionExists(version int64) bool {
 	return st.tree.VersionExists(version)
 }
 
+// AvailableVersions returns the versions retained by the store, i.e. the
+// versions flushed to disk and not pruned yet and the versions kept in memory,
+// in ascending order.
+func (st *Store) AvailableVersions() []int64 {
+	versions := st.tree.AvailableVersions()
+	res := make([]int64, len(versions))
+	for i, v := range versions {
+		res[i] = int64(v)
+	}
+
+	return res
+}
+
 // Implements Store.
 func (st *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeIAVL
@@ -235,6 +260,28 @@ func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
 	return newIAVLIterator(iTree, start, end, false)
 }
 
+// Export exports the IAVL store at the given version, returning an iavl.Exporter for the tree.
+func (st *Store) Export(version int64) (*iavl.Exporter, error) {
+	istore, err := st.GetImmutable(version)
+	if err != nil {
+		return nil, errors.Wrapf(err, "iavl export failed for version %v", version)
+	}
+	tree, ok := istore.tree.(*immutableTree)
+	if !ok || tree == nil {
+		return nil, fmt.Errorf("iavl export failed: unable to fetch tree for version %v", version)
+	}
+	return tree.Export(), nil
+}
+
+// Import imports an IAVL tree at the given version, returning an iavl.Importer for importing.
+func (st *Store) Import(version int64) (*iavl.Importer, error) {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return nil, errors.New("iavl import failed: unable to find mutable tree")
+	}
+	return tree.Import(version)
+}
+
 // Handle gatest the latest height, if height is 0
 func getHeight(tree Tree, req abci.RequestQuery) int64 {
 	height := req.Height
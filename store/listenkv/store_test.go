@@ -0,0 +1,95 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var testStoreKey = types.NewKVStoreKey("listen_test")
+
+type write struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+type mockListener struct {
+	writes []write
+}
+
+func (l *mockListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) {
+	if storeKey != testStoreKey {
+		panic("unexpected store key")
+	}
+	l.writes = append(l.writes, write{key, value, delete})
+}
+
+func newListenKVStore(listener types.WriteListener) *listenkv.Store {
+	memDB := dbadapter.Store{DB: dbm.NewMemDB()}
+	return listenkv.NewStore(memDB, testStoreKey, []types.WriteListener{listener})
+}
+
+func TestListenKVStoreSetDelete(t *testing.T) {
+	listener := &mockListener{}
+	store := newListenKVStore(listener)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Delete([]byte("key1"))
+
+	require.Nil(t, store.Get([]byte("key1")))
+	require.Equal(t, []byte("value2"), store.Get([]byte("key2")))
+	require.Equal(t, []write{
+		{[]byte("key1"), []byte("value1"), false},
+		{[]byte("key2"), []byte("value2"), false},
+		{[]byte("key1"), nil, true},
+	}, listener.writes)
+}
+
+func TestListenKVStoreReads(t *testing.T) {
+	listener := &mockListener{}
+	store := newListenKVStore(listener)
+	store.Set([]byte("key1"), []byte("value1"))
+	listener.writes = nil
+
+	require.True(t, store.Has([]byte("key1")))
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		require.Equal(t, []byte("key1"), iter.Key())
+	}
+	iter.Close()
+
+	require.Empty(t, listener.writes)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	listener := &mockListener{}
+	store := newListenKVStore(listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key2"), []byte("value2"))
+	cache.Set([]byte("key1"), []byte("value1"))
+	cache.Set([]byte("key3"), []byte("value3"))
+	cache.Delete([]byte("key3"))
+	require.Empty(t, listener.writes)
+
+	cache.Write()
+	require.Equal(t, []write{
+		{[]byte("key1"), []byte("value1"), false},
+		{[]byte("key2"), []byte("value2"), false},
+		{[]byte("key3"), nil, true},
+	}, listener.writes)
+}
+
+func TestListenKVStoreGetStoreType(t *testing.T) {
+	store := newListenKVStore(&mockListener{})
+	require.Equal(t, types.StoreTypeDB, store.GetStoreType())
+}
@@ -7,6 +7,7 @@ import (
 // Import cosmos-sdk/types/store.go for convenience.
 type (
 	PruningOptions   = types.PruningOptions
+	PruningInfo      = types.PruningInfo
 	Store            = types.Store
 	Committer        = types.Committer
 	CommitStore      = types.CommitStore
@@ -25,6 +26,8 @@ type (
 	Type             = types.StoreType
 	Queryable        = types.Queryable
 	TraceContext     = types.TraceContext
+	WriteListener    = types.WriteListener
+	StoreKVPair      = types.StoreKVPair
 	Gas              = types.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = types.GasConfig
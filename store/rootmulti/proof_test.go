@@ -3,6 +3,7 @@ package rootmulti
 import (
 	"testing"
 
+	ics23 "github.com/confio/ics23/go"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
@@ -146,3 +147,95 @@ func TestVerifyMultiStoreQueryProofAbsence(t *testing.T) {
 	err = prt.VerifyValue(res.Proof, cid.Hash, "/iavlStoreKey/MYABSENTKEY", []byte(""))
 	require.NotNil(t, err)
 }
+
+func TestMultiStoreQueryProofICS23(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	iavlStoreKey := types.NewKVStoreKey("iavlStoreKey")
+	otherStoreKey := types.NewKVStoreKey("otherStoreKey")
+
+	store.MountStoreWithDB(iavlStoreKey, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(otherStoreKey, types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	store.GetCommitKVStore(iavlStoreKey).Set([]byte("MYKEY"), []byte("MYVALUE"))
+	store.GetCommitKVStore(otherStoreKey).Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	specs := types.ProofSpecs()
+
+	// decodeProofs returns the ics23 proofs of a query, from the store layer
+	// to the multi-store layer.
+	decodeProofs := func(res abci.ResponseQuery) []*ics23.CommitmentProof {
+		require.Len(t, res.Proof.Ops, len(specs))
+
+		proofs := make([]*ics23.CommitmentProof, len(res.Proof.Ops))
+		for i, op := range res.Proof.Ops {
+			spec, err := types.GetProofSpec(op.Type)
+			require.NoError(t, err)
+			require.Equal(t, specs[i], spec)
+
+			proofs[i] = &ics23.CommitmentProof{}
+			require.NoError(t, proofs[i].Unmarshal(op.Data))
+		}
+
+		return proofs
+	}
+
+	res := store.Query(abci.RequestQuery{Path: "/iavlStoreKey/key", Data: []byte("MYKEY"), Prove: true})
+	proofs := decodeProofs(res)
+
+	storeRoot, err := proofs[0].Calculate()
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyMembership(specs[0], storeRoot, proofs[0], []byte("MYKEY"), []byte("MYVALUE")))
+	require.True(t, ics23.VerifyMembership(specs[1], cid.Hash, proofs[1], []byte("iavlStoreKey"), storeRoot))
+	require.False(t, ics23.VerifyMembership(specs[1], cid.Hash, proofs[1], []byte("otherStoreKey"), storeRoot))
+
+	res = store.Query(abci.RequestQuery{Path: "/iavlStoreKey/key", Data: []byte("MYABSENTKEY"), Prove: true})
+	proofs = decodeProofs(res)
+
+	storeRoot, err = proofs[0].Calculate()
+	require.NoError(t, err)
+	require.True(t, ics23.VerifyNonMembership(specs[0], storeRoot, proofs[0], []byte("MYABSENTKEY")))
+	require.False(t, ics23.VerifyNonMembership(specs[0], storeRoot, proofs[0], []byte("MYKEY")))
+	require.True(t, ics23.VerifyMembership(specs[1], cid.Hash, proofs[1], []byte("iavlStoreKey"), storeRoot))
+}
+
+func TestVerifyMultiStoreQueryProofSMT(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewStore(db)
+	smtStoreKey := types.NewKVStoreKey("smtStoreKey")
+
+	store.MountStoreWithDB(smtStoreKey, types.StoreTypeSMT, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	smtStore := store.GetCommitKVStore(smtStoreKey)
+	smtStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	smtStore.Set([]byte("OTHERKEY"), []byte("OTHERVALUE"))
+	cid := store.Commit()
+
+	res := store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key",
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+	require.NotNil(t, res.Proof)
+
+	prt := DefaultProofRuntime()
+	err := prt.VerifyValue(res.Proof, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE"))
+	require.Nil(t, err)
+
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/smtStoreKey/MYKEY", []byte("MYVALUE_NOT"))
+	require.NotNil(t, err)
+
+	err = prt.VerifyValue(res.Proof, cid.Hash, "/smtStoreKey/OTHERKEY", []byte("MYVALUE"))
+	require.NotNil(t, err)
+
+	// the absence of a key cannot be proven
+	res = store.Query(abci.RequestQuery{
+		Path:  "/smtStoreKey/key",
+		Data:  []byte("MYABSENTKEY"),
+		Prove: true,
+	})
+	require.False(t, res.IsOK())
+}
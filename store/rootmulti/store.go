@@ -1,20 +1,30 @@
 package rootmulti
 
 import (
+	"bufio"
+	"compress/zlib"
 	"fmt"
 	"io"
+	"math"
+	"sort"
 	"strings"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/pkg/errors"
+	iavltree "github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
+	"github.com/cosmos/cosmos-sdk/store/smt"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -27,6 +37,11 @@ import (
 const (
 	latestVersionKey = "s/latest"
 	commitInfoKeyFmt = "s/%d" // s/<version>
+
+	// Do not change chunk size without new snapshot format (must be uniform across nodes)
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
 )
 
 var cdc = codec.New()
@@ -47,10 +62,13 @@ type Store struct {
 	traceContext types.TraceContext
 
 	interBlockCache types.MultiStorePersistentCache
+
+	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var _ types.CommitMultiStore = (*Store)(nil)
 var _ types.Queryable = (*Store)(nil)
+var _ snapshottypes.Snapshotter = (*Store)(nil)
 
 // NewStore returns a reference to a new Store object with the provided DB. The
 // store will be created with a PruneNothing pruning strategy by default. After
@@ -63,6 +81,7 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitKVStore),
 		keysByName:   make(map[string]types.StoreKey),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -79,6 +98,82 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// GetPruning returns the pruning strategy of the root store.
+func (rs *Store) GetPruning() types.PruningOptions {
+	return rs.pruningOpts
+}
+
+// AvailableVersions returns the versions retained by all the commitment
+// stores in ascending order, i.e. the versions which can be loaded with
+// CacheMultiStoreWithVersion and queried.
+func (rs *Store) AvailableVersions() []int64 {
+	var versions []int64
+
+	first := true
+	for key := range rs.stores {
+		// unwrap the inter-block cache if any to get the underlying store
+		store, ok := rs.GetCommitKVStore(key).(types.CommitmentStore)
+		if !ok {
+			continue
+		}
+
+		storeVersions := store.AvailableVersions()
+		if first {
+			versions = storeVersions
+			first = false
+			continue
+		}
+
+		versions = intersectVersions(versions, storeVersions)
+	}
+
+	return versions
+}
+
+// versionPrunedError returns a VersionPrunedError if the given version is a
+// past version which is not retained by the root store, and nil otherwise.
+func (rs *Store) versionPrunedError(version int64) error {
+	if version <= 0 || version >= rs.lastCommitInfo.Version {
+		return nil
+	}
+
+	versions := rs.AvailableVersions()
+
+	i := sort.Search(len(versions), func(i int) bool { return versions[i] >= version })
+	if i < len(versions) && versions[i] == version {
+		return nil
+	}
+
+	earliest := rs.lastCommitInfo.Version
+	if len(versions) > 0 {
+		earliest = versions[0]
+	}
+
+	return &types.VersionPrunedError{Version: version, EarliestVersion: earliest}
+}
+
+// intersectVersions returns the versions present in both of the given sorted
+// slices of versions.
+func intersectVersions(a, b []int64) []int64 {
+	res := make([]int64, 0, len(a))
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+
+		case a[i] > b[j]:
+			j++
+
+		default:
+			res = append(res, a[i])
+			i++
+			j++
+		}
+	}
+
+	return res
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -284,6 +379,20 @@ func (rs *Store) TracingEnabled() bool {
 	return rs.traceWriter != nil
 }
 
+// ListeningEnabled returns if listening is enabled for the KVStore belonging
+// to the given StoreKey.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) > 0
+}
+
+// AddListeners adds WriteListeners for the KVStore belonging to the given
+// StoreKey. The listeners are notified of writes made through GetKVStore, and
+// of the writes flushed from cache-wrapped stores, e.g. when the DeliverTx
+// state is written on Commit.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
 //----------------------------------------
 // +CommitStore
 
@@ -333,37 +442,40 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 		stores[k] = v
 	}
 
-	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext, rs.listeners)
 }
 
 // CacheMultiStoreWithVersion is analogous to CacheMultiStore except that it
 // attempts to load stores at a given version (height). An error is returned if
-// any store cannot be loaded. This should only be used for querying and
-// iterating at past heights.
+// any store cannot be loaded, which is a VersionPrunedError if the version is
+// not retained anymore. This should only be used for querying and iterating at
+// past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
-		switch store.GetStoreType() {
-		case types.StoreTypeIAVL:
-			// If the store is wrapped with an inter-block cache, we must first unwrap
-			// it to get the underlying IAVL store.
-			store = rs.GetCommitKVStore(key)
-
-			// Attempt to lazy-load an already saved IAVL store version. If the
-			// version does not exist or is pruned, an error should be returned.
-			iavlStore, err := store.(*iavl.Store).GetImmutable(version)
-			if err != nil {
-				return nil, err
-			}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying commitment store.
+		commitmentStore, ok := rs.GetCommitKVStore(key).(types.CommitmentStore)
+		if !ok {
+			cachedStores[key] = store
+			continue
+		}
 
-			cachedStores[key] = iavlStore
+		// Attempt to lazy-load an already saved store version. If the version
+		// does not exist or is pruned, an error should be returned.
+		versionStore, err := commitmentStore.GetImmutableVersion(version)
+		if err != nil {
+			if prunedErr := rs.versionPrunedError(version); prunedErr != nil {
+				return nil, prunedErr
+			}
 
-		default:
-			cachedStores[key] = store
+			return nil, err
 		}
+
+		cachedStores[key] = versionStore
 	}
 
-	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.traceContext, nil), nil
 }
 
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
@@ -393,6 +505,9 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
 	}
+	if rs.ListeningEnabled(key) {
+		store = listenkv.NewStore(store, key, rs.listeners[key])
+	}
 
 	return store
 }
@@ -415,7 +530,11 @@ func (rs *Store) getStoreByName(name string) types.Store {
 // Query calls substore.Query with the same `req` where `req.Path` is
 // modified to remove the substore prefix.
 // Ie. `req.Path` here is `/<substore>/<path>`, and trimmed to `/<path>` for the substore.
-// TODO: add proof for `multistore -> substore`.
+//
+// When a proof is requested, the response holds ics23 CommitmentProofs as
+// merkle.ProofOps: the substore's proof of the key, followed by the proof of
+// the substore's root hash in the multi-store, whose specs are returned by
+// types.ProofSpecs for IAVL substores.
 func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 	path := req.Path
 	storeName, subpath, err := parsePath(path)
@@ -433,6 +552,12 @@ func (rs *Store) Query(req abci.RequestQuery) abci.ResponseQuery {
 		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "store %s (type %T) doesn't support queries", storeName, store))
 	}
 
+	if commitmentStore, ok := store.(types.CommitmentStore); ok && !commitmentStore.VersionExists(req.Height) {
+		if err := rs.versionPrunedError(req.Height); err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+	}
+
 	// trim the path and make the query
 	req.Path = subpath
 	res := queryable.Query(req)
@@ -483,7 +608,307 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 	return storeName, subpath, nil
 }
 
-//----------------------------------------
+//---------------------- Snapshotting ------------------
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together. If the output for a
+// given format changes (at the byte level), the snapshot format must be bumped - see
+// TestMultistoreSnapshot_Checksum test.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight, "cannot snapshot future height %v", height)
+	}
+
+	// Collect stores to snapshot (only IAVL and SMT stores are supported)
+	type namedStore struct {
+		types.CommitKVStore
+		name string
+	}
+	stores := []namedStore{}
+	for key := range rs.stores {
+		switch store := rs.GetCommitKVStore(key).(type) {
+		case *iavl.Store, *smt.Store:
+			stores = append(stores, namedStore{name: key.Name(), CommitKVStore: store})
+		case *transient.Store, *mem.Store:
+			// Non-persisted stores shouldn't be snapshotted
+			continue
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType,
+				"don't know how to snapshot store %q of type %T", key.Name(), store)
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool {
+		return strings.Compare(stores[i].name, stores[j].name) == -1
+	})
+
+	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize snapshot nodes:
+		// ExportNode -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		defer chunkWriter.Close()
+		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+		defer func() {
+			if err := bufWriter.Flush(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+		defer func() {
+			if err := zWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		defer func() {
+			if err := protoWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		// Export each store. Stores are serialized as a stream of SnapshotItem Protobuf
+		// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+		// and the following messages contain a SnapshotNode (i.e. an ExportNode) for IAVL
+		// stores or a SnapshotKVItem for SMT stores. Store changes are demarcated by new
+		// SnapshotStore items.
+		for _, store := range stores {
+			err := protoWriter.WriteMsg(&types.SnapshotItem{
+				Item: &types.SnapshotItem_Store{
+					Store: &types.SnapshotStoreItem{
+						Name: store.name,
+					},
+				},
+			})
+			if err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+
+			if smtStore, ok := store.CommitKVStore.(*smt.Store); ok {
+				err = smtStore.Export(int64(height), func(key, value []byte) error {
+					return protoWriter.WriteMsg(&types.SnapshotItem{
+						Item: &types.SnapshotItem_KV{
+							KV: &types.SnapshotKVItem{
+								Key:   key,
+								Value: value,
+							},
+						},
+					})
+				})
+				if err != nil {
+					chunkWriter.CloseWithError(err)
+					return
+				}
+				continue
+			}
+
+			exporter, err := store.CommitKVStore.(*iavl.Store).Export(int64(height))
+			if err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+			defer exporter.Close()
+
+			for {
+				node, err := exporter.Next()
+				if err == iavltree.ExportDone {
+					break
+				} else if err != nil {
+					chunkWriter.CloseWithError(err)
+					return
+				}
+				err = protoWriter.WriteMsg(&types.SnapshotItem{
+					Item: &types.SnapshotItem_IAVL{
+						IAVL: &types.SnapshotIAVLItem{
+							Key:     node.Key,
+							Value:   node.Value,
+							Height:  int32(node.Height),
+							Version: node.Version,
+						},
+					},
+				})
+				if err != nil {
+					chunkWriter.CloseWithError(err)
+					return
+				}
+			}
+			exporter.Close()
+		}
+	}()
+
+	return ch, nil
+}
+
+// Restore implements snapshottypes.Snapshotter.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if err := snapshots.ValidRestoreHeight(format, height); err != nil {
+		return err
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> ExportNode
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
+	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
+	// SnapshotNodeItem (i.e. ExportNode) for IAVL stores or SnapshotKVItem for SMT stores until we
+	// reach the next SnapshotStoreItem or EOF.
+	var (
+		importer    *iavltree.Importer
+		smtImporter *smt.Importer
+	)
+	commitImporters := func() error {
+		if importer != nil {
+			if err := importer.Commit(); err != nil {
+				return sdkerrors.Wrap(err, "IAVL commit failed")
+			}
+			importer.Close()
+			importer = nil
+		}
+		if smtImporter != nil {
+			if err := smtImporter.Commit(); err != nil {
+				return sdkerrors.Wrap(err, "SMT commit failed")
+			}
+			smtImporter = nil
+		}
+		return nil
+	}
+
+	for {
+		item := &types.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *types.SnapshotItem_Store:
+			if err := commitImporters(); err != nil {
+				return err
+			}
+
+			switch store := rs.getStoreByName(item.Store.Name).(type) {
+			case *iavl.Store:
+				importer, err = store.Import(int64(height))
+				if err != nil {
+					return sdkerrors.Wrap(err, "import failed")
+				}
+				defer importer.Close()
+
+			case *smt.Store:
+				smtImporter, err = store.Import(int64(height))
+				if err != nil {
+					return sdkerrors.Wrap(err, "import failed")
+				}
+
+			default:
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "cannot import into store %q of type %T", item.Store.Name, store)
+			}
+
+		case *types.SnapshotItem_IAVL:
+			if importer == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "received IAVL node item before store item")
+			}
+			if item.IAVL.Height > math.MaxInt8 {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "node height %v cannot exceed %v",
+					item.IAVL.Height, math.MaxInt8)
+			}
+			node := &iavltree.ExportNode{
+				Key:     item.IAVL.Key,
+				Value:   item.IAVL.Value,
+				Height:  int8(item.IAVL.Height),
+				Version: item.IAVL.Version,
+			}
+			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+			if node.Key == nil {
+				node.Key = []byte{}
+			}
+			if node.Height == 0 && node.Value == nil {
+				node.Value = []byte{}
+			}
+			err := importer.Add(node)
+			if err != nil {
+				return sdkerrors.Wrap(err, "IAVL node import failed")
+			}
+
+		case *types.SnapshotItem_KV:
+			if smtImporter == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "received KV item before SMT store item")
+			}
+			// Protobuf does not differentiate between []byte{} and nil, while store values are never nil.
+			value := item.KV.Value
+			if value == nil {
+				value = []byte{}
+			}
+			if err := smtImporter.Add(item.KV.Key, value); err != nil {
+				return sdkerrors.Wrap(err, "SMT item import failed")
+			}
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown snapshot item %T", item)
+		}
+	}
+
+	if err := commitImporters(); err != nil {
+		return err
+	}
+
+	flushCommitInfo(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
+	return rs.LoadLatestVersion()
+}
+
+// buildCommitInfo builds a commitInfo for the given version from the last
+// commit IDs of the mounted stores, skipping transient stores.
+func (rs *Store) buildCommitInfo(version int64) commitInfo {
+	storeInfos := []storeInfo{}
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient {
+			continue
+		}
+
+		si := storeInfo{}
+		si.Name = key.Name()
+		si.Core.CommitID = store.LastCommitID()
+		storeInfos = append(storeInfos, si)
+	}
+
+	return commitInfo{
+		Version:    version,
+		StoreInfos: storeInfos,
+	}
+}
+
+// ----------------------------------------
 // Note: why do we use key and params.key in different places. Seems like there should be only one key used.
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
 	var db dbm.DB
@@ -514,6 +939,18 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 
 		return store, err
 
+	case types.StoreTypeSMT:
+		store, err := smt.LoadStore(db, id, rs.pruningOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		if rs.interBlockCache != nil {
+			store = rs.interBlockCache.GetStoreCache(key, store)
+		}
+
+		return store, nil
+
 	case types.StoreTypeDB:
 		return commitDBStoreAdapter{Store: dbadapter.Store{DB: db}}, nil
 
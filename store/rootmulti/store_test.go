@@ -1,6 +1,7 @@
 package rootmulti
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -8,6 +9,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -89,6 +91,49 @@ func TestCacheMultiStoreWithVersion(t *testing.T) {
 	})
 }
 
+func TestCacheMultiStoreWithPrunedVersion(t *testing.T) {
+	var db dbm.DB = dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruningOptions{KeepEvery: 2, SnapshotEvery: 4})
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Equal(t, types.PruningOptions{KeepEvery: 2, SnapshotEvery: 4}, ms.GetPruning())
+
+	k, v := []byte("wind"), []byte("blows")
+	for i := 0; i < 10; i++ {
+		ms.getStoreByName("store1").(types.KVStore).Set(k, v)
+		ms.Commit()
+	}
+
+	// snapshot versions, the last flushed version and the in-memory versions
+	// are retained
+	versions := ms.AvailableVersions()
+	require.Equal(t, int64(4), versions[0])
+	require.Equal(t, int64(10), versions[len(versions)-1])
+
+	for _, version := range versions {
+		_, err := ms.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+	}
+
+	// past versions which are not retained are reported as pruned
+	for _, version := range []int64{1, 3, 5} {
+		_, err := ms.CacheMultiStoreWithVersion(version)
+		require.True(t, errors.Is(err, types.ErrVersionPruned))
+
+		var prunedErr *types.VersionPrunedError
+		require.True(t, errors.As(err, &prunedErr))
+		require.Equal(t, &types.VersionPrunedError{Version: version, EarliestVersion: 4}, prunedErr)
+
+		res := ms.Query(abci.RequestQuery{Path: "/store1/key", Data: k, Height: version})
+		require.Equal(t, types.StoreCodespace, res.Codespace)
+		require.Equal(t, types.ErrVersionPruned.ABCICode(), res.Code)
+	}
+
+	// future versions are not
+	_, err := ms.CacheMultiStoreWithVersion(11)
+	require.Error(t, err)
+	require.False(t, errors.Is(err, types.ErrVersionPruned))
+}
+
 func TestHashStableWithEmptyCommit(t *testing.T) {
 	var db dbm.DB = dbm.NewMemDB()
 	ms := newMultiStoreWithMounts(db, types.PruneNothing)
@@ -442,9 +487,204 @@ func TestMultiStoreQuery(t *testing.T) {
 	require.Equal(t, v2, qres.Value)
 }
 
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, source.LoadLatestVersion())
+
+	for v := 1; v <= 3; v++ {
+		for _, name := range []string{"store1", "store2", "store3"} {
+			store := source.getStoreByName(name).(types.KVStore)
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("%s-v%d-%03d", name, v, i)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%03d", v)))
+		}
+		source.Commit()
+	}
+	commitID := source.LastCommitID()
+
+	chunks, err := source.Snapshot(uint64(commitID.Version), snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	target := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, target.LoadLatestVersion())
+
+	ready := make(chan struct{})
+	err = target.Restore(uint64(commitID.Version), snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	<-ready
+
+	require.Equal(t, commitID, target.LastCommitID())
+	for _, name := range []string{"store1", "store2", "store3"} {
+		sourceStore := source.getStoreByName(name).(types.KVStore)
+		targetStore := target.getStoreByName(name).(types.KVStore)
+		require.Equal(t, sourceStore.Get([]byte("key005")), targetStore.Get([]byte("key005")))
+		require.Nil(t, targetStore.Get([]byte("key003")))
+	}
+
+	// the restored commit info must have been persisted
+	reloaded := newMultiStoreWithMounts(target.db, types.PruneNothing)
+	require.NoError(t, reloaded.LoadLatestVersion())
+	require.Equal(t, commitID, reloaded.LastCommitID())
+}
+
+func TestMultistoreSnapshotRestoreSMT(t *testing.T) {
+	newStore := func(db dbm.DB) *Store {
+		store := NewStore(db)
+		store.pruningOpts = types.PruneNothing
+		store.MountStoreWithDB(types.NewKVStoreKey("iavl"), types.StoreTypeIAVL, nil)
+		store.MountStoreWithDB(types.NewKVStoreKey("smt"), types.StoreTypeSMT, nil)
+		return store
+	}
+
+	source := newStore(dbm.NewMemDB())
+	require.NoError(t, source.LoadLatestVersion())
+
+	for v := 1; v <= 3; v++ {
+		for _, name := range []string{"iavl", "smt"} {
+			store := source.getStoreByName(name).(types.KVStore)
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("%s-v%d-%03d", name, v, i)))
+			}
+			store.Delete([]byte(fmt.Sprintf("key%03d", v)))
+		}
+		source.Commit()
+	}
+	commitID := source.LastCommitID()
+
+	chunks, err := source.Snapshot(uint64(commitID.Version), snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+
+	target := newStore(dbm.NewMemDB())
+	require.NoError(t, target.LoadLatestVersion())
+
+	ready := make(chan struct{})
+	err = target.Restore(uint64(commitID.Version), snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	<-ready
+
+	// the restored SMT store commits to the same root
+	require.Equal(t, commitID, target.LastCommitID())
+
+	sourceStore := source.getStoreByName("smt").(types.KVStore)
+	targetStore := target.getStoreByName("smt").(types.KVStore)
+	require.Equal(t, sourceStore.Get([]byte("key005")), targetStore.Get([]byte("key005")))
+	require.Nil(t, targetStore.Get([]byte("key003")))
+
+	// a snapshot of a store with data can't be restored into it
+	chunks, err = source.Snapshot(uint64(commitID.Version), snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	require.Error(t, target.Restore(uint64(commitID.Version), snapshottypes.CurrentFormat, chunks, nil))
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+	store.Commit()
+
+	testcases := map[string]struct {
+		height uint64
+		format uint32
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat},
+		"0 format":       {1, 0},
+		"unknown format": {1, 9},
+		"future height":  {2, snapshottypes.CurrentFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, store.LoadLatestVersion())
+
+	testcases := map[string]struct {
+		height uint64
+		format uint32
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat},
+		"0 format":       {1, 0},
+		"unknown format": {1, 9},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, nil, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
 //-----------------------------------------------------------------------
 // utils
 
+type mockWriteListener struct {
+	pairs []types.StoreKVPair
+}
+
+func (l *mockWriteListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) {
+	l.pairs = append(l.pairs, types.StoreKVPair{StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+}
+
+func TestMultistoreListeners(t *testing.T) {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB(), types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+	listener := &mockWriteListener{}
+	require.False(t, ms.ListeningEnabled(key1))
+	ms.AddListeners(key1, []types.WriteListener{listener})
+	require.True(t, ms.ListeningEnabled(key1))
+	require.False(t, ms.ListeningEnabled(key2))
+
+	// direct writes to a listened store are passed to the listener
+	ms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(key2).Set([]byte("b"), []byte("2"))
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")},
+	}, listener.pairs)
+	listener.pairs = nil
+
+	// cached writes are passed to the listener once they are written, including
+	// those of nested caches
+	cms := ms.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("c"), []byte("3"))
+	nested := cms.CacheMultiStore()
+	nested.GetKVStore(key1).Delete([]byte("a"))
+	nested.GetKVStore(key2).Set([]byte("d"), []byte("4"))
+	nested.Write()
+	require.Empty(t, listener.pairs)
+
+	cms.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Delete: true},
+		{StoreKey: "store1", Key: []byte("c"), Value: []byte("3")},
+	}, listener.pairs)
+	listener.pairs = nil
+
+	// listeners on a cache multi-store are notified of its own writes, and of
+	// the writes of nested caches as they are written to it
+	cms = ms.CacheMultiStore()
+	cacheListener := &mockWriteListener{}
+	cms.AddListeners(key1, []types.WriteListener{cacheListener})
+	cms.GetKVStore(key1).Set([]byte("e"), []byte("5"))
+	nested = cms.CacheMultiStore()
+	nested.GetKVStore(key1).Set([]byte("f"), []byte("6"))
+	require.Len(t, cacheListener.pairs, 1)
+	nested.Write()
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("e"), Value: []byte("5")},
+		{StoreKey: "store1", Key: []byte("f"), Value: []byte("6")},
+	}, cacheListener.pairs)
+	require.Empty(t, listener.pairs)
+}
+
 func newMultiStoreWithMounts(db dbm.DB, pruningOpts types.PruningOptions) *Store {
 	store := NewStore(db)
 	store.pruningOpts = pruningOpts
@@ -0,0 +1,106 @@
+package smt
+
+import (
+	"bytes"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ types.KVStore = (*immutableStore)(nil)
+
+// immutableStore is a read-only KVStore at a version of an SMT store. It reads
+// the flat store if set, and the tree of the given root otherwise. Any mutable
+// operation panics.
+type immutableStore struct {
+	flat dbm.DB
+
+	db   dbm.DB
+	root []byte
+}
+
+// GetStoreType implements Store.
+func (st *immutableStore) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// CacheWrap implements Store.
+func (st *immutableStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *immutableStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Get implements types.KVStore.
+func (st *immutableStore) Get(key []byte) []byte {
+	if st.flat != nil {
+		return dbadapter.Store{DB: st.flat}.Get(key)
+	}
+
+	leaf, err := getLeaf(st.db, st.root, key)
+	if err != nil {
+		panic(err)
+	}
+
+	if leaf == nil {
+		return nil
+	}
+
+	return leaf.value
+}
+
+// Has implements types.KVStore.
+func (st *immutableStore) Has(key []byte) bool {
+	return st.Get(key) != nil
+}
+
+// Set implements types.KVStore.
+func (st *immutableStore) Set(_, _ []byte) {
+	panic("cannot set a key on an immutable SMT store")
+}
+
+// Delete implements types.KVStore.
+func (st *immutableStore) Delete(_ []byte) {
+	panic("cannot delete a key on an immutable SMT store")
+}
+
+// Iterator implements types.KVStore.
+func (st *immutableStore) Iterator(start, end []byte) types.Iterator {
+	return st.parent(start, end).Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *immutableStore) ReverseIterator(start, end []byte) types.Iterator {
+	return st.parent(start, end).ReverseIterator(start, end)
+}
+
+// parent returns the store to iterate over the given domain. The leaves of the
+// tree are ordered by the hash of their key, so iterating over a past version
+// loads all of its keys of the domain in memory.
+func (st *immutableStore) parent(start, end []byte) dbadapter.Store {
+	if st.flat != nil {
+		return dbadapter.Store{DB: st.flat}
+	}
+
+	mem := dbm.NewMemDB()
+
+	err := iterateLeaves(st.db, st.root, func(n *node) error {
+		if (start == nil || bytes.Compare(n.key, start) >= 0) && (end == nil || bytes.Compare(n.key, end) < 0) {
+			return mem.Set(n.key, n.value)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return dbadapter.Store{DB: mem}
+}
@@ -0,0 +1,536 @@
+package smt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.KVStore         = (*Store)(nil)
+	_ types.CommitStore     = (*Store)(nil)
+	_ types.CommitKVStore   = (*Store)(nil)
+	_ types.Queryable       = (*Store)(nil)
+	_ types.CommitmentStore = (*Store)(nil)
+
+	// ErrVersionDoesNotExist is returned when loading a version which does not
+	// exist or was pruned.
+	ErrVersionDoesNotExist = errors.New("version does not exist")
+
+	flatPrefix    = []byte("f/")
+	rootPrefix    = []byte("r/")
+	latestVersion = []byte("l")
+
+	cdc = codec.New()
+)
+
+// Store implements types.CommitmentStore with a flat key-value store holding
+// the latest committed state, which serves reads, and a versioned sparse
+// Merkle tree committing to it, which serves proofs and past versions.
+//
+// The keys of the tree are ordered by their SHA-256 hash, so that the store
+// only provides ics23 existence proofs. As the absence of a key can't be
+// proven, the store must not hold state proven by non-membership proofs, such
+// as the IBC packet receipts used by timeout proofs. Past versions are
+// iterated by walking their entire tree.
+type Store struct {
+	db      dbm.DB
+	flat    dbm.DB
+	pruning types.PruningOptions
+
+	lastCommitID types.CommitID
+	root         []byte
+
+	// pending holds the changes made since the last commit on top of the flat
+	// store, while changes records them by key, with a nil value for a
+	// deletion.
+	pending *cachekv.Store
+	changes map[string][]byte
+}
+
+// LoadStore returns an SMT Store as a CommitKVStore, loading the given version
+// from the provided DB. The changes committed after that version, if any, are
+// discarded. An error is returned if the version does not exist.
+func LoadStore(db dbm.DB, id types.CommitID, pruning types.PruningOptions) (types.CommitKVStore, error) {
+	if !pruning.IsValid() {
+		return nil, fmt.Errorf("pruning options are invalid: %v", pruning)
+	}
+
+	st := &Store{
+		db:      db,
+		flat:    dbm.NewPrefixDB(db, flatPrefix),
+		pruning: pruning,
+		root:    placeholder,
+	}
+
+	latest, err := st.latestVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Version > latest {
+		return nil, errors.Wrapf(ErrVersionDoesNotExist, "version %d, latest version %d", id.Version, latest)
+	}
+
+	if id.Version > 0 {
+		root, err := st.getRoot(id.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		st.root = root
+		st.lastCommitID = types.CommitID{Version: id.Version, Hash: rootHash(root)}
+	}
+
+	if id.Version < latest {
+		if err := st.rollback(latest); err != nil {
+			return nil, err
+		}
+	}
+
+	st.resetPending()
+
+	return st, nil
+}
+
+// rollback deletes the versions after the loaded version, up to the given
+// latest version, and restores the flat store to the loaded version.
+func (st *Store) rollback(latest int64) error {
+	t := newTxn(st.db)
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for version := st.lastCommitID.Version + 1; version <= latest; version++ {
+		if err := st.deleteVersion(t, batch, version); err != nil {
+			return err
+		}
+	}
+
+	it, err := st.flat.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	for ; it.Valid(); it.Next() {
+		batch.Delete(append(append([]byte{}, flatPrefix...), it.Key()...))
+	}
+	it.Close()
+
+	err = iterateLeaves(st.db, st.root, func(n *node) error {
+		batch.Set(append(append([]byte{}, flatPrefix...), n.key...), n.value)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	t.write(batch)
+	batch.Set(latestVersion, versionBytes(st.lastCommitID.Version))
+
+	return batch.WriteSync()
+}
+
+func (st *Store) resetPending() {
+	st.pending = cachekv.NewStore(dbadapter.Store{DB: st.flat})
+	st.changes = make(map[string][]byte)
+}
+
+func (st *Store) latestVersion() (int64, error) {
+	bz, err := st.db.Get(latestVersion)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// getRoot returns the root of the tree of the given version.
+func (st *Store) getRoot(version int64) ([]byte, error) {
+	root, err := st.db.Get(rootKey(version))
+	if err != nil {
+		return nil, err
+	}
+
+	if root == nil {
+		return nil, errors.Wrapf(ErrVersionDoesNotExist, "version %d", version)
+	}
+
+	return root, nil
+}
+
+// Commit commits the changes made since the last commit to the tree as a new
+// version and prunes the previous versions as per the pruning options.
+func (st *Store) Commit() types.CommitID {
+	version := st.lastCommitID.Version + 1
+
+	keys := make([]string, 0, len(st.changes))
+	for key := range st.changes {
+		keys = append(keys, key)
+	}
+
+	changes := make([]*node, len(keys))
+	for i, key := range keys {
+		changes[i] = newLeaf([]byte(key), st.changes[key])
+	}
+
+	sort.Slice(changes, func(i, j int) bool { return bytes.Compare(changes[i].path, changes[j].path) < 0 })
+
+	t := newTxn(st.db)
+
+	root, err := t.update(st.root, 0, changes)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := t.incRef(root); err != nil {
+		panic(err)
+	}
+
+	batch := st.db.NewBatch()
+	defer batch.Close()
+
+	for _, c := range changes {
+		if c.value == nil {
+			batch.Delete(append(append([]byte{}, flatPrefix...), c.key...))
+		} else {
+			batch.Set(append(append([]byte{}, flatPrefix...), c.key...), c.value)
+		}
+	}
+
+	batch.Set(rootKey(version), root)
+	batch.Set(latestVersion, versionBytes(version))
+
+	for _, previous := range st.prunedVersions(version) {
+		if err := st.deleteVersion(t, batch, previous); err != nil {
+			panic(err)
+		}
+	}
+
+	t.write(batch)
+
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+
+	st.root = root
+	st.lastCommitID = types.CommitID{Version: version, Hash: rootHash(root)}
+	st.resetPending()
+
+	return st.lastCommitID
+}
+
+// prunedVersions returns the versions to prune once the given version is
+// committed. Like the IAVL store, the store retains the latest version, the
+// latest version which is a multiple of KeepEvery and the versions which are
+// multiples of SnapshotEvery.
+func (st *Store) prunedVersions(version int64) []int64 {
+	var versions []int64
+
+	if previous := version - 1; previous > 0 && !st.pruning.FlushVersion(previous) && !st.pruning.SnapshotVersion(previous) {
+		versions = append(versions, previous)
+	}
+
+	if st.pruning.FlushVersion(version) {
+		if previous := version - st.pruning.KeepEvery; previous > 0 && !st.pruning.SnapshotVersion(previous) {
+			versions = append(versions, previous)
+		}
+	}
+
+	return versions
+}
+
+// deleteVersion deletes the given version, if it exists, along with the nodes
+// not referenced by other versions.
+func (st *Store) deleteVersion(t *txn, batch dbm.Batch, version int64) error {
+	root, err := st.db.Get(rootKey(version))
+	if err != nil || root == nil {
+		return err
+	}
+
+	batch.Delete(rootKey(version))
+
+	return t.decRef(root)
+}
+
+// LastCommitID implements Committer.
+func (st *Store) LastCommitID() types.CommitID {
+	return st.lastCommitID
+}
+
+// SetPruning panics as pruning options should be provided at initialization.
+func (st *Store) SetPruning(_ types.PruningOptions) {
+	panic("cannot set pruning options on an initialized SMT store")
+}
+
+// VersionExists returns whether or not a given version is stored.
+func (st *Store) VersionExists(version int64) bool {
+	if version <= 0 || version > st.lastCommitID.Version {
+		return false
+	}
+
+	has, err := st.db.Has(rootKey(version))
+	return err == nil && has
+}
+
+// AvailableVersions returns the versions retained by the store in ascending
+// order.
+func (st *Store) AvailableVersions() []int64 {
+	it, err := dbm.IteratePrefix(st.db, rootPrefix)
+	if err != nil {
+		panic(err)
+	}
+	defer it.Close()
+
+	var versions []int64
+	for ; it.Valid(); it.Next() {
+		versions = append(versions, int64(binary.BigEndian.Uint64(it.Key()[len(rootPrefix):])))
+	}
+
+	return versions
+}
+
+// GetImmutableVersion implements types.CommitmentStore. The latest version is
+// read from the flat store, while past versions are read from their tree.
+func (st *Store) GetImmutableVersion(version int64) (types.KVStore, error) {
+	if version == st.lastCommitID.Version {
+		return &immutableStore{flat: st.flat}, nil
+	}
+
+	root, err := st.getRoot(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &immutableStore{db: st.db, root: root}, nil
+}
+
+// Export calls fn with the key-value pairs of the given version, in the order
+// of their paths in the tree, which is the same on every node. It stops at the
+// first error returned by fn.
+func (st *Store) Export(version int64, fn func(key, value []byte) error) error {
+	root, err := st.getRoot(version)
+	if err != nil {
+		return errors.Wrapf(err, "smt export failed for version %v", version)
+	}
+
+	return iterateLeaves(st.db, root, func(n *node) error {
+		return fn(n.key, n.value)
+	})
+}
+
+// Import returns an Importer committing the imported key-value pairs as the
+// given version. The store must be empty.
+func (st *Store) Import(version int64) (*Importer, error) {
+	if st.lastCommitID.Version != 0 {
+		return nil, fmt.Errorf("smt import failed: store is not empty, last version %d", st.lastCommitID.Version)
+	}
+
+	if version <= 0 {
+		return nil, fmt.Errorf("smt import failed: invalid version %d", version)
+	}
+
+	return &Importer{store: st, version: version}, nil
+}
+
+// Importer imports key-value pairs into an empty Store. The pairs are held in
+// memory until they are committed to the tree.
+type Importer struct {
+	store   *Store
+	version int64
+}
+
+// Add adds a key-value pair to the import.
+func (im *Importer) Add(key, value []byte) error {
+	if len(key) == 0 {
+		return errors.New("smt import failed: empty key")
+	}
+
+	if value == nil {
+		return errors.New("smt import failed: nil value")
+	}
+
+	im.store.Set(key, value)
+	return nil
+}
+
+// Commit commits the imported key-value pairs as the version of the import.
+func (im *Importer) Commit() error {
+	im.store.lastCommitID.Version = im.version - 1
+
+	id := im.store.Commit()
+	if id.Version != im.version {
+		return fmt.Errorf("smt import failed: committed version %d, expected %d", id.Version, im.version)
+	}
+
+	return nil
+}
+
+// GetStoreType implements Store.
+func (st *Store) GetStoreType() types.StoreType {
+	return types.StoreTypeSMT
+}
+
+// CacheWrap implements Store.
+func (st *Store) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(st)
+}
+
+// CacheWrapWithTrace implements the Store interface.
+func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
+}
+
+// Set implements types.KVStore.
+func (st *Store) Set(key, value []byte) {
+	types.AssertValidValue(value)
+	st.pending.Set(key, value)
+	st.changes[string(key)] = value
+}
+
+// Get implements types.KVStore.
+func (st *Store) Get(key []byte) []byte {
+	return st.pending.Get(key)
+}
+
+// Has implements types.KVStore.
+func (st *Store) Has(key []byte) bool {
+	return st.pending.Has(key)
+}
+
+// Delete implements types.KVStore.
+func (st *Store) Delete(key []byte) {
+	st.pending.Delete(key)
+	st.changes[string(key)] = nil
+}
+
+// Iterator implements types.KVStore.
+func (st *Store) Iterator(start, end []byte) types.Iterator {
+	return st.pending.Iterator(start, end)
+}
+
+// ReverseIterator implements types.KVStore.
+func (st *Store) ReverseIterator(start, end []byte) types.Iterator {
+	return st.pending.ReverseIterator(start, end)
+}
+
+// Query implements ABCI interface, allows queries. Like for the IAVL store,
+// queries default to the latest height - 1 if it exists.
+//
+// Proofs are only provided for the keys which are set, as the keys being
+// ordered by their hash in the tree, the absence of a key cannot be proven
+// with ics23.
+func (st *Store) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(req.Data) == 0 {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrTxDecode, "query cannot be zero length"))
+	}
+
+	res.Height = req.Height
+	if res.Height == 0 {
+		res.Height = st.lastCommitID.Version
+		if st.VersionExists(res.Height - 1) {
+			res.Height--
+		}
+	}
+
+	if !st.VersionExists(res.Height) {
+		res.Log = ErrVersionDoesNotExist.Error()
+		return res
+	}
+
+	root, err := st.getRoot(res.Height)
+	if err != nil {
+		return sdkerrors.QueryResult(err)
+	}
+
+	switch req.Path {
+	case "/key": // get by key
+		key := req.Data // data holds the key bytes
+		res.Key = key
+
+		leaf, err := getLeaf(st.db, root, key)
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+
+		if leaf == nil {
+			if req.Prove {
+				return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot prove the absence of key %X in an SMT store", key))
+			}
+
+			break
+		}
+
+		res.Value = leaf.value
+		if !req.Prove {
+			break
+		}
+
+		proof, err := getProof(st.db, root, key)
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+
+		res.Proof = commitmentOpProof(key, proof)
+
+	case "/subspace":
+		var KVs []types.KVPair
+
+		subspace := req.Data
+		res.Key = subspace
+
+		store := &immutableStore{db: st.db, root: root}
+
+		iterator := types.KVStorePrefixIterator(store, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			KVs = append(KVs, types.KVPair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+
+		iterator.Close()
+		res.Value = cdc.MustMarshalBinaryBare(KVs)
+
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+
+	return res
+}
+
+func commitmentOpProof(key []byte, proof *ics23.CommitmentProof) *merkle.Proof {
+	op := types.NewSMTCommitmentOp(key, proof)
+	return &merkle.Proof{Ops: []merkle.ProofOp{op.ProofOp()}}
+}
+
+func rootKey(version int64) []byte {
+	return append(append([]byte{}, rootPrefix...), versionBytes(version)...)
+}
+
+func versionBytes(version int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(version))
+	return bz
+}
+
+// rootHash returns the hash of a tree given its root, which is nil for an
+// empty tree.
+func rootHash(root []byte) []byte {
+	if bytes.Equal(root, placeholder) {
+		return nil
+	}
+
+	return root
+}
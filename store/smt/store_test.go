@@ -0,0 +1,233 @@
+package smt
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	ics23 "github.com/confio/ics23/go"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newStore(t *testing.T, db dbm.DB, id types.CommitID, pruning types.PruningOptions) *Store {
+	store, err := LoadStore(db, id, pruning)
+	require.NoError(t, err)
+
+	return store.(*Store)
+}
+
+func iterateAll(store types.KVStore) (keys []string) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+
+	return keys
+}
+
+func TestStoreGetSetDelete(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), types.CommitID{}, types.PruneNothing)
+	require.Nil(t, store.LastCommitID().Hash)
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	require.Equal(t, []byte("goodbye"), store.Get([]byte("hello")))
+	require.True(t, store.Has([]byte("aloha")))
+
+	cid := store.Commit()
+	require.Equal(t, int64(1), cid.Version)
+	require.NotNil(t, cid.Hash)
+
+	store.Delete([]byte("hello"))
+	store.Set([]byte("bonjour"), []byte("au revoir"))
+	require.Nil(t, store.Get([]byte("hello")))
+	require.Equal(t, []string{"aloha", "bonjour"}, iterateAll(store))
+
+	cid2 := store.Commit()
+	require.NotEqual(t, cid.Hash, cid2.Hash)
+
+	// the past version is read from its tree
+	past, err := store.GetImmutableVersion(1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("goodbye"), past.Get([]byte("hello")))
+	require.Nil(t, past.Get([]byte("bonjour")))
+	require.Equal(t, []string{"aloha", "hello"}, iterateAll(past))
+	require.Panics(t, func() { past.Set([]byte("hello"), []byte("adios")) })
+
+	latest, err := store.GetImmutableVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"aloha", "bonjour"}, iterateAll(latest))
+
+	_, err = store.GetImmutableVersion(3)
+	require.Error(t, err)
+
+	// deleting all the keys results in an empty tree
+	store.Delete([]byte("aloha"))
+	store.Delete([]byte("bonjour"))
+	require.Nil(t, store.Commit().Hash)
+}
+
+func TestStoreHashIsIndependentOfHistory(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	keys := make([][]byte, 200)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%d", r.Int()))
+	}
+
+	store1 := newStore(t, dbm.NewMemDB(), types.CommitID{}, types.PruneNothing)
+	for _, key := range keys {
+		store1.Set(key, key)
+	}
+	cid1 := store1.Commit()
+
+	// set the keys over several versions in another order, along with keys
+	// deleted later on
+	store2 := newStore(t, dbm.NewMemDB(), types.CommitID{}, types.PruneNothing)
+	for i, j := range r.Perm(len(keys)) {
+		store2.Set(keys[j], []byte("tmp"))
+		store2.Set([]byte(fmt.Sprintf("tmp%d", i)), keys[j])
+
+		if i%50 == 0 {
+			store2.Commit()
+		}
+	}
+	store2.Commit()
+
+	for i, key := range keys {
+		store2.Set(key, key)
+		store2.Delete([]byte(fmt.Sprintf("tmp%d", i)))
+	}
+	cid2 := store2.Commit()
+
+	require.Equal(t, cid1.Hash, cid2.Hash)
+}
+
+func TestStoreQueryProof(t *testing.T) {
+	store := newStore(t, dbm.NewMemDB(), types.CommitID{}, types.PruneNothing)
+
+	for i := 0; i < 100; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	cid := store.Commit()
+
+	for i := 0; i < 100; i++ {
+		key := []byte(fmt.Sprintf("key%d", i))
+		value := []byte(fmt.Sprintf("value%d", i))
+
+		res := store.Query(abci.RequestQuery{Path: "/key", Data: key, Height: cid.Version, Prove: true})
+		require.True(t, res.IsOK(), res.Log)
+		require.Equal(t, value, res.Value)
+		require.Len(t, res.Proof.Ops, 1)
+
+		op, err := types.CommitmentOpDecoder(res.Proof.Ops[0])
+		require.NoError(t, err)
+
+		root, err := op.Run([][]byte{value})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{cid.Hash}, root)
+
+		proof := op.(types.CommitmentOp).Proof
+		require.True(t, ics23.VerifyMembership(types.SMTSpec, cid.Hash, proof, key, value))
+		require.False(t, ics23.VerifyMembership(types.SMTSpec, cid.Hash, proof, key, []byte("other")))
+	}
+
+	res := store.Query(abci.RequestQuery{Path: "/key", Data: []byte("absent"), Height: cid.Version})
+	require.True(t, res.IsOK())
+	require.Nil(t, res.Value)
+
+	res = store.Query(abci.RequestQuery{Path: "/key", Data: []byte("absent"), Height: cid.Version, Prove: true})
+	require.False(t, res.IsOK())
+
+	res = store.Query(abci.RequestQuery{Path: "/subspace", Data: []byte("key1"), Height: cid.Version})
+	require.True(t, res.IsOK())
+
+	var kvs []types.KVPair
+	require.NoError(t, cdc.UnmarshalBinaryBare(res.Value, &kvs))
+	require.Len(t, kvs, 11)
+}
+
+func TestStorePruning(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pruning  types.PruningOptions
+		versions []int64
+	}{
+		{"prune nothing", types.PruneNothing, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{"prune everything", types.PruneEverything, []int64{10}},
+		{"keep every 3", types.PruningOptions{KeepEvery: 3, SnapshotEvery: 0}, []int64{9, 10}},
+		{"snapshot every 4", types.PruningOptions{KeepEvery: 2, SnapshotEvery: 4}, []int64{4, 8, 10}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			store := newStore(t, db, types.CommitID{}, tc.pruning)
+
+			for i := 0; i < 10; i++ {
+				store.Set([]byte(fmt.Sprintf("key%d", i%3)), []byte(fmt.Sprintf("value%d", i)))
+				store.Commit()
+			}
+
+			require.Equal(t, tc.versions, store.AvailableVersions())
+
+			for _, version := range tc.versions {
+				require.True(t, store.VersionExists(version))
+
+				past, err := store.GetImmutableVersion(version)
+				require.NoError(t, err)
+				require.Equal(t, []byte(fmt.Sprintf("value%d", version-1)), past.Get([]byte(fmt.Sprintf("key%d", (version-1)%3))))
+			}
+
+			// deleting the remaining versions deletes all the nodes
+			t2 := newTxn(db)
+			batch := db.NewBatch()
+			for _, version := range tc.versions {
+				require.NoError(t, store.deleteVersion(t2, batch, version))
+			}
+			t2.write(batch)
+			require.NoError(t, batch.Write())
+
+			for _, prefix := range [][]byte{nodePrefix, refCountPrefix, rootPrefix} {
+				it, err := dbm.IteratePrefix(db, prefix)
+				require.NoError(t, err)
+				require.False(t, it.Valid())
+				it.Close()
+			}
+		})
+	}
+}
+
+func TestLoadStoreRollback(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newStore(t, db, types.CommitID{}, types.PruneNothing)
+
+	store.Set([]byte("hello"), []byte("goodbye"))
+	cid := store.Commit()
+
+	store.Set([]byte("hello"), []byte("adios"))
+	store.Set([]byte("aloha"), []byte("shalom"))
+	store.Commit()
+
+	// reloading the latest version
+	reloaded := newStore(t, db, types.CommitID{Version: 2}, types.PruneNothing)
+	require.Equal(t, []byte("adios"), reloaded.Get([]byte("hello")))
+
+	// loading a previous version discards the later ones
+	reloaded = newStore(t, db, cid, types.PruneNothing)
+	require.Equal(t, cid, reloaded.LastCommitID())
+	require.Equal(t, []byte("goodbye"), reloaded.Get([]byte("hello")))
+	require.Equal(t, []string{"hello"}, iterateAll(reloaded))
+	require.Equal(t, []int64{1}, reloaded.AvailableVersions())
+
+	_, err := LoadStore(db, types.CommitID{Version: 2}, types.PruneNothing)
+	require.Error(t, err)
+}
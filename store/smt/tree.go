@@ -0,0 +1,505 @@
+package smt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	ics23 "github.com/confio/ics23/go"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	leafPrefix  byte = 0
+	innerPrefix byte = 1
+
+	// maxDepth is the depth of the tree, i.e. the number of bits of a path.
+	maxDepth = 8 * sha256.Size
+)
+
+var (
+	// placeholder is the hash of an empty subtree.
+	placeholder = make([]byte, sha256.Size)
+
+	nodePrefix     = []byte("n/")
+	refCountPrefix = []byte("c/")
+)
+
+// node is a node of the sparse Merkle tree. Leaves are placed at the
+// shallowest depth at which their path, the SHA-256 hash of their key, is
+// unique in the tree, so that a subtree holding a single leaf is that leaf.
+type node struct {
+	leaf bool
+
+	// fields of leaf nodes
+	path  []byte
+	key   []byte
+	value []byte
+
+	// fields of inner nodes, placeholder for an empty child
+	left  []byte
+	right []byte
+}
+
+func newLeaf(key, value []byte) *node {
+	path := sha256.Sum256(key)
+	return &node{leaf: true, path: path[:], key: key, value: value}
+}
+
+// hash returns the hash of the node, as defined by types.SMTSpec.
+func (n *node) hash() []byte {
+	h := sha256.New()
+
+	if n.leaf {
+		value := sha256.Sum256(n.value)
+		h.Write([]byte{leafPrefix})
+		h.Write(n.path)
+		h.Write(value[:])
+	} else {
+		h.Write([]byte{innerPrefix})
+		h.Write(n.left)
+		h.Write(n.right)
+	}
+
+	return h.Sum(nil)
+}
+
+func (n *node) encode() []byte {
+	if !n.leaf {
+		bz := make([]byte, 0, 1+2*sha256.Size)
+		bz = append(bz, innerPrefix)
+		bz = append(bz, n.left...)
+		return append(bz, n.right...)
+	}
+
+	bz := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(n.key)+len(n.value))
+	bz[0] = leafPrefix
+	bz = bz[:1+binary.PutUvarint(bz[1:], uint64(len(n.key)))]
+	bz = append(bz, n.key...)
+
+	return append(bz, n.value...)
+}
+
+func decodeNode(bz []byte) (*node, error) {
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("empty node")
+	}
+
+	switch bz[0] {
+	case innerPrefix:
+		if len(bz) != 1+2*sha256.Size {
+			return nil, fmt.Errorf("invalid inner node length %d", len(bz))
+		}
+
+		return &node{left: bz[1 : 1+sha256.Size], right: bz[1+sha256.Size:]}, nil
+
+	case leafPrefix:
+		keyLen, n := binary.Uvarint(bz[1:])
+		if n <= 0 || uint64(len(bz)-1-n) < keyLen {
+			return nil, fmt.Errorf("invalid leaf node key length")
+		}
+
+		key := bz[1+n : 1+n+int(keyLen)]
+		return newLeaf(key, bz[1+n+int(keyLen):]), nil
+
+	default:
+		return nil, fmt.Errorf("unknown node prefix %X", bz[0])
+	}
+}
+
+// bit returns the bit of the path at the given depth.
+func bit(path []byte, depth int) int {
+	return int(path[depth/8]>>(7-uint(depth%8))) & 1
+}
+
+func nodeKey(hash []byte) []byte {
+	return append(append([]byte{}, nodePrefix...), hash...)
+}
+
+func refCountKey(hash []byte) []byte {
+	return append(append([]byte{}, refCountPrefix...), hash...)
+}
+
+// getNode loads the node with the given hash from the db.
+func getNode(db dbm.DB, hash []byte) (*node, error) {
+	bz, err := db.Get(nodeKey(hash))
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return nil, fmt.Errorf("node %X not found", hash)
+	}
+
+	return decodeNode(bz)
+}
+
+// getLeaf returns the leaf of the given key in the tree of the given root, or
+// nil if the key is not set.
+func getLeaf(db dbm.DB, root, key []byte) (*node, error) {
+	path := sha256.Sum256(key)
+	hash := root
+
+	for depth := 0; !bytes.Equal(hash, placeholder); depth++ {
+		n, err := getNode(db, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.leaf {
+			if !bytes.Equal(n.key, key) {
+				return nil, nil
+			}
+
+			return n, nil
+		}
+
+		if bit(path[:], depth) == 0 {
+			hash = n.left
+		} else {
+			hash = n.right
+		}
+	}
+
+	return nil, nil
+}
+
+// getProof returns an ics23 existence proof of the given key in the tree of the
+// given root, or nil if the key is not set.
+func getProof(db dbm.DB, root, key []byte) (*ics23.CommitmentProof, error) {
+	path := sha256.Sum256(key)
+	hash := root
+
+	var ops []*ics23.InnerOp
+	for depth := 0; !bytes.Equal(hash, placeholder); depth++ {
+		n, err := getNode(db, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.leaf {
+			if !bytes.Equal(n.key, key) {
+				return nil, nil
+			}
+
+			// inner ops are ordered from the leaf to the root
+			for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+				ops[i], ops[j] = ops[j], ops[i]
+			}
+
+			proof := &ics23.ExistenceProof{
+				Key:   key,
+				Value: n.value,
+				Leaf:  types.SMTSpec.LeafSpec,
+				Path:  ops,
+			}
+
+			return &ics23.CommitmentProof{
+				Proof: &ics23.CommitmentProof_Exist{Exist: proof},
+			}, nil
+		}
+
+		op := &ics23.InnerOp{Hash: ics23.HashOp_SHA256}
+		if bit(path[:], depth) == 0 {
+			op.Prefix = []byte{innerPrefix}
+			op.Suffix = n.right
+			hash = n.left
+		} else {
+			op.Prefix = append([]byte{innerPrefix}, n.left...)
+			hash = n.right
+		}
+
+		ops = append(ops, op)
+	}
+
+	return nil, nil
+}
+
+// iterateLeaves calls fn for each leaf of the tree of the given root, in the
+// order of their paths, until fn returns an error.
+func iterateLeaves(db dbm.DB, root []byte, fn func(n *node) error) error {
+	if bytes.Equal(root, placeholder) {
+		return nil
+	}
+
+	n, err := getNode(db, root)
+	if err != nil {
+		return err
+	}
+
+	if n.leaf {
+		return fn(n)
+	}
+
+	if err := iterateLeaves(db, n.left, fn); err != nil {
+		return err
+	}
+
+	return iterateLeaves(db, n.right, fn)
+}
+
+// txn accumulates the changes made to the nodes of the tree and their
+// reference counts, i.e. the number of inner nodes and versions referencing
+// them, until they are written to the db.
+type txn struct {
+	db      dbm.DB
+	created map[string]*node
+	refs    map[string]uint64
+}
+
+func newTxn(db dbm.DB) *txn {
+	return &txn{
+		db:      db,
+		created: make(map[string]*node),
+		refs:    make(map[string]uint64),
+	}
+}
+
+func (t *txn) getNode(hash []byte) (*node, error) {
+	if n, ok := t.created[string(hash)]; ok {
+		return n, nil
+	}
+
+	return getNode(t.db, hash)
+}
+
+func (t *txn) refCount(hash []byte) (uint64, error) {
+	if refs, ok := t.refs[string(hash)]; ok {
+		return refs, nil
+	}
+
+	bz, err := t.db.Get(refCountKey(hash))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+// saveNode saves a node unless it already exists, and returns its hash.
+func (t *txn) saveNode(n *node) ([]byte, error) {
+	hash := n.hash()
+
+	if _, ok := t.created[string(hash)]; ok {
+		return hash, nil
+	}
+
+	refs, err := t.refCount(hash)
+	if err != nil || refs > 0 {
+		return hash, err
+	}
+
+	t.created[string(hash)] = n
+	t.refs[string(hash)] = 0
+
+	if !n.leaf {
+		if err := t.incRef(n.left); err != nil {
+			return nil, err
+		}
+
+		if err := t.incRef(n.right); err != nil {
+			return nil, err
+		}
+	}
+
+	return hash, nil
+}
+
+func (t *txn) incRef(hash []byte) error {
+	if bytes.Equal(hash, placeholder) {
+		return nil
+	}
+
+	refs, err := t.refCount(hash)
+	if err != nil {
+		return err
+	}
+
+	t.refs[string(hash)] = refs + 1
+
+	return nil
+}
+
+// decRef decrements the reference count of a node, deleting it along with the
+// nodes only it references when it is not referenced anymore.
+func (t *txn) decRef(hash []byte) error {
+	if bytes.Equal(hash, placeholder) {
+		return nil
+	}
+
+	refs, err := t.refCount(hash)
+	if err != nil {
+		return err
+	}
+
+	if refs == 0 {
+		return fmt.Errorf("node %X is not referenced", hash)
+	}
+
+	t.refs[string(hash)] = refs - 1
+	if refs > 1 {
+		return nil
+	}
+
+	n, err := t.getNode(hash)
+	if err != nil {
+		return err
+	}
+
+	if n.leaf {
+		return nil
+	}
+
+	if err := t.decRef(n.left); err != nil {
+		return err
+	}
+
+	return t.decRef(n.right)
+}
+
+// write adds the changes of the transaction to the batch.
+func (t *txn) write(batch dbm.Batch) {
+	for hash, refs := range t.refs {
+		if refs == 0 {
+			batch.Delete(nodeKey([]byte(hash)))
+			batch.Delete(refCountKey([]byte(hash)))
+			continue
+		}
+
+		if n, ok := t.created[hash]; ok {
+			batch.Set(nodeKey([]byte(hash)), n.encode())
+		}
+
+		bz := make([]byte, 8)
+		binary.BigEndian.PutUint64(bz, refs)
+		batch.Set(refCountKey([]byte(hash)), bz)
+	}
+}
+
+// update applies the given changes, sorted by path, to the subtree of the
+// given root at the given depth and returns the root of the new subtree. A
+// change with a nil value deletes its key.
+func (t *txn) update(root []byte, depth int, changes []*node) ([]byte, error) {
+	if len(changes) == 0 {
+		return root, nil
+	}
+
+	if bytes.Equal(root, placeholder) {
+		return t.build(depth, setLeaves(changes))
+	}
+
+	n, err := t.getNode(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.leaf {
+		leaves := setLeaves(changes)
+
+		i := sort.Search(len(changes), func(i int) bool { return bytes.Compare(changes[i].path, n.path) >= 0 })
+		if i == len(changes) || !bytes.Equal(changes[i].path, n.path) {
+			j := sort.Search(len(leaves), func(j int) bool { return bytes.Compare(leaves[j].path, n.path) >= 0 })
+			leaves = append(leaves[:j], append([]*node{n}, leaves[j:]...)...)
+		}
+
+		return t.build(depth, leaves)
+	}
+
+	i := splitIndex(changes, depth)
+
+	left, err := t.update(n.left, depth+1, changes[:i])
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := t.update(n.right, depth+1, changes[i:])
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(left, n.left) && bytes.Equal(right, n.right) {
+		return root, nil
+	}
+
+	return t.join(left, right)
+}
+
+// build builds the subtree at the given depth holding the given leaves, sorted
+// by path, and returns its root.
+func (t *txn) build(depth int, leaves []*node) ([]byte, error) {
+	switch len(leaves) {
+	case 0:
+		return placeholder, nil
+	case 1:
+		return t.saveNode(leaves[0])
+	}
+
+	if depth >= maxDepth {
+		return nil, fmt.Errorf("duplicate path %X", leaves[0].path)
+	}
+
+	i := splitIndex(leaves, depth)
+
+	left, err := t.build(depth+1, leaves[:i])
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := t.build(depth+1, leaves[i:])
+	if err != nil {
+		return nil, err
+	}
+
+	return t.saveNode(&node{left: left, right: right})
+}
+
+// join returns the root of the subtree with the given children. A subtree
+// with a single leaf is collapsed into that leaf.
+func (t *txn) join(left, right []byte) ([]byte, error) {
+	leftEmpty, rightEmpty := bytes.Equal(left, placeholder), bytes.Equal(right, placeholder)
+
+	switch {
+	case leftEmpty && rightEmpty:
+		return placeholder, nil
+
+	case leftEmpty || rightEmpty:
+		child := left
+		if leftEmpty {
+			child = right
+		}
+
+		n, err := t.getNode(child)
+		if err != nil {
+			return nil, err
+		}
+
+		if n.leaf {
+			return child, nil
+		}
+	}
+
+	return t.saveNode(&node{left: left, right: right})
+}
+
+// setLeaves returns the changes setting a value.
+func setLeaves(changes []*node) []*node {
+	leaves := make([]*node, 0, len(changes))
+	for _, c := range changes {
+		if c.value != nil {
+			leaves = append(leaves, c)
+		}
+	}
+
+	return leaves
+}
+
+// splitIndex returns the index of the first of the given nodes, sorted by path
+// and sharing the same path prefix up to the given depth, going to the right
+// subtree.
+func splitIndex(nodes []*node, depth int) int {
+	return sort.Search(len(nodes), func(i int) bool { return bit(nodes[i].path, depth) == 1 })
+}
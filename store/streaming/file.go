@@ -0,0 +1,100 @@
+package streaming
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	// fileExt is the extension of the files written by a FileListener.
+	fileExt = ".changes"
+
+	// maxPairSize is the maximum size of a single StoreKVPair read by ReadFile.
+	maxPairSize = 64e6
+)
+
+// FileListener writes the state changes of each block to a separate file in a
+// directory. A file contains a sequence of length-prefixed (uvarint) protobuf
+// StoreKVPair messages, and is moved into place once it has been completely
+// written, so services tailing the directory never see partial files.
+type FileListener struct {
+	dir string
+}
+
+// NewFileListener creates a new FileListener writing to the given directory,
+// creating it if necessary.
+func NewFileListener(dir string) (*FileListener, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create state change directory %q: %w", dir, err)
+	}
+
+	return &FileListener{dir: dir}, nil
+}
+
+// FileName returns the name of the file holding the state changes of the
+// block at the given height.
+func FileName(height int64) string {
+	return fmt.Sprintf("block-%d%s", height, fileExt)
+}
+
+// ListenCommit writes the state changes of a block to its file.
+func (f *FileListener) ListenCommit(height int64, changes []*types.StoreKVPair) (err error) {
+	file, err := ioutil.TempFile(f.dir, FileName(height)+".tmp-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+	}()
+
+	buf := bufio.NewWriter(file)
+	writer := protoio.NewDelimitedWriter(buf)
+	for _, pair := range changes {
+		if err = writer.WriteMsg(pair); err != nil {
+			return err
+		}
+	}
+	if err = buf.Flush(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filepath.Join(f.dir, FileName(height)))
+}
+
+// ReadFile reads the state changes written to a file by a FileListener.
+func ReadFile(path string) ([]*types.StoreKVPair, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := protoio.NewDelimitedReader(bufio.NewReader(file), maxPairSize)
+	var changes []*types.StoreKVPair
+	for {
+		pair := &types.StoreKVPair{}
+		err := reader.ReadMsg(pair)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		changes = append(changes, pair)
+	}
+
+	return changes, nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -8,4 +10,35 @@ const StoreCodespace = "store"
 
 var (
 	ErrInvalidProof = sdkerrors.Register(StoreCodespace, 2, "invalid proof")
+
+	// ErrVersionPruned defines an error when loading or querying a past version
+	// which is not retained by the store anymore.
+	ErrVersionPruned = sdkerrors.Register(StoreCodespace, 3, "version pruned")
 )
+
+// VersionPrunedError is an ErrVersionPruned giving the pruned version and the
+// earliest version retained by the store, e.g. so that a client can retry a
+// query on a node retaining the version.
+type VersionPrunedError struct {
+	Version         int64
+	EarliestVersion int64
+}
+
+// Error implements the error interface.
+func (e *VersionPrunedError) Error() string {
+	return fmt.Sprintf(
+		"version %d has been pruned; earliest available version: %d", e.Version, e.EarliestVersion,
+	)
+}
+
+// Cause returns ErrVersionPruned so that the error is reported with its ABCI
+// code.
+func (e *VersionPrunedError) Cause() error {
+	return ErrVersionPruned
+}
+
+// Unwrap implements the errors.Unwrap interface, so that errors.Is matches
+// ErrVersionPruned.
+func (e *VersionPrunedError) Unwrap() error {
+	return ErrVersionPruned
+}
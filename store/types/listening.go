@@ -0,0 +1,10 @@
+package types
+
+// WriteListener is notified of the writes made to a listened KVStore. It is
+// attached to a MultiStore with AddListeners, and is called by the
+// listenkv.Store wrapping the KVStore.
+type WriteListener interface {
+	// OnWrite is called for every Set and Delete on the KVStore identified by
+	// storeKey. If delete is true the key was removed and value is nil.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
+}
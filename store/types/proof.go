@@ -10,8 +10,63 @@ import (
 const (
 	ProofOpIAVLCommitment         = "ics23:iavl"
 	ProofOpSimpleMerkleCommitment = "ics23:simple"
+	ProofOpSMTCommitment          = "ics23:smt"
 )
 
+var (
+	// IAVLSpec is the ics23 ProofSpec of the query proofs of IAVL stores.
+	IAVLSpec = ics23.IavlSpec
+
+	// MultiStoreSpec is the ics23 ProofSpec of the root multi-store layer of
+	// query proofs, proving the root hash of a substore under its name.
+	MultiStoreSpec = ics23.TendermintSpec
+)
+
+// ProofSpecs returns the ics23 ProofSpecs of the query proofs of IAVL stores
+// returned by the root multi-store, ordered from the substore layer to the
+// multi-store layer.
+func ProofSpecs() []*ics23.ProofSpec {
+	return []*ics23.ProofSpec{IAVLSpec, MultiStoreSpec}
+}
+
+// GetProofSpec returns the ics23 ProofSpec of the given ProofOp type.
+func GetProofSpec(opType string) (*ics23.ProofSpec, error) {
+	switch opType {
+	case ProofOpIAVLCommitment:
+		return IAVLSpec, nil
+	case ProofOpSimpleMerkleCommitment:
+		return MultiStoreSpec, nil
+	case ProofOpSMTCommitment:
+		return SMTSpec, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment', 'ProofOpSimpleMerkleCommitment' or 'ProofOpSMTCommitment'", opType)
+	}
+}
+
+// SMTSpec is the ics23 ProofSpec of the sparse Merkle trees of SMT stores.
+// Leaves hash the SHA-256 hashes of their key and value with a 0x00 prefix
+// and inner nodes hash their two children with a 0x01 prefix. Keys are
+// ordered by their SHA-256 hash in the tree, so only existence proofs are
+// supported.
+var SMTSpec = &ics23.ProofSpec{
+	LeafSpec: &ics23.LeafOp{
+		Hash:         ics23.HashOp_SHA256,
+		PrehashKey:   ics23.HashOp_SHA256,
+		PrehashValue: ics23.HashOp_SHA256,
+		Length:       ics23.LengthOp_NO_PREFIX,
+		Prefix:       []byte{0},
+	},
+	InnerSpec: &ics23.InnerSpec{
+		ChildOrder:      []int32{0, 1},
+		ChildSize:       32,
+		MinPrefixLength: 1,
+		MaxPrefixLength: 1,
+		EmptyChild:      make([]byte, 32),
+		Hash:            ics23.HashOp_SHA256,
+	},
+	MaxDepth: 256,
+}
+
 // CommitmentOp implements merkle.ProofOperator by wrapping an ics23 CommitmentProof
 // It also contains a Key field to determine which key the proof is proving.
 // NOTE: CommitmentProof currently can either be ExistenceProof or NonexistenceProof
@@ -30,7 +85,7 @@ var _ merkle.ProofOperator = CommitmentOp{}
 func NewIavlCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
 	return CommitmentOp{
 		Type:  ProofOpIAVLCommitment,
-		Spec:  ics23.IavlSpec,
+		Spec:  IAVLSpec,
 		Key:   key,
 		Proof: proof,
 	}
@@ -39,7 +94,16 @@ func NewIavlCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp
 func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
 	return CommitmentOp{
 		Type:  ProofOpSimpleMerkleCommitment,
-		Spec:  ics23.TendermintSpec,
+		Spec:  MultiStoreSpec,
+		Key:   key,
+		Proof: proof,
+	}
+}
+
+func NewSMTCommitmentOp(key []byte, proof *ics23.CommitmentProof) CommitmentOp {
+	return CommitmentOp{
+		Type:  ProofOpSMTCommitment,
+		Spec:  SMTSpec,
 		Key:   key,
 		Proof: proof,
 	}
@@ -49,18 +113,13 @@ func NewSimpleMerkleCommitmentOp(key []byte, proof *ics23.CommitmentProof) Commi
 // The proofOp.Data is just a marshalled CommitmentProof. The Key of the CommitmentOp is extracted
 // from the unmarshalled proof.
 func CommitmentOpDecoder(pop merkle.ProofOp) (merkle.ProofOperator, error) {
-	var spec *ics23.ProofSpec
-	switch pop.Type {
-	case ProofOpIAVLCommitment:
-		spec = ics23.IavlSpec
-	case ProofOpSimpleMerkleCommitment:
-		spec = ics23.TendermintSpec
-	default:
-		return nil, sdkerrors.Wrapf(ErrInvalidProof, "unexpected ProofOp.Type; got %s, want supported ics23 subtypes 'ProofOpIAVLCommitment' or 'ProofOpSimpleMerkleCommitment'", pop.Type)
+	spec, err := GetProofSpec(pop.Type)
+	if err != nil {
+		return nil, err
 	}
 
 	proof := &ics23.CommitmentProof{}
-	err := proof.Unmarshal(pop.Data)
+	err = proof.Unmarshal(pop.Data)
 	if err != nil {
 		return nil, err
 	}
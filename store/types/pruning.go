@@ -64,3 +64,13 @@ func (po PruningOptions) FlushVersion(ver int64) bool {
 func (po PruningOptions) SnapshotVersion(ver int64) bool {
 	return po.SnapshotEvery != 0 && ver%po.SnapshotEvery == 0
 }
+
+// PruningInfo describes the pruning strategy of a multi-store along with the
+// range of versions it retains. Only the versions in between EarliestVersion
+// and LatestVersion which are kept per the pruning strategy can be loaded.
+type PruningInfo struct {
+	KeepEvery       int64 `json:"keep_every"`
+	SnapshotEvery   int64 `json:"snapshot_every"`
+	EarliestVersion int64 `json:"earliest_version"`
+	LatestVersion   int64 `json:"latest_version"`
+}
@@ -7,6 +7,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmkv "github.com/tendermint/tendermint/libs/kv"
 	dbm "github.com/tendermint/tm-db"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 )
 
 type Store interface {
@@ -116,6 +118,14 @@ type MultiStore interface {
 	// implied that the caller should update the context when necessary between
 	// tracing operations. The modified MultiStore is returned.
 	SetTracingContext(TraceContext) MultiStore
+
+	// ListeningEnabled returns if listening is enabled for the KVStore
+	// belonging to the given StoreKey.
+	ListeningEnabled(key StoreKey) bool
+
+	// AddListeners adds WriteListeners for the KVStore belonging to the given
+	// StoreKey, appending them to any listeners already registered.
+	AddListeners(key StoreKey, listeners []WriteListener)
 }
 
 // From MultiStore.CacheMultiStore()....
@@ -128,6 +138,7 @@ type CacheMultiStore interface {
 type CommitMultiStore interface {
 	Committer
 	MultiStore
+	snapshottypes.Snapshotter
 
 	// Mount a store of type using the given db.
 	// If db == nil, the new store will use the CommitMultiStore db.
@@ -162,6 +173,13 @@ type CommitMultiStore interface {
 	// Set an inter-block (persistent) cache that maintains a mapping from
 	// StoreKeys to CommitKVStores.
 	SetInterBlockCache(MultiStorePersistentCache)
+
+	// GetPruning returns the pruning strategy of the multi-store.
+	GetPruning() PruningOptions
+
+	// AvailableVersions returns the versions which can be loaded with
+	// CacheMultiStoreWithVersion, in ascending order.
+	AvailableVersions() []int64
 }
 
 //---------subsp-------------------------------
@@ -218,6 +236,26 @@ type CommitKVStore interface {
 	KVStore
 }
 
+// CommitmentStore is a CommitKVStore whose committed versions are retained
+// and committed to by a Merkle tree, e.g. the IAVL and SMT stores. The root
+// multi-store loads and queries past versions of its substores through it,
+// independently of the tree backing them.
+type CommitmentStore interface {
+	CommitKVStore
+	Queryable
+
+	// VersionExists returns whether the given version is retained by the store.
+	VersionExists(version int64) bool
+
+	// AvailableVersions returns the versions retained by the store in
+	// ascending order.
+	AvailableVersions() []int64
+
+	// GetImmutableVersion returns a read-only KVStore with the state of the
+	// store at the given version. Any mutable operation on it panics.
+	GetImmutableVersion(version int64) (KVStore, error)
+}
+
 //----------------------------------------
 // CacheWrap
 
@@ -273,6 +311,7 @@ const (
 	StoreTypeIAVL
 	StoreTypeTransient
 	StoreTypeMemory
+	StoreTypeSMT
 )
 
 func (st StoreType) String() string {
@@ -291,6 +330,9 @@ func (st StoreType) String() string {
 
 	case StoreTypeMemory:
 		return "StoreTypeMemory"
+
+	case StoreTypeSMT:
+		return "StoreTypeSMT"
 	}
 
 	return "unknown store type"
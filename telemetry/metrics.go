@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
@@ -91,6 +92,8 @@ func New(cfg Config) (*Metrics, error) {
 		return nil, err
 	}
 
+	atomic.StoreInt32(&enabled, 1)
+
 	return m, nil
 }
 
@@ -0,0 +1,36 @@
+package telemetry
+
+import (
+	"sync/atomic"
+
+	"github.com/armon/go-metrics"
+)
+
+// Common metric labels.
+const (
+	MetricLabelNameStoreKey = "store_key"
+)
+
+// enabled is set to 1 once application telemetry is enabled with New.
+var enabled int32
+
+// IsEnabled returns true if application telemetry was enabled with New. It
+// allows to skip computing metrics on hot paths otherwise.
+func IsEnabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// NewLabel returns a metrics label with the given name and value.
+func NewLabel(name, value string) metrics.Label {
+	return metrics.Label{Name: name, Value: value}
+}
+
+// IncrCounterWithLabels increments the counter of the given keys and labels by
+// the given value if application telemetry is enabled.
+func IncrCounterWithLabels(keys []string, val float32, labels []metrics.Label) {
+	if !IsEnabled() {
+		return
+	}
+
+	metrics.IncrCounterWithLabels(keys, val, labels)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/gaskv"
 	stypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 /*
@@ -35,6 +36,8 @@ type Context struct {
 	minGasPrice   DecCoins
 	consParams    *abci.ConsensusParams
 	eventManager  *EventManager
+	priority      int64 // the priority of the tx in the mempool, set by the AnteHandler in CheckTx
+	kvGasConfigs  map[StoreKey]GasConfig
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -55,6 +58,17 @@ func (c Context) IsCheckTx() bool             { return c.checkTx }
 func (c Context) IsReCheckTx() bool           { return c.recheckTx }
 func (c Context) MinGasPrices() DecCoins      { return c.minGasPrice }
 func (c Context) EventManager() *EventManager { return c.eventManager }
+func (c Context) Priority() int64             { return c.priority }
+
+// KVGasConfig returns the gas config of the KVStore of the given key, which
+// is the default KVGasConfig unless set with WithKVGasConfigs.
+func (c Context) KVGasConfig(key StoreKey) GasConfig {
+	if config, ok := c.kvGasConfigs[key]; ok {
+		return config
+	}
+
+	return stypes.KVGasConfig()
+}
 
 // clone the header before returning
 func (c Context) BlockHeader() abci.Header {
@@ -179,6 +193,19 @@ func (c Context) WithEventManager(em *EventManager) Context {
 	return c
 }
 
+// WithPriority returns a Context with an updated tx priority
+func (c Context) WithPriority(p int64) Context {
+	c.priority = p
+	return c
+}
+
+// WithKVGasConfigs returns a Context with the gas configs of the KVStores of
+// the given keys, overriding the default KVGasConfig.
+func (c Context) WithKVGasConfigs(configs map[StoreKey]GasConfig) Context {
+	c.kvGasConfigs = configs
+	return c
+}
+
 // TODO: remove???
 func (c Context) IsZero() bool {
 	return c.ms == nil
@@ -207,14 +234,26 @@ func (c Context) Value(key interface{}) interface{} {
 // Store / Caching
 // ----------------------------------------------------------------------------
 
-// KVStore fetches a KVStore from the MultiStore.
+// KVStore fetches a KVStore from the MultiStore. Its accesses consume gas as
+// per the store's KVGasConfig and are reported to telemetry by store key if
+// telemetry is enabled.
 func (c Context) KVStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.KVGasConfig())
+	return c.gasKVStore(c.MultiStore().GetKVStore(key), c.KVGasConfig(key), key)
 }
 
 // TransientStore fetches a TransientStore from the MultiStore.
 func (c Context) TransientStore(key StoreKey) KVStore {
-	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), stypes.TransientGasConfig())
+	return c.gasKVStore(c.MultiStore().GetKVStore(key), stypes.TransientGasConfig(), key)
+}
+
+// gasKVStore wraps the given store to consume gas. The store key telemetry is
+// only recorded if telemetry is enabled, keeping it off the hot path otherwise.
+func (c Context) gasKVStore(parent KVStore, gasConfig GasConfig, key StoreKey) KVStore {
+	if telemetry.IsEnabled() {
+		return gaskv.NewStoreWithTelemetry(parent, c.GasMeter(), gasConfig, key)
+	}
+
+	return gaskv.NewStore(parent, c.GasMeter(), gasConfig)
 }
 
 // CacheContext returns a new Context with the multi-store cached and a new
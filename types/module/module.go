@@ -34,6 +34,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -54,6 +55,7 @@ type AppModuleBasic interface {
 
 	// client functionality
 	RegisterRESTRoutes(client.Context, *mux.Router)
+	RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux)
 	GetTxCmd(clientCtx client.Context) *cobra.Command
 	GetQueryCmd(clientCtx client.Context) *cobra.Command
 }
@@ -105,6 +107,14 @@ func (bm BasicManager) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rou
 	}
 }
 
+// RegisterGRPCGatewayRoutes registers all module rest routes generated from
+// their gRPC query services
+func (bm BasicManager) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *runtime.ServeMux) {
+	for _, b := range bm {
+		b.RegisterGRPCGatewayRoutes(clientCtx, rtr)
+	}
+}
+
 // AddTxCommands adds all tx commands to the rootTxCmd
 func (bm BasicManager) AddTxCommands(rootTxCmd *cobra.Command, ctx client.Context) {
 	for _, b := range bm {
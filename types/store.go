@@ -71,6 +71,7 @@ const (
 	StoreTypeIAVL      = types.StoreTypeIAVL
 	StoreTypeTransient = types.StoreTypeTransient
 	StoreTypeMemory    = types.StoreTypeMemory
+	StoreTypeSMT       = types.StoreTypeSMT
 )
 
 type (
@@ -581,6 +581,9 @@ type Fee struct {
 	// gas_limit is the maximum gas that can be used in transaction processing
 	// before an out of gas error occurs
 	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// granter is the account which granted the fee payer an allowance to pay the
+	// fee (see x/feegrant). The fee is paid by the fee payer when it is empty
+	Granter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=granter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"granter,omitempty"`
 }
 
 func (m *Fee) Reset()         { *m = Fee{} }
@@ -630,6 +633,13 @@ func (m *Fee) GetGasLimit() uint64 {
 	return 0
 }
 
+func (m *Fee) GetGranter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Granter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Tx)(nil), "cosmos.tx.Tx")
 	proto.RegisterType((*SignDoc)(nil), "cosmos.tx.SignDoc")
@@ -645,54 +655,56 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/tx.proto", fileDescriptor_9b35c9d5d6b7bce8) }
 
 var fileDescriptor_9b35c9d5d6b7bce8 = []byte{
-	// 749 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xdb, 0x38,
-	0x10, 0xb6, 0x2c, 0xdb, 0xb1, 0x27, 0xff, 0x4c, 0x16, 0x50, 0x1c, 0x40, 0x31, 0x0c, 0x04, 0xf0,
-	0x1e, 0x56, 0xca, 0x3a, 0x7b, 0xd8, 0xdd, 0xcb, 0x22, 0xce, 0x6e, 0x90, 0x60, 0x9b, 0x16, 0xa0,
-	0x83, 0x1e, 0x72, 0x11, 0x64, 0x99, 0x96, 0x89, 0x58, 0xa4, 0x2b, 0x52, 0xa8, 0x5d, 0xa0, 0xef,
-	0xd0, 0x4b, 0x5f, 0xa2, 0x87, 0x3e, 0x47, 0x6e, 0xcd, 0xb1, 0xa7, 0xb6, 0x48, 0x1e, 0xa4, 0x85,
-	0x28, 0xca, 0x71, 0x0b, 0x37, 0x3d, 0xf5, 0xa4, 0xe1, 0x37, 0xdf, 0x37, 0x33, 0x9c, 0x19, 0x11,
-	0x50, 0xc0, 0x45, 0xc4, 0x85, 0x2b, 0x27, 0xae, 0x9c, 0x38, 0xe3, 0x98, 0x4b, 0x8e, 0x6a, 0x19,
-	0xe6, 0xc8, 0x49, 0x7d, 0x3b, 0xe4, 0x21, 0x57, 0xa8, 0x9b, 0x5a, 0x19, 0xa1, 0x5e, 0xd7, 0xa2,
-	0x20, 0x9e, 0x8e, 0x25, 0xd7, 0x1f, 0xed, 0xdb, 0xca, 0x7d, 0x59, 0x8c, 0x0c, 0xdc, 0xbb, 0xcf,
-	0x22, 0x68, 0xc8, 0x28, 0x0b, 0xf3, 0xaf, 0x26, 0xec, 0x84, 0x9c, 0x87, 0x23, 0xe2, 0xaa, 0x53,
-	0x2f, 0x19, 0xb8, 0x3e, 0x9b, 0x66, 0xae, 0xe6, 0x4b, 0x28, 0x5e, 0x4c, 0xd0, 0x3e, 0x94, 0x7a,
-	0xbc, 0x3f, 0xb5, 0x8c, 0x86, 0xd1, 0x5a, 0x6e, 0x6f, 0x3a, 0xb3, 0x12, 0x9d, 0x8b, 0x49, 0x87,
-	0xf7, 0xa7, 0x58, 0xb9, 0xd1, 0x01, 0xd4, 0xfc, 0x44, 0x0e, 0x3d, 0xca, 0x06, 0xdc, 0x2a, 0x2a,
-	0xee, 0xd6, 0x1c, 0xf7, 0x28, 0x91, 0xc3, 0x33, 0x36, 0xe0, 0xb8, 0xea, 0x6b, 0x0b, 0xd9, 0x00,
-	0x69, 0x29, 0xbe, 0x4c, 0x62, 0x22, 0x2c, 0xb3, 0x61, 0xb6, 0x56, 0xf0, 0x1c, 0xd2, 0x7c, 0x67,
-	0xc0, 0x52, 0x97, 0x86, 0xec, 0x5f, 0x1e, 0xfc, 0xbc, 0x22, 0x76, 0xa0, 0x1a, 0x0c, 0x7d, 0xca,
-	0x3c, 0xda, 0xb7, 0xcc, 0x86, 0xd1, 0xaa, 0xe1, 0x25, 0x75, 0x3e, 0xeb, 0xa3, 0x7d, 0x58, 0xf3,
-	0x83, 0x80, 0x27, 0x4c, 0x7a, 0x2c, 0x89, 0x7a, 0x24, 0xb6, 0x4a, 0x0d, 0xa3, 0x55, 0xc2, 0xab,
-	0x1a, 0x7d, 0xac, 0x40, 0xf4, 0x2b, 0x6c, 0xe4, 0x34, 0x41, 0x9e, 0x25, 0x84, 0x05, 0xc4, 0x2a,
-	0x2b, 0xe2, 0xba, 0xc6, 0xbb, 0x1a, 0x6e, 0xbe, 0x2e, 0x42, 0x25, 0xab, 0x17, 0x1d, 0x40, 0x35,
-	0x22, 0x42, 0xf8, 0x21, 0x11, 0x96, 0xd1, 0x30, 0x5b, 0xcb, 0xed, 0x6d, 0x27, 0x9b, 0x84, 0x93,
-	0x4f, 0xc2, 0x39, 0x62, 0x53, 0x3c, 0x63, 0x21, 0x04, 0xa5, 0x88, 0x44, 0xd9, 0xb5, 0x6a, 0x58,
-	0xd9, 0x69, 0x89, 0x92, 0x46, 0x84, 0x27, 0xd2, 0x1b, 0x12, 0x1a, 0x0e, 0xa5, 0xba, 0x83, 0x89,
-	0x57, 0x35, 0x7a, 0xaa, 0x40, 0xd4, 0x81, 0x4d, 0x32, 0x91, 0x84, 0x09, 0xca, 0x99, 0xc7, 0xc7,
-	0x92, 0x72, 0x26, 0xac, 0xcf, 0x4b, 0x0f, 0xa4, 0xdd, 0x98, 0xf1, 0x9f, 0x64, 0x74, 0x74, 0x09,
-	0x36, 0xe3, 0xcc, 0x0b, 0x62, 0x2a, 0x69, 0xe0, 0x8f, 0xbc, 0x05, 0x01, 0xd7, 0x1f, 0x08, 0xb8,
-	0xcb, 0x38, 0x3b, 0xd6, 0xda, 0xff, 0xbe, 0x89, 0xdd, 0x1c, 0x40, 0x35, 0x1f, 0x0d, 0xfa, 0x13,
-	0x56, 0xd2, 0x1d, 0x20, 0xb1, 0x1a, 0x62, 0xde, 0x9c, 0x5f, 0xe6, 0xa6, 0xd8, 0x55, 0x6e, 0x35,
-	0xc7, 0x65, 0x31, 0xb3, 0x05, 0x6a, 0x80, 0x39, 0x20, 0x44, 0x8f, 0x7d, 0x6d, 0x4e, 0x70, 0x42,
-	0x08, 0x4e, 0x5d, 0x4d, 0x01, 0x70, 0x2f, 0x46, 0x87, 0x00, 0xe3, 0xa4, 0x37, 0xa2, 0x81, 0x77,
-	0x45, 0xf2, 0xcd, 0x5a, 0x5c, 0x7c, 0x2d, 0xe3, 0xfd, 0x4f, 0xd4, 0x86, 0x45, 0xbc, 0x4f, 0xbe,
-	0xb7, 0x61, 0xe7, 0xbc, 0x4f, 0xb2, 0x0d, 0x8b, 0xb4, 0xd5, 0x7c, 0x5b, 0x84, 0x6a, 0x0e, 0xa3,
-	0x3f, 0xa0, 0x22, 0x28, 0x0b, 0x47, 0x44, 0xe7, 0xab, 0x2f, 0xd0, 0x3a, 0x5d, 0xc5, 0x38, 0x2d,
-	0x60, 0xcd, 0x45, 0xbf, 0x43, 0x39, 0x4a, 0x46, 0x92, 0xea, 0x84, 0x3b, 0x8b, 0x44, 0xe7, 0x29,
-	0xe1, 0xb4, 0x80, 0x33, 0x66, 0xfd, 0x2f, 0xa8, 0x64, 0x61, 0x90, 0x0b, 0xa5, 0xb4, 0x16, 0x95,
-	0x70, 0xad, 0xbd, 0x3b, 0xa7, 0xcd, 0x1f, 0x82, 0xb4, 0x27, 0x69, 0x1c, 0xac, 0x88, 0xf5, 0xe7,
-	0x50, 0x56, 0xc1, 0xd0, 0xdf, 0x50, 0xed, 0x51, 0xe9, 0xc7, 0xb1, 0x9f, 0xb7, 0xc7, 0xce, 0xd5,
-	0xfa, 0xe1, 0x39, 0xe6, 0xd1, 0xd8, 0x0f, 0x64, 0x87, 0xca, 0xa3, 0x94, 0x85, 0x67, 0x7c, 0xd4,
-	0x06, 0x98, 0xf5, 0x49, 0x58, 0x45, 0x35, 0xc4, 0x85, 0x8d, 0xaa, 0xe5, 0x8d, 0x12, 0x9d, 0x32,
-	0x98, 0x22, 0x89, 0x9a, 0x2f, 0xc0, 0x3c, 0x21, 0x04, 0x3d, 0x85, 0x8a, 0x1f, 0xa5, 0xbf, 0x8f,
-	0x5e, 0x81, 0x95, 0x5c, 0x7d, 0xcc, 0x29, 0xeb, 0x1c, 0x5c, 0x7f, 0xd8, 0x2b, 0xbc, 0xf9, 0xb8,
-	0xd7, 0x0a, 0xa9, 0x1c, 0x26, 0x3d, 0x27, 0xe0, 0x91, 0xfb, 0xd5, 0xfb, 0xf7, 0x9b, 0xe8, 0x5f,
-	0xb9, 0x72, 0x3a, 0x26, 0x99, 0x40, 0x60, 0x1d, 0x0d, 0xed, 0x42, 0x2d, 0xf4, 0x85, 0x37, 0xa2,
-	0x11, 0x95, 0xaa, 0xa1, 0x25, 0x5c, 0x0d, 0x7d, 0xf1, 0x28, 0x3d, 0x77, 0xfe, 0xb9, 0xbe, 0xb5,
-	0x8d, 0x9b, 0x5b, 0xdb, 0xf8, 0x74, 0x6b, 0x1b, 0xaf, 0xee, 0xec, 0xc2, 0xcd, 0x9d, 0x5d, 0x78,
-	0x7f, 0x67, 0x17, 0x2e, 0xf7, 0x7f, 0x9c, 0xc8, 0x95, 0x93, 0x5e, 0x45, 0x2d, 0xce, 0xe1, 0x97,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0xf8, 0x98, 0x8a, 0xde, 0x05, 0x00, 0x00,
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0x8f, 0xe3, 0xfc, 0x7d, 0xcd, 0x76, 0x77, 0x67, 0x17, 0xc9, 0x4d, 0x25, 0x27, 0x8a, 0x54,
+	0x29, 0x1c, 0xd6, 0xee, 0x66, 0x39, 0x00, 0x17, 0x94, 0x14, 0xaa, 0x56, 0xa5, 0x20, 0x4d, 0x2a,
+	0x0e, 0xbd, 0x58, 0x8e, 0x3d, 0x71, 0x46, 0x8d, 0x67, 0x82, 0x67, 0x2c, 0x92, 0x03, 0xdf, 0x81,
+	0x0b, 0x5f, 0x82, 0x03, 0x5f, 0x83, 0xde, 0xe8, 0x91, 0x53, 0x41, 0xed, 0xb7, 0xe0, 0x02, 0xf2,
+	0x78, 0x9c, 0x06, 0x94, 0x16, 0x2e, 0x7b, 0xf2, 0xf8, 0xf7, 0x7e, 0xbf, 0xdf, 0x7b, 0x7e, 0xef,
+	0x79, 0x00, 0x05, 0x5c, 0xc4, 0x5c, 0xb8, 0x72, 0xe9, 0xca, 0xa5, 0xb3, 0x48, 0xb8, 0xe4, 0xa8,
+	0x99, 0x63, 0x8e, 0x5c, 0xb6, 0x5f, 0x47, 0x3c, 0xe2, 0x0a, 0x75, 0xb3, 0x53, 0x4e, 0x68, 0xb7,
+	0xb5, 0x28, 0x48, 0x56, 0x0b, 0xc9, 0xf5, 0x43, 0xc7, 0x5e, 0x15, 0xb1, 0xdc, 0x23, 0x07, 0x3b,
+	0x0f, 0x59, 0x04, 0x8d, 0x18, 0x65, 0x51, 0xf1, 0xd4, 0x84, 0xbd, 0x88, 0xf3, 0x68, 0x4e, 0x5c,
+	0xf5, 0x36, 0x49, 0xa7, 0xae, 0xcf, 0x56, 0x79, 0xa8, 0xf7, 0x3d, 0x94, 0x2f, 0x96, 0xe8, 0x00,
+	0x2a, 0x13, 0x1e, 0xae, 0x2c, 0xa3, 0x6b, 0xf4, 0x77, 0x06, 0x2f, 0x9d, 0x75, 0x89, 0xce, 0xc5,
+	0x72, 0xc4, 0xc3, 0x15, 0x56, 0x61, 0x74, 0x08, 0x4d, 0x3f, 0x95, 0x33, 0x8f, 0xb2, 0x29, 0xb7,
+	0xca, 0x8a, 0xfb, 0x6a, 0x83, 0x3b, 0x4c, 0xe5, 0xec, 0x94, 0x4d, 0x39, 0x6e, 0xf8, 0xfa, 0x84,
+	0x6c, 0x80, 0xac, 0x14, 0x5f, 0xa6, 0x09, 0x11, 0x96, 0xd9, 0x35, 0xfb, 0x2d, 0xbc, 0x81, 0xf4,
+	0x7e, 0x35, 0xa0, 0x3e, 0xa6, 0x11, 0xfb, 0x9c, 0x07, 0xef, 0xaf, 0x88, 0x3d, 0x68, 0x04, 0x33,
+	0x9f, 0x32, 0x8f, 0x86, 0x96, 0xd9, 0x35, 0xfa, 0x4d, 0x5c, 0x57, 0xef, 0xa7, 0x21, 0x3a, 0x80,
+	0x5d, 0x3f, 0x08, 0x78, 0xca, 0xa4, 0xc7, 0xd2, 0x78, 0x42, 0x12, 0xab, 0xd2, 0x35, 0xfa, 0x15,
+	0xfc, 0x4c, 0xa3, 0x5f, 0x29, 0x10, 0x7d, 0x08, 0x2f, 0x0a, 0x9a, 0x20, 0xdf, 0xa6, 0x84, 0x05,
+	0xc4, 0xaa, 0x2a, 0xe2, 0x73, 0x8d, 0x8f, 0x35, 0xdc, 0xfb, 0xb1, 0x0c, 0xb5, 0xbc, 0x5e, 0x74,
+	0x08, 0x8d, 0x98, 0x08, 0xe1, 0x47, 0x44, 0x58, 0x46, 0xd7, 0xec, 0xef, 0x0c, 0x5e, 0x3b, 0xf9,
+	0x24, 0x9c, 0x62, 0x12, 0xce, 0x90, 0xad, 0xf0, 0x9a, 0x85, 0x10, 0x54, 0x62, 0x12, 0xe7, 0x9f,
+	0xd5, 0xc4, 0xea, 0x9c, 0x95, 0x28, 0x69, 0x4c, 0x78, 0x2a, 0xbd, 0x19, 0xa1, 0xd1, 0x4c, 0xaa,
+	0x6f, 0x30, 0xf1, 0x33, 0x8d, 0x9e, 0x28, 0x10, 0x8d, 0xe0, 0x25, 0x59, 0x4a, 0xc2, 0x04, 0xe5,
+	0xcc, 0xe3, 0x0b, 0x49, 0x39, 0x13, 0xd6, 0x5f, 0xf5, 0x27, 0xd2, 0xbe, 0x58, 0xf3, 0xbf, 0xce,
+	0xe9, 0xe8, 0x12, 0x6c, 0xc6, 0x99, 0x17, 0x24, 0x54, 0xd2, 0xc0, 0x9f, 0x7b, 0x5b, 0x0c, 0x9f,
+	0x3f, 0x61, 0xb8, 0xcf, 0x38, 0x3b, 0xd2, 0xda, 0x2f, 0xfe, 0xe5, 0xdd, 0x9b, 0x42, 0xa3, 0x18,
+	0x0d, 0xfa, 0x18, 0x5a, 0xd9, 0x0e, 0x90, 0x44, 0x0d, 0xb1, 0x68, 0xce, 0x07, 0x1b, 0x53, 0x1c,
+	0xab, 0xb0, 0x9a, 0xe3, 0x8e, 0x58, 0x9f, 0x05, 0xea, 0x82, 0x39, 0x25, 0x44, 0x8f, 0x7d, 0x77,
+	0x43, 0x70, 0x4c, 0x08, 0xce, 0x42, 0x3d, 0x01, 0xf0, 0x20, 0x46, 0xef, 0x00, 0x16, 0xe9, 0x64,
+	0x4e, 0x03, 0xef, 0x8a, 0x14, 0x9b, 0xb5, 0xbd, 0xf8, 0x66, 0xce, 0x3b, 0x23, 0x6a, 0xc3, 0x62,
+	0x1e, 0x92, 0xc7, 0x36, 0xec, 0x9c, 0x87, 0x24, 0xdf, 0xb0, 0x58, 0x9f, 0x7a, 0x3f, 0x97, 0xa1,
+	0x51, 0xc0, 0xe8, 0x23, 0xa8, 0x09, 0xca, 0xa2, 0x39, 0xd1, 0xf9, 0xda, 0x5b, 0xb4, 0xce, 0x58,
+	0x31, 0x4e, 0x4a, 0x58, 0x73, 0xd1, 0x5b, 0xa8, 0xc6, 0xe9, 0x5c, 0x52, 0x9d, 0x70, 0x6f, 0x9b,
+	0xe8, 0x3c, 0x23, 0x9c, 0x94, 0x70, 0xce, 0x6c, 0x7f, 0x02, 0xb5, 0xdc, 0x06, 0xb9, 0x50, 0xc9,
+	0x6a, 0x51, 0x09, 0x77, 0x07, 0xfb, 0x1b, 0xda, 0xe2, 0x22, 0xc8, 0x7a, 0x92, 0xf9, 0x60, 0x45,
+	0x6c, 0x7f, 0x07, 0x55, 0x65, 0x86, 0x3e, 0x85, 0xc6, 0x84, 0x4a, 0x3f, 0x49, 0xfc, 0xa2, 0x3d,
+	0x76, 0xa1, 0xd6, 0x17, 0xcf, 0x11, 0x8f, 0x17, 0x7e, 0x20, 0x47, 0x54, 0x0e, 0x33, 0x16, 0x5e,
+	0xf3, 0xd1, 0x00, 0x60, 0xdd, 0x27, 0x61, 0x95, 0xbb, 0xe6, 0x63, 0x8d, 0x6a, 0x16, 0x8d, 0x12,
+	0xa3, 0x2a, 0x98, 0x22, 0x8d, 0x7b, 0xbf, 0x18, 0x60, 0x1e, 0x13, 0x82, 0xbe, 0x81, 0x9a, 0x1f,
+	0x67, 0xff, 0x8f, 0xde, 0x81, 0x56, 0x21, 0x3f, 0xe2, 0x94, 0x8d, 0x0e, 0xaf, 0x6f, 0x3b, 0xa5,
+	0x9f, 0x7e, 0xef, 0xf4, 0x23, 0x2a, 0x67, 0xe9, 0xc4, 0x09, 0x78, 0xec, 0xfe, 0xe3, 0x02, 0x7c,
+	0x23, 0xc2, 0x2b, 0x57, 0xae, 0x16, 0x24, 0x17, 0x08, 0xac, 0xdd, 0xd0, 0x3e, 0x34, 0x23, 0x5f,
+	0x78, 0x73, 0x1a, 0x53, 0xa9, 0x3a, 0x5a, 0xc1, 0x8d, 0xc8, 0x17, 0x5f, 0x66, 0xef, 0xe8, 0x0c,
+	0xea, 0x51, 0xe2, 0x33, 0x49, 0x12, 0xf5, 0x2b, 0xb5, 0x46, 0x6f, 0xff, 0xbc, 0xed, 0xbc, 0xf9,
+	0x1f, 0x39, 0x86, 0x41, 0x30, 0x0c, 0xc3, 0x84, 0x08, 0x81, 0x0b, 0x87, 0xd1, 0x67, 0xd7, 0x77,
+	0xb6, 0x71, 0x73, 0x67, 0x1b, 0x7f, 0xdc, 0xd9, 0xc6, 0x0f, 0xf7, 0x76, 0xe9, 0xe6, 0xde, 0x2e,
+	0xfd, 0x76, 0x6f, 0x97, 0x2e, 0x0f, 0xfe, 0xdb, 0xd1, 0x95, 0xcb, 0x49, 0x4d, 0xad, 0xe1, 0xbb,
+	0xbf, 0x07, 0x00, 0x8e, 0xf9, 0xf3, 0x92, 0x2c, 0x06, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1163,6 +1175,13 @@ func (m *Fee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.GasLimit != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
 		i--
@@ -1398,6 +1417,10 @@ func (m *Fee) Size() (n int) {
 	if m.GasLimit != 0 {
 		n += 1 + sovTx(uint64(m.GasLimit))
 	}
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2613,6 +2636,40 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = append(m.Granter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Granter == nil {
+				m.Granter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
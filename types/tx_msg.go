@@ -64,6 +64,9 @@ type (
 		GetGas() uint64
 		GetFee() Coins
 		FeePayer() AccAddress
+		// FeeGranter returns the account that pays the fee on behalf of the
+		// FeePayer through a fee allowance, or nil if there is none.
+		FeeGranter() AccAddress
 	}
 
 	// Tx must have GetMemo() method to use ValidateMemoDecorator
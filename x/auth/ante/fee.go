@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,11 +53,45 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
 			}
 		}
+
+		ctx = ctx.WithPriority(GetTxPriority(feeCoins, gas, minGasPrices))
 	}
 
 	return next(ctx, tx, simulate)
 }
 
+// GetTxPriority returns the mempool priority of a tx paying the given fees for
+// the given gas limit. It is the highest ratio, over the denominations of the
+// minimum gas prices, between the gas price paid by the tx and the minimum gas
+// price, in thousandths: a tx paying exactly the minimum gas price has a
+// priority of 1000. The priority is 0 if no minimum gas prices are set.
+func GetTxPriority(feeCoins sdk.Coins, gas uint64, minGasPrices sdk.DecCoins) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	glDec := sdk.NewDec(int64(gas))
+
+	var priority int64
+	for _, gp := range minGasPrices {
+		if !gp.Amount.IsPositive() {
+			continue
+		}
+
+		// ratio = fee / (minGasPrice * gasLimit)
+		ratio := feeCoins.AmountOf(gp.Denom).ToDec().Quo(gp.Amount.Mul(glDec)).MulInt64(1000).TruncateInt()
+		if !ratio.IsInt64() {
+			return math.MaxInt64
+		}
+
+		if ratio.Int64() > priority {
+			priority = ratio.Int64()
+		}
+	}
+
+	return priority
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
@@ -83,6 +118,11 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		panic(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
 	}
 
+	// paying fees from a fee allowance requires the x/feegrant ante handler
+	if feeTx.FeeGranter() != nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
 	feePayer := feeTx.FeePayer()
 	feePayerAcc := dfd.ak.GetAccount(ctx, feePayer)
 
@@ -58,6 +58,46 @@ func TestEnsureMempoolFees(t *testing.T) {
 
 	_, err = antehandler(ctx, tx, false)
 	require.Nil(t, err, "Decorator should not have errored on fee higher than local gasPrice")
+
+	// the priority is the paid gas price relative to the minimum gas price
+	atomPrice = sdk.NewDecCoinFromDec("atom", sdk.NewDec(100).Quo(sdk.NewDec(100000)))
+	ctx = ctx.WithMinGasPrices([]sdk.DecCoin{atomPrice})
+
+	newCtx, err := antehandler(ctx, tx, false)
+	require.Nil(t, err, "Decorator should not have errored on fee higher than local gasPrice")
+	require.Equal(t, int64(1500), newCtx.Priority())
+}
+
+func TestGetTxPriority(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("stake", 50))
+
+	testCases := []struct {
+		name         string
+		gas          uint64
+		minGasPrices sdk.DecCoins
+		expPriority  int64
+	}{
+		{"no min gas prices", 100000, sdk.DecCoins{}, 0},
+		{"zero gas", 0, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3))), 0},
+		{"min gas price", 150000, sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3))), 1000},
+		{"unpaid denom", 100000, sdk.NewDecCoins(sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(1, 3))), 0},
+		{
+			"highest ratio", 100000,
+			sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 3)),
+				sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 4)),
+			),
+			5000,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expPriority, ante.GetTxPriority(fee, tc.gas, tc.minGasPrices))
+		})
+	}
 }
 
 func TestDeductFees(t *testing.T) {
@@ -95,4 +135,13 @@ func TestDeductFees(t *testing.T) {
 	_, err = antehandler(ctx, tx, false)
 
 	require.Nil(t, err, "Tx errored after account has been set with sufficient funds")
+
+	// fee grants require the x/feegrant fee decorator
+	_, _, addr2 := types.KeyTestPubAddr()
+	fee.Granter = addr2
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	_, err = antehandler(ctx, tx, false)
+
+	require.NotNil(t, err, "Tx did not error when paying fees with a fee grant")
 }
@@ -0,0 +1,96 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ProtoTxDecoder converts a protobuf transaction to the sdk.Tx run by the
+// application.
+type ProtoTxDecoder func(tx *txtypes.Tx) (sdk.Tx, error)
+
+// SimulateStdTxDecoder returns a ProtoTxDecoder for applications running amino
+// StdTx transactions. The messages are unpacked with anyUnpacker, the signer
+// public keys are decoded with pubKeyCodec, and the fee, fee granter, memo and
+// signatures are copied to a StdTx.
+//
+// Protobuf signatures are not valid for the StdTx sign bytes, so the returned
+// decoder must only be used to simulate transactions.
+func SimulateStdTxDecoder(anyUnpacker codectypes.AnyUnpacker, pubKeyCodec cryptotypes.PublicKeyCodec) ProtoTxDecoder {
+	return func(tx *txtypes.Tx) (sdk.Tx, error) {
+		if tx.Body == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "missing tx body")
+		}
+
+		msgs := make([]sdk.Msg, len(tx.Body.Messages))
+		for i, any := range tx.Body.Messages {
+			var msg sdk.Msg
+			if err := anyUnpacker.UnpackAny(any, &msg); err != nil {
+				return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "message %d: %s", i, err)
+			}
+
+			msgs[i] = msg
+		}
+
+		var (
+			fee         authtypes.StdFee
+			signerInfos []*txtypes.SignerInfo
+		)
+		if tx.AuthInfo != nil {
+			if tx.AuthInfo.Fee != nil {
+				fee = authtypes.NewStdFee(tx.AuthInfo.Fee.GasLimit, tx.AuthInfo.Fee.Amount)
+				fee.Granter = tx.AuthInfo.Fee.Granter
+			}
+
+			signerInfos = tx.AuthInfo.SignerInfos
+		}
+
+		// the public keys are needed to charge the gas of first-time signers,
+		// so a signature is added for each signer info even if it is missing
+		numSigs := len(tx.Signatures)
+		if len(signerInfos) > numSigs {
+			numSigs = len(signerInfos)
+		}
+
+		sigs := make([]authtypes.StdSignature, numSigs)
+		for i := range sigs {
+			if i < len(tx.Signatures) {
+				sigs[i].Signature = tx.Signatures[i]
+			}
+
+			if i < len(signerInfos) && signerInfos[i] != nil && signerInfos[i].PublicKey != nil {
+				pubKey, err := decodePubKey(pubKeyCodec, signerInfos[i].PublicKey)
+				if err != nil {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrTxDecode, "signer %d: %s", i, err)
+				}
+
+				sigs[i] = authtypes.NewStdSignature(pubKey, sigs[i].Signature)
+			}
+		}
+
+		return authtypes.NewStdTx(msgs, fee, sigs, tx.Body.Memo), nil
+	}
+}
+
+// decodePubKey decodes a public key packed as a protobuf PublicKey.
+func decodePubKey(pubKeyCodec cryptotypes.PublicKeyCodec, any *codectypes.Any) (crypto.PubKey, error) {
+	var pk cryptotypes.PublicKey
+	if typeURL := "/" + proto.MessageName(&pk); any.TypeUrl != typeURL {
+		return nil, fmt.Errorf("expected public key of type %s, got %s", typeURL, any.TypeUrl)
+	}
+
+	if err := pk.Unmarshal(any.Value); err != nil {
+		return nil, err
+	}
+
+	return pubKeyCodec.Decode(&pk)
+}
@@ -0,0 +1,63 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSimulateStdTxDecoder(t *testing.T) {
+	app := simapp.Setup(false)
+	decoder := authtx.SimulateStdTxDecoder(app.AppCodec(), std.DefaultPublicKeyCodec{})
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	sender := sdk.AccAddress(pubKey.Address())
+	granter := sdk.AccAddress("granter_____________")
+
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(sender, granter, sdk.NewCoins(sdk.NewInt64Coin("atom", 10))))
+	require.NoError(t, err)
+
+	pk, err := std.DefaultPublicKeyCodec{}.Encode(pubKey)
+	require.NoError(t, err)
+	pkAny, err := codectypes.NewAnyWithValue(pk)
+	require.NoError(t, err)
+
+	fee := &txtypes.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 1)), GasLimit: 100000, Granter: granter}
+	protoTx := &txtypes.Tx{
+		Body: &txtypes.TxBody{Messages: []*codectypes.Any{msg}, Memo: "memo"},
+		AuthInfo: &txtypes.AuthInfo{
+			SignerInfos: []*txtypes.SignerInfo{{PublicKey: pkAny}},
+			Fee:         fee,
+		},
+	}
+
+	tx, err := decoder(protoTx)
+	require.NoError(t, err)
+
+	stdTx, ok := tx.(authtypes.StdTx)
+	require.True(t, ok)
+	require.Equal(t, "memo", stdTx.GetMemo())
+	require.Equal(t, fee.Amount, stdTx.GetFee())
+	require.Equal(t, fee.GasLimit, stdTx.GetGas())
+
+	// the fee granter and signer public keys are carried through, so that the
+	// simulation charges the same gas as the real transaction
+	require.Equal(t, granter, stdTx.FeeGranter())
+	require.Len(t, stdTx.GetPubKeys(), 1)
+	require.True(t, pubKey.Equals(stdTx.GetPubKeys()[0]))
+
+	// public keys which are not protobuf PublicKeys are rejected
+	protoTx.AuthInfo.SignerInfos[0].PublicKey = msg
+	_, err = decoder(protoTx)
+	require.Error(t, err)
+}
@@ -0,0 +1,253 @@
+package tx
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// baseAppSimulateFn is the signature of the BaseApp.Simulate function.
+type baseAppSimulateFn func(txBytes []byte, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error)
+
+// txServer is the server for the transaction service.
+type txServer struct {
+	clientCtx      client.Context
+	simulate       baseAppSimulateFn
+	txDecoder      sdk.TxDecoder
+	protoTxDecoder ProtoTxDecoder
+}
+
+var _ txtypes.ServiceServer = txServer{}
+
+// NewTxServer creates a new transaction service server. Transactions are
+// simulated with simulate after being decoded with txDecoder, or with
+// protoTxDecoder when given as a protobuf Tx, while the other methods query
+// and broadcast through the Tendermint RPC client of clientCtx. A nil
+// protoTxDecoder rejects the simulation of protobuf transactions.
+func NewTxServer(
+	clientCtx client.Context, simulate baseAppSimulateFn, txDecoder sdk.TxDecoder, protoTxDecoder ProtoTxDecoder,
+) txtypes.ServiceServer {
+	return txServer{
+		clientCtx:      clientCtx,
+		simulate:       simulate,
+		txDecoder:      txDecoder,
+		protoTxDecoder: protoTxDecoder,
+	}
+}
+
+// RegisterTxService registers the transaction service on the given gRPC router.
+func RegisterTxService(
+	qrt gogogrpc.Server, clientCtx client.Context, simulate baseAppSimulateFn,
+	txDecoder sdk.TxDecoder, protoTxDecoder ProtoTxDecoder,
+) {
+	txtypes.RegisterServiceServer(qrt, NewTxServer(clientCtx, simulate, txDecoder, protoTxDecoder))
+}
+
+// RegisterGRPCGatewayRoutes mounts the transaction service's gRPC gateway routes
+// on the given router.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, rtr *runtime.ServeMux) {
+	err := txtypes.RegisterServiceHandlerClient(context.Background(), rtr, txtypes.NewServiceClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Simulate implements the Service/Simulate gRPC method
+func (s txServer) Simulate(_ context.Context, req *txtypes.SimulateRequest) (*txtypes.SimulateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		txBytes = req.TxBytes
+		tx      sdk.Tx
+		err     error
+	)
+
+	switch {
+	case req.Tx != nil && len(txBytes) != 0:
+		return nil, status.Errorf(codes.InvalidArgument, "only one of tx and tx_bytes can be set")
+
+	case req.Tx != nil:
+		if s.protoTxDecoder == nil {
+			return nil, status.Errorf(codes.Unimplemented, "the app does not simulate protobuf transactions, use tx_bytes")
+		}
+
+		// the protobuf encoding only determines the tx size gas
+		txBytes, err = req.Tx.Marshal()
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to encode tx: %s", err)
+		}
+
+		tx, err = s.protoTxDecoder(req.Tx)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decode tx: %s", err)
+		}
+
+	case len(txBytes) == 0:
+		return nil, status.Errorf(codes.InvalidArgument, "empty tx")
+
+	default:
+		tx, err = s.txDecoder(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to decode tx: %s", err)
+		}
+	}
+
+	gasInfo, result, err := s.simulate(txBytes, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.SimulateResponse{
+		GasInfo: &gasInfo,
+		Result:  result,
+	}, nil
+}
+
+// GetTx implements the Service/GetTx gRPC method
+func (s txServer) GetTx(_ context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := hex.DecodeString(req.Hash)
+	if err != nil || len(hash) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx hash %q", req.Hash)
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(hash, !s.clientCtx.TrustNode)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tx %s: %s", req.Hash, err)
+	}
+
+	txResponses, err := s.newTxResponses([]*ctypes.ResultTx{resTx})
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxResponse{TxResponse: txResponses[0]}, nil
+}
+
+// GetTxsEvent implements the Service/GetTxsEvent gRPC method
+func (s txServer) GetTxsEvent(_ context.Context, req *txtypes.GetTxsEventRequest) (*txtypes.GetTxsEventResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Events) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "must declare at least one event to search")
+	}
+
+	tmEvents := make([]string, len(req.Events))
+	for i, event := range req.Events {
+		if strings.Count(event, "=") != 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid event %q; event must be of the form {eventType}.{eventAttribute}={value}", event)
+		}
+
+		tokens := strings.Split(event, "=")
+		if tokens[0] == tmtypes.TxHeightKey {
+			tmEvents[i] = fmt.Sprintf("%s=%s", tokens[0], tokens[1])
+		} else {
+			tmEvents[i] = fmt.Sprintf("%s='%s'", tokens[0], tokens[1])
+		}
+	}
+
+	page, limit := int(req.Page), int(req.Limit)
+	if page == 0 {
+		page = rest.DefaultPage
+	}
+
+	if limit == 0 {
+		limit = rest.DefaultLimit
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTxs, err := node.TxSearch(strings.Join(tmEvents, " AND "), !s.clientCtx.TrustNode, page, limit, "")
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to search txs: %s", err)
+	}
+
+	txResponses, err := s.newTxResponses(resTxs.Txs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txtypes.GetTxsEventResponse{
+		TxResponses: txResponses,
+		TotalCount:  uint64(resTxs.TotalCount),
+	}, nil
+}
+
+// BroadcastTx implements the Service/BroadcastTx gRPC method
+func (s txServer) BroadcastTx(_ context.Context, req *txtypes.BroadcastTxRequest) (*txtypes.BroadcastTxResponse, error) {
+	return client.TxServiceBroadcast(s.clientCtx, req)
+}
+
+// newTxResponses verifies the given transaction results, unless the node is
+// trusted, and converts them to TxResponses holding the time of their block.
+func (s txServer) newTxResponses(resTxs []*ctypes.ResultTx) ([]*txtypes.TxResponse, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	timestamps := make(map[int64]string)
+	txResponses := make([]*txtypes.TxResponse, len(resTxs))
+
+	for i, resTx := range resTxs {
+		if err := authclient.ValidateTxResult(s.clientCtx, resTx); err != nil {
+			return nil, err
+		}
+
+		if _, ok := timestamps[resTx.Height]; !ok {
+			resBlock, err := node.Block(&resTx.Height)
+			if err != nil {
+				return nil, err
+			}
+
+			timestamps[resTx.Height] = resBlock.Block.Time.Format(time.RFC3339)
+		}
+
+		txResponses[i] = &txtypes.TxResponse{
+			Height:    resTx.Height,
+			Txhash:    resTx.Hash.String(),
+			Codespace: resTx.TxResult.Codespace,
+			Code:      resTx.TxResult.Code,
+			Data:      resTx.TxResult.Data,
+			RawLog:    resTx.TxResult.Log,
+			Info:      resTx.TxResult.Info,
+			GasWanted: resTx.TxResult.GasWanted,
+			GasUsed:   resTx.TxResult.GasUsed,
+			Events:    resTx.TxResult.Events,
+			Tx:        resTx.Tx,
+			Timestamp: timestamps[resTx.Height],
+		}
+	}
+
+	return txResponses, nil
+}
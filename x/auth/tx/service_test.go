@@ -0,0 +1,155 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type ServiceTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	txServer  txtypes.ServiceServer
+	txClient  txtypes.ServiceClient
+	priv      secp256k1.PrivKeySecp256k1
+	addr      sdk.AccAddress
+	accNumber uint64
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.priv = secp256k1.GenPrivKey()
+	s.addr = sdk.AccAddress(s.priv.PubKey().Address())
+
+	acc := authtypes.NewBaseAccount(s.addr, nil, 0, 0)
+	balance := banktypes.Balance{Address: s.addr, Coins: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))}
+	s.app = simapp.SetupWithGenesisAccounts([]authtypes.GenesisAccount{acc}, balance)
+
+	ctx := s.app.BaseApp.NewContext(true, abci.Header{})
+	s.accNumber = s.app.AccountKeeper.GetAccount(ctx, s.addr).GetAccountNumber()
+
+	txDecoder := authtypes.DefaultTxDecoder(s.app.Codec())
+	protoTxDecoder := authtx.SimulateStdTxDecoder(s.app.AppCodec(), std.DefaultPublicKeyCodec{})
+	s.txServer = authtx.NewTxServer(client.Context{}, s.app.Simulate, txDecoder, protoTxDecoder)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	authtx.RegisterTxService(queryHelper, client.Context{}, s.app.Simulate, txDecoder, protoTxDecoder)
+	s.txClient = txtypes.NewServiceClient(queryHelper)
+}
+
+func (s *ServiceTestSuite) sendTxBytes(amount int64) []byte {
+	msg := banktypes.NewMsgSend(s.addr, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	tx := helpers.GenTx([]sdk.Msg{msg}, sdk.NewCoins(), helpers.DefaultGenTxGas, "", []uint64{s.accNumber}, []uint64{0}, s.priv)
+
+	bz, err := s.app.Codec().MarshalBinaryBare(tx)
+	s.Require().NoError(err)
+
+	return bz
+}
+
+func (s *ServiceTestSuite) sendProtoTx(amount int64) *txtypes.Tx {
+	msg := banktypes.NewMsgSend(s.addr, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)))
+	any, err := codectypes.NewAnyWithValue(msg)
+	s.Require().NoError(err)
+
+	return &txtypes.Tx{
+		Body:       &txtypes.TxBody{Messages: []*codectypes.Any{any}},
+		AuthInfo:   &txtypes.AuthInfo{Fee: &txtypes.Fee{GasLimit: helpers.DefaultGenTxGas}},
+		Signatures: [][]byte{make([]byte, 64)},
+	}
+}
+
+func (s *ServiceTestSuite) TestSimulate() {
+	res, err := s.txServer.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: s.sendTxBytes(100)})
+	s.Require().NoError(err)
+	s.Require().True(res.GasInfo.GasUsed > 0)
+
+	eventTypes := make(map[string]bool)
+	for _, event := range res.Result.Events {
+		eventTypes[event.Type] = true
+	}
+	s.Require().True(eventTypes[banktypes.EventTypeTransfer])
+
+	// the same simulation is served through a gRPC router
+	routerRes, err := s.txClient.Simulate(context.Background(), &txtypes.SimulateRequest{TxBytes: s.sendTxBytes(100)})
+	s.Require().NoError(err)
+	s.Require().True(routerRes.GasInfo.GasUsed > 0)
+
+	// simulating does not change the state
+	ctx := s.app.BaseApp.NewContext(true, abci.Header{})
+	s.Require().Equal(int64(1000), s.app.BankKeeper.GetBalance(ctx, s.addr, sdk.DefaultBondDenom).Amount.Int64())
+}
+
+func (s *ServiceTestSuite) TestSimulateProtoTx() {
+	res, err := s.txServer.Simulate(context.Background(), &txtypes.SimulateRequest{Tx: s.sendProtoTx(100)})
+	s.Require().NoError(err)
+	s.Require().True(res.GasInfo.GasUsed > 0)
+
+	eventTypes := make(map[string]bool)
+	for _, event := range res.Result.Events {
+		eventTypes[event.Type] = true
+	}
+	s.Require().True(eventTypes[banktypes.EventTypeTransfer])
+
+	// apps without a protobuf tx decoder reject protobuf transactions
+	txServer := authtx.NewTxServer(client.Context{}, s.app.Simulate, authtypes.DefaultTxDecoder(s.app.Codec()), nil)
+	_, err = txServer.Simulate(context.Background(), &txtypes.SimulateRequest{Tx: s.sendProtoTx(100)})
+	s.Require().Equal(codes.Unimplemented, status.Code(err))
+}
+
+func (s *ServiceTestSuite) TestSimulateInvalidRequests() {
+	testCases := []struct {
+		name string
+		req  *txtypes.SimulateRequest
+	}{
+		{"nil request", nil},
+		{"empty request", &txtypes.SimulateRequest{}},
+		{"undecodable tx", &txtypes.SimulateRequest{TxBytes: []byte("invalid")}},
+		{"tx and tx bytes", &txtypes.SimulateRequest{Tx: &txtypes.Tx{}, TxBytes: s.sendTxBytes(100)}},
+		{"insufficient funds", &txtypes.SimulateRequest{TxBytes: s.sendTxBytes(2000)}},
+		{"protobuf tx without body", &txtypes.SimulateRequest{Tx: &txtypes.Tx{}}},
+		{"protobuf tx with insufficient funds", &txtypes.SimulateRequest{Tx: s.sendProtoTx(2000)}},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.txServer.Simulate(context.Background(), tc.req)
+			s.Require().Error(err)
+		})
+	}
+}
+
+func (s *ServiceTestSuite) TestInvalidQueries() {
+	_, err := s.txServer.GetTx(context.Background(), &txtypes.GetTxRequest{Hash: "not hex"})
+	s.Require().Error(err)
+
+	_, err = s.txServer.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{})
+	s.Require().Error(err)
+
+	_, err = s.txServer.GetTxsEvent(context.Background(), &txtypes.GetTxsEventRequest{Events: []string{"message.action"}})
+	s.Require().Error(err)
+
+	_, err = s.txServer.BroadcastTx(context.Background(), &txtypes.BroadcastTxRequest{TxBytes: s.sendTxBytes(100)})
+	s.Require().Error(err)
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
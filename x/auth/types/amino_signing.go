@@ -40,6 +40,6 @@ func (LegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode, data sign
 	}
 
 	return StdSignBytes(
-		data.ChainID, data.AccountNumber, data.AccountSequence, StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas()}, tx.GetMsgs(), memoTx.GetMemo(),
+		data.ChainID, data.AccountNumber, data.AccountSequence, StdFee{Amount: feeTx.GetFee(), Gas: feeTx.GetGas(), Granter: feeTx.FeeGranter()}, tx.GetMsgs(), memoTx.GetMemo(),
 	), nil
 }
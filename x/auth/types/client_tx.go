@@ -66,6 +66,11 @@ func (s *StdTxBuilder) SetGasLimit(limit uint64) {
 	s.StdTx.Fee.Gas = limit
 }
 
+// SetFeeGranter implements TxBuilder.SetFeeGranter
+func (s *StdTxBuilder) SetFeeGranter(feeGranter sdk.AccAddress) {
+	s.StdTx.Fee.Granter = feeGranter
+}
+
 // SetMemo implements TxBuilder.SetMemo
 func (s *StdTxBuilder) SetMemo(memo string) {
 	s.Memo = memo
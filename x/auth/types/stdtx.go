@@ -23,9 +23,13 @@ const MaxGasWanted = uint64((1 << 63) - 1)
 // Deprecated: StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+//
+// If Granter is set, the fee is paid from an allowance granted to the fee
+// payer by the Granter account (see x/feegrant) rather than by the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount" yaml:"amount"`
-	Gas    uint64    `json:"gas" yaml:"gas"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	Gas     uint64         `json:"gas" yaml:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty" yaml:"granter,omitempty"`
 }
 
 // Deprecated: NewStdFee returns a new instance of StdFee
@@ -279,6 +283,12 @@ func (tx StdTx) FeePayer() sdk.AccAddress {
 	return sdk.AccAddress{}
 }
 
+// FeeGranter returns the address of the account that granted the fee payer
+// an allowance to pay the fee of this tx, or nil if the fee payer pays it.
+func (tx StdTx) FeeGranter() sdk.AccAddress {
+	return tx.Fee.Granter
+}
+
 // StdSignDoc is replay-prevention structure.
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	authzQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the authz module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzQueryCmd.AddCommand(
+		GetCmdQueryAuthorization(clientCtx),
+		GetCmdQueryAuthorizations(clientCtx),
+	)
+
+	return authzQueryCmd
+}
+
+// GetCmdQueryAuthorization returns the command to query the authorization
+// for a single message type.
+func GetCmdQueryAuthorization(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg_type]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the authorization for a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization granted by a granter to a grantee for a message type.
+
+Example:
+$ %s query %s authorization cosmos1skjw... cosmos1skjw... /cosmos.bank.MsgSend
+`, version.ClientName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authorization(
+				context.Background(),
+				&types.QueryAuthorizationRequest{
+					Granter: granterAddr,
+					Grantee: granteeAddr,
+					MsgType: args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Authorization)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdQueryAuthorizations returns the command to query all the
+// authorizations granted by a granter to a grantee.
+func GetCmdQueryAuthorizations(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorizations [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query all the authorizations of a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the authorizations granted by a granter to a grantee.
+
+Example:
+$ %s query %s authorizations cosmos1skjw... cosmos1skjw...
+`, version.ClientName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Authorizations(
+				context.Background(),
+				&types.QueryAuthorizationsRequest{
+					Granter: granterAddr,
+					Grantee: granteeAddr,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// flags for the authz module
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagMsgType    = "msg-type"
+	FlagExpiration = "expiration"
+)
+
+// authorization types accepted by the grant command
+const (
+	authorizationTypeSend    = "send"
+	authorizationTypeGeneric = "generic"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/authz transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	authzTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	authzTxCmd.AddCommand(
+		NewCmdGrantAuthorization(clientCtx),
+		NewCmdRevokeAuthorization(clientCtx),
+		NewCmdExecAuthorized(clientCtx),
+	)
+
+	return authzTxCmd
+}
+
+// NewCmdGrantAuthorization returns a CLI command handler for creating a
+// MsgGrantAuthorization transaction.
+func NewCmdGrantAuthorization(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter_key_or_address] [grantee] [authorization_type]",
+		Short: "Grant authorization to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to an address to execute a transaction on your behalf.
+The authorization type is either "%s", limited by --%s, or "%s", for the message
+type given by --%s. Note, the '--from' flag is ignored as it is implied from [granter].
+
+Examples:
+$ %s tx %s grant cosmos1skjw... cosmos1skjw... %s --%s 1000stake --%s 2022-01-30T15:04:05Z
+$ %s tx %s grant cosmos1skjw... cosmos1skjw... %s --%s /cosmos.gov.MsgVote
+`, authorizationTypeSend, FlagSpendLimit, authorizationTypeGeneric, FlagMsgType,
+				version.ClientName, types.ModuleName, authorizationTypeSend, FlagSpendLimit, FlagExpiration,
+				version.ClientName, types.ModuleName, authorizationTypeGeneric, FlagMsgType,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			var authorization types.Authorization
+			switch args[2] {
+			case authorizationTypeSend:
+				limit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+				if err != nil {
+					return err
+				}
+
+				authorization = types.NewSendAuthorization(limit)
+
+			case authorizationTypeGeneric:
+				authorization = types.NewGenericAuthorization(viper.GetString(FlagMsgType))
+
+			default:
+				return fmt.Errorf("invalid authorization type %q, expected %q or %q", args[2], authorizationTypeSend, authorizationTypeGeneric)
+			}
+
+			// grants without an explicit expiration are valid for one year
+			expiration := time.Now().AddDate(1, 0, 0)
+			if exp := viper.GetString(FlagExpiration); exp != "" {
+				expiration, err = time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg, err := types.NewMsgGrantAuthorization(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "The maximum amount of coins the grantee may send, for send authorizations")
+	cmd.Flags().String(FlagMsgType, "", "The type URL of the authorized message, for generic authorizations")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the authorization expires (default one year from now)")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewCmdRevokeAuthorization returns a CLI command handler for creating a
+// MsgRevokeAuthorization transaction.
+func NewCmdRevokeAuthorization(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter_key_or_address] [grantee] [msg_type]",
+		Short: "Revoke authorization",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the authorization of a grantee to execute the given message type on
+your behalf. Note, the '--from' flag is ignored as it is implied from [granter].
+
+Example:
+$ %s tx %s revoke cosmos1skjw... cosmos1skjw... /cosmos.bank.MsgSend
+`, version.ClientName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAuthorization(clientCtx.GetFromAddress(), grantee, args[2])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewCmdExecAuthorized returns a CLI command handler for creating a
+// MsgExecAuthorized transaction.
+func NewCmdExecAuthorized(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [grantee_key_or_address] [msg_tx_json_file]",
+		Short: "Execute transaction on behalf of granter account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the messages of a transaction on behalf of their signers, using the
+authorizations granted to the grantee. The transaction is typically created with
+the '--generate-only' flag. Note, the '--from' flag is ignored as it is implied
+from [grantee].
+
+Example:
+$ %s tx %s exec cosmos1skjw... tx.json
+`, version.ClientName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			stdTx, err := authclient.ReadStdTxFromFile(clientCtx.Codec, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgExecAuthorized(clientCtx.GetFromAddress(), stdTx.GetMsgs())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
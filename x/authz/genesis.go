@@ -0,0 +1,50 @@
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, entry := range data.Authorization {
+		authorization, ok := entry.Authorization.GetCachedValue().(types.Authorization)
+		if !ok {
+			panic(fmt.Sprintf("invalid %s authorization from %s to %s", types.ModuleName, entry.Granter, entry.Grantee))
+		}
+
+		if err := k.Grant(ctx, entry.Granter, entry.Grantee, authorization, entry.Expiration); err != nil {
+			panic(fmt.Sprintf("failed to import %s grant from %s to %s: %s", types.ModuleName, entry.Granter, entry.Grantee, err))
+		}
+	}
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable
+// GenesisState. Expired authorizations can never be used again, so they are
+// dropped from the export.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	var entries []types.GrantAuthorization
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		if grant.IsExpired(ctx.BlockTime()) {
+			return false
+		}
+
+		entries = append(entries, types.GrantAuthorization{
+			Granter:       granter,
+			Grantee:       grantee,
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(entries)
+}
@@ -0,0 +1,64 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 1, Time: time.Now().UTC()})
+	suite.app = app
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	addrs := simapp.AddTestAddrsIncremental(suite.app, suite.ctx, 3, sdk.NewInt(30000000))
+	k := suite.app.AuthzKeeper
+
+	now := suite.ctx.BlockTime()
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+
+	// an active authorization, and one that has already expired
+	suite.Require().NoError(k.Grant(suite.ctx, addrs[0], addrs[1], authorization, now.Add(time.Hour)))
+	suite.Require().NoError(k.Grant(suite.ctx, addrs[0], addrs[2], authorization, now.Add(-time.Hour)))
+
+	genesis := authz.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(authz.ValidateGenesis(genesis))
+	suite.Require().Len(genesis.Authorization, 1)
+	suite.Require().Equal(addrs[0], genesis.Authorization[0].Granter)
+	suite.Require().Equal(addrs[1], genesis.Authorization[0].Grantee)
+
+	// import into a new chain
+	suite.SetupTest()
+	authz.InitGenesis(suite.ctx, suite.app.AuthzKeeper, genesis)
+
+	got, expiration := suite.app.AuthzKeeper.GetCleanAuthorization(
+		suite.ctx, addrs[0], addrs[1], types.MsgTypeURL(&banktypes.MsgSend{}),
+	)
+	suite.Require().Equal(authorization, got)
+	suite.Require().True(now.Add(time.Hour).Equal(expiration))
+
+	suite.Require().Equal(genesis, authz.ExportGenesis(suite.ctx, suite.app.AuthzKeeper))
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
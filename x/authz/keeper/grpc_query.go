@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Authorization implements the Query/Authorization gRPC method
+func (k Keeper) Authorization(c context.Context, req *types.QueryAuthorizationRequest) (*types.QueryAuthorizationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Granter) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if len(req.Grantee) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	if req.MsgType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty message type")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	grant, found := k.getAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(req.Granter, req.Grantee, req.MsgType))
+	if !found || grant.IsExpired(ctx.BlockTime()) {
+		return nil, status.Errorf(codes.NotFound, "no authorization for %s from %s to %s", req.MsgType, req.Granter, req.Grantee)
+	}
+
+	return &types.QueryAuthorizationResponse{Authorization: &grant}, nil
+}
+
+// Authorizations implements the Query/Authorizations gRPC method
+func (k Keeper) Authorizations(c context.Context, req *types.QueryAuthorizationsRequest) (*types.QueryAuthorizationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Granter) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if len(req.Grantee) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryAuthorizationsResponse{Authorizations: k.getActiveGrants(ctx, req.Granter, req.Grantee)}, nil
+}
+
+// getActiveGrants returns the unexpired authorizations granted by the granter
+// to the grantee.
+func (k Keeper) getActiveGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) []*types.AuthorizationGrant {
+	var grants []*types.AuthorizationGrant
+	k.IterateGranterGrants(ctx, granter, grantee, func(grant types.AuthorizationGrant) bool {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			grants = append(grants, &grant)
+		}
+		return false
+	})
+
+	return grants
+}
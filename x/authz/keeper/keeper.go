@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// Keeper manages the authorizations granted between accounts and dispatches
+// the messages executed on behalf of a granter.
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.Marshaler
+	router   sdk.Router
+}
+
+// NewKeeper constructs a message authorization Keeper. The router is used to
+// dispatch the messages executed through MsgExecAuthorized.
+func NewKeeper(storeKey sdk.StoreKey, cdc codec.Marshaler, router sdk.Router) Keeper {
+	return Keeper{
+		storeKey: storeKey,
+		cdc:      cdc,
+		router:   router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// getAuthorizationGrant returns the grant stored under the given key, and a
+// boolean indicating whether it was found.
+func (k Keeper) getAuthorizationGrant(ctx sdk.Context, key []byte) (types.AuthorizationGrant, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return types.AuthorizationGrant{}, false
+	}
+
+	var grant types.AuthorizationGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+func (k Keeper) setAuthorizationGrant(ctx sdk.Context, key []byte, grant types.AuthorizationGrant) {
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&grant))
+}
+
+// Grant stores the authorization granted by the granter to the grantee until
+// the expiration time, overwriting any existing authorization for the same
+// message type.
+func (k Keeper) Grant(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, authorization types.Authorization, expiration time.Time,
+) error {
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	if err != nil {
+		return err
+	}
+
+	msgType := authorization.MsgType()
+	k.setAuthorizationGrant(ctx, types.GetAuthorizationStoreKey(granter, grantee, msgType), grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGrantType, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// Revoke removes the authorization for the message type granted by the
+// granter to the grantee. It returns an error if there is no such
+// authorization.
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAuthorizationStoreKey(granter, grantee, msgType)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAuthorizationFound, "%s from %s to %s", msgType, granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGrantType, msgType),
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetCleanAuthorization returns the authorization for the message type
+// granted by the granter to the grantee, along with its expiration time. It
+// returns nil if there is no authorization, and removes it from state if it
+// has expired.
+func (k Keeper) GetCleanAuthorization(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+) (types.Authorization, time.Time) {
+	key := types.GetAuthorizationStoreKey(granter, grantee, msgType)
+
+	grant, found := k.getAuthorizationGrant(ctx, key)
+	if !found {
+		return nil, time.Time{}
+	}
+
+	if grant.IsExpired(ctx.BlockTime()) {
+		ctx.KVStore(k.storeKey).Delete(key)
+		return nil, time.Time{}
+	}
+
+	return grant.GetAuthorizationGrant(), grant.Expiration
+}
+
+// IterateGranterGrants iterates over all the authorizations granted by the
+// granter to the grantee. Callback to get all data, returns true to stop,
+// false to keep reading.
+func (k Keeper) IterateGranterGrants(
+	ctx sdk.Context, granter, grantee sdk.AccAddress, cb func(types.AuthorizationGrant) bool,
+) {
+	k.iterateGrants(ctx, types.GetAuthorizationsPrefix(granter, grantee), func(_, _ sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		return cb(grant)
+	})
+}
+
+// IterateGrants iterates over all the authorizations in the store. Callback
+// to get all data, returns true to stop, false to keep reading. Calling this
+// without pagination is very expensive and only designed for export genesis.
+func (k Keeper) IterateGrants(ctx sdk.Context, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool) {
+	k.iterateGrants(ctx, types.GrantKeyPrefix, cb)
+}
+
+func (k Keeper) iterateGrants(
+	ctx sdk.Context, prefix []byte, cb func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool,
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.AuthorizationGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		granter, grantee := types.ExtractAddressesFromGrantKey(iter.Key())
+		if cb(granter, grantee, grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes the provided messages on behalf of their signers.
+// Each message must have a single signer, which must either be the grantee or
+// have granted the grantee an authorization for the message type. Accepted
+// authorizations are updated, or removed once they are used up.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) (*sdk.Result, error) {
+	txData := &sdk.TxData{
+		Data: make([]*sdk.MsgData, 0, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization can only be given to messages with a single signer; message index: %d", i)
+		}
+
+		// the grantee does not need an authorization to act for itself
+		granter := signers[0]
+		if !granter.Equals(grantee) {
+			if err := k.acceptAuthorization(ctx, granter, grantee, msg); err != nil {
+				return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+			}
+		}
+
+		msgRoute := msg.Route()
+		handler := k.router.Route(ctx, msgRoute)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventExecuteAuthorization,
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyGranterAddress, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGranteeAddress, grantee.String()),
+			),
+		)
+		ctx.EventManager().EmitEvents(msgResult.GetEvents())
+
+		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+	}
+
+	data, err := proto.Marshal(txData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal dispatched messages data")
+	}
+
+	return &sdk.Result{Data: data}, nil
+}
+
+// acceptAuthorization checks that the granter authorized the grantee to
+// execute the message, and records the updated authorization.
+func (k Keeper) acceptAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) error {
+	msgType := types.MsgTypeURL(msg)
+
+	authorization, expiration := k.GetCleanAuthorization(ctx, granter, grantee, msgType)
+	if authorization == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s from %s to %s: %s", msgType, granter, grantee, types.ErrNoAuthorizationFound)
+	}
+
+	updated, del, err := authorization.Accept(msg, ctx.BlockHeader())
+	if err != nil {
+		return err
+	}
+
+	key := types.GetAuthorizationStoreKey(granter, grantee, msgType)
+
+	switch {
+	case del:
+		ctx.KVStore(k.storeKey).Delete(key)
+
+	case updated != nil:
+		grant, err := types.NewAuthorizationGrant(updated, expiration)
+		if err != nil {
+			return err
+		}
+
+		k.setAuthorizationGrant(ctx, key, grant)
+	}
+
+	return nil
+}
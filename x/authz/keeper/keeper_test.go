@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app   *simapp.SimApp
+	ctx   sdk.Context
+	addrs []sdk.AccAddress
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Now().UTC()})
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+}
+
+func (suite *KeeperTestSuite) TestKeeperCrud() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	sendType := types.MsgTypeURL(&banktypes.MsgSend{})
+
+	authorization, _ := k.GetCleanAuthorization(ctx, granter, grantee, sendType)
+	suite.Require().Nil(authorization)
+
+	// grant and overwrite
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	expiration := ctx.BlockTime().Add(time.Hour)
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(limit), expiration))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(limit.Add(limit...)), expiration))
+
+	authorization, exp := k.GetCleanAuthorization(ctx, granter, grantee, sendType)
+	suite.Require().Equal(types.NewSendAuthorization(limit.Add(limit...)), authorization)
+	suite.Require().True(expiration.Equal(exp))
+
+	// the authorization is directional
+	authorization, _ = k.GetCleanAuthorization(ctx, grantee, granter, sendType)
+	suite.Require().Nil(authorization)
+
+	// add a generic authorization and list them all
+	voteType := "/cosmos.gov.MsgVote"
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewGenericAuthorization(voteType), expiration))
+
+	var msgTypes []string
+	k.IterateGranterGrants(ctx, granter, grantee, func(grant types.AuthorizationGrant) bool {
+		msgTypes = append(msgTypes, grant.GetAuthorizationGrant().MsgType())
+		return false
+	})
+	suite.Require().ElementsMatch([]string{sendType, voteType}, msgTypes)
+
+	// revoke
+	suite.Require().NoError(k.Revoke(ctx, granter, grantee, sendType))
+	suite.Require().Error(k.Revoke(ctx, granter, grantee, sendType))
+	authorization, _ = k.GetCleanAuthorization(ctx, granter, grantee, sendType)
+	suite.Require().Nil(authorization)
+
+	// expired authorizations are removed on access
+	future := ctx.WithBlockTime(expiration)
+	authorization, _ = k.GetCleanAuthorization(future, granter, grantee, voteType)
+	suite.Require().Nil(authorization)
+	suite.Require().Error(k.Revoke(ctx, granter, grantee, voteType))
+}
+
+func (suite *KeeperTestSuite) TestDispatchActions() {
+	ctx := suite.ctx
+	k := suite.app.AuthzKeeper
+	granter, grantee, recipient := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	expiration := ctx.BlockTime().Add(time.Hour)
+
+	send := func(amount int64) []sdk.Msg {
+		return []sdk.Msg{banktypes.NewMsgSend(granter, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))}
+	}
+
+	// no authorization
+	_, err := k.DispatchActions(ctx, grantee, send(10))
+	suite.Require().Error(err)
+
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewSendAuthorization(limit), expiration))
+
+	// over the spend limit
+	_, err = k.DispatchActions(ctx, grantee, send(101))
+	suite.Require().Error(err)
+
+	// within the spend limit, which is updated
+	_, err = k.DispatchActions(ctx, grantee, send(40))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10040), suite.app.BankKeeper.GetBalance(ctx, recipient, "stake").Amount)
+
+	sendType := types.MsgTypeURL(&banktypes.MsgSend{})
+	authorization, _ := k.GetCleanAuthorization(ctx, granter, grantee, sendType)
+	suite.Require().Equal(types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("stake", 60))), authorization)
+
+	// the authorization is removed once the spend limit is used up
+	_, err = k.DispatchActions(ctx, grantee, send(60))
+	suite.Require().NoError(err)
+	authorization, _ = k.GetCleanAuthorization(ctx, granter, grantee, sendType)
+	suite.Require().Nil(authorization)
+
+	// the grantee can always act for itself
+	own := banktypes.NewMsgSend(grantee, recipient, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	_, err = k.DispatchActions(ctx, grantee, []sdk.Msg{own})
+	suite.Require().NoError(err)
+
+	// expired authorizations can't be used
+	suite.Require().NoError(k.Grant(ctx, granter, grantee, types.NewGenericAuthorization(sendType), expiration))
+	_, err = k.DispatchActions(ctx.WithBlockTime(expiration.Add(time.Second)), grantee, send(10))
+	suite.Require().Error(err)
+}
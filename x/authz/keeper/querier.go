@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryAuthorizations:
+			res, err = queryAuthorizations(ctx, path[1:], k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryAuthorizations(ctx sdk.Context, args []string, k Keeper) ([]byte, error) {
+	if len(args) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing granter or grantee address")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", args[0])
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(args[1])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", args[1])
+	}
+
+	res := types.QueryAuthorizationsResponse{Authorizations: k.getActiveGrants(ctx, granter, grantee)}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = AuthorizationGrant{}
+
+// NewAuthorizationGrant returns a new AuthorizationGrant holding the provided
+// authorization until the expiration time.
+func NewAuthorizationGrant(authorization Authorization, expiration time.Time) (AuthorizationGrant, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return AuthorizationGrant{}, err
+	}
+
+	return AuthorizationGrant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorizationGrant unpacks the authorization of the grant, returning nil
+// if it is missing or not an Authorization.
+func (g AuthorizationGrant) GetAuthorizationGrant() Authorization {
+	if g.Authorization == nil {
+		return nil
+	}
+
+	authorization, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
+
+// IsExpired returns true if the grant expired at or before the provided time.
+func (g AuthorizationGrant) IsExpired(blockTime time.Time) bool {
+	return !g.Expiration.After(blockTime)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g AuthorizationGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if g.Authorization == nil {
+		return nil
+	}
+
+	var authorization Authorization
+	return unpacker.UnpackAny(g.Authorization, &authorization)
+}
+
+// ValidateBasic performs basic validation on the grant.
+func (g AuthorizationGrant) ValidateBasic() error {
+	if g.Authorization == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "missing authorization")
+	}
+
+	authorization := g.GetAuthorizationGrant()
+	if authorization == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not an Authorization", g.Authorization.TypeUrl)
+	}
+
+	return authorization.ValidateBasic()
+}
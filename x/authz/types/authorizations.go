@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Authorization represents the interface of various authorization types
+// that a granter can give to a grantee to execute messages on its behalf.
+type Authorization interface {
+	proto.Message
+
+	// MsgType returns the type URL of the message this authorization applies
+	// to, eg. "/cosmos.bank.MsgSend".
+	MsgType() string
+
+	// Accept determines whether this grant permits the provided sdk.Msg to be
+	// performed, and if so provides an updated authorization instance, or nil
+	// if it is unchanged. If delete is true, the authorization is removed from
+	// state.
+	Accept(msg sdk.Msg, block abci.Header) (updated Authorization, delete bool, err error)
+
+	// ValidateBasic does a simple validation check that doesn't require
+	// access to any other information.
+	ValidateBasic() error
+}
+
+// MsgTypeURL returns the type URL of a message, under which authorizations for
+// it are stored.
+func MsgTypeURL(msg sdk.Msg) string {
+	return "/" + proto.MessageName(msg)
+}
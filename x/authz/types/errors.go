@@ -0,0 +1,17 @@
+// DONTCOVER
+
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/authz module sentinel errors
+var (
+	// ErrInvalidExpirationTime error if the set expiration time is in the past
+	ErrInvalidExpirationTime = sdkerrors.Register(ModuleName, 2, "expiration time of authorization should be more than current time")
+	// ErrNoAuthorizationFound error if there is no authorization found given a grant key
+	ErrNoAuthorizationFound = sdkerrors.Register(ModuleName, 3, "authorization not found")
+	// ErrInvalidMsgType error if the message type of a grant is empty or unknown
+	ErrInvalidMsgType = sdkerrors.Register(ModuleName, 4, "invalid message type")
+)
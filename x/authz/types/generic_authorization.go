@@ -0,0 +1,37 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ Authorization = &GenericAuthorization{}
+
+// NewGenericAuthorization creates a new GenericAuthorization object.
+func NewGenericAuthorization(msgType string) *GenericAuthorization {
+	return &GenericAuthorization{
+		MessageType: msgType,
+	}
+}
+
+// MsgType implements Authorization.MsgType.
+func (authorization GenericAuthorization) MsgType() string {
+	return authorization.MessageType
+}
+
+// Accept implements Authorization.Accept. A generic authorization accepts
+// any message of its type and is never used up.
+func (authorization GenericAuthorization) Accept(msg sdk.Msg, block abci.Header) (Authorization, bool, error) {
+	return nil, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization GenericAuthorization) ValidateBasic() error {
+	if authorization.MessageType == "" {
+		return sdkerrors.Wrap(ErrInvalidMsgType, "message type cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = GenesisState{}
+	_ types.UnpackInterfacesMessage = GrantAuthorization{}
+)
+
+// NewGenesisState creates new GenesisState object
+func NewGenesisState(entries []GrantAuthorization) GenesisState {
+	return GenesisState{
+		Authorization: entries,
+	}
+}
+
+// DefaultGenesisState returns a default, empty GenesisState.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data GenesisState) error {
+	for _, a := range data.Authorization {
+		if err := a.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, a := range data.Authorization {
+		if err := a.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewGrantAuthorization creates a new genesis GrantAuthorization entry.
+func NewGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) (GrantAuthorization, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return GrantAuthorization{}, err
+	}
+
+	return GrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on the genesis entry.
+func (a GrantAuthorization) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+
+	authorization, ok := a.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing authorization")
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a GrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
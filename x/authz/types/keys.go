@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "authz"
+
+	// StoreKey is the store key string for authz
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for authz
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for authz
+	QuerierRoute = ModuleName
+
+	// QueryAuthorizations is the legacy querier route for the authorizations
+	// granted to a grantee by a granter
+	QueryAuthorizations = "authorizations"
+)
+
+// KVStore key prefixes
+var (
+	// GrantKeyPrefix is the prefix of the authorization grants
+	GrantKeyPrefix = []byte{0x01}
+)
+
+// GetAuthorizationStoreKey returns the store key of the authorization granted
+// to the grantee by the granter for the given message type.
+func GetAuthorizationStoreKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetAuthorizationsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// GetAuthorizationsPrefix returns the prefix of all the authorizations granted
+// to the grantee by the granter.
+//
+// NOTE: addresses are fixed length, so an address can't be a prefix of another.
+func GetAuthorizationsPrefix(granter, grantee sdk.AccAddress) []byte {
+	key := append(append([]byte{}, GrantKeyPrefix...), granter.Bytes()...)
+	return append(key, grantee.Bytes()...)
+}
+
+// ExtractAddressesFromGrantKey returns the granter and grantee addresses of a
+// grant store key.
+func ExtractAddressesFromGrantKey(key []byte) (granter, grantee sdk.AccAddress) {
+	key = key[len(GrantKeyPrefix):]
+	return sdk.AccAddress(key[:sdk.AddrLen]), sdk.AccAddress(key[sdk.AddrLen : 2*sdk.AddrLen])
+}
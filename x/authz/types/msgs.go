@@ -0,0 +1,269 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Message types for the authz module
+const (
+	TypeMsgGrantAuthorization  = "grant_authorization"
+	TypeMsgRevokeAuthorization = "revoke_authorization"
+	TypeMsgExecAuthorized      = "exec_authorized"
+)
+
+var (
+	_ sdk.Msg                       = &MsgGrantAuthorization{}
+	_ sdk.Msg                       = &MsgRevokeAuthorization{}
+	_ sdk.Msg                       = &MsgExecAuthorized{}
+	_ types.UnpackInterfacesMessage = MsgGrantAuthorization{}
+	_ types.UnpackInterfacesMessage = MsgExecAuthorized{}
+)
+
+// NewMsgGrantAuthorization creates a new MsgGrantAuthorization.
+func NewMsgGrantAuthorization(
+	granter, grantee sdk.AccAddress, authorization Authorization, expiration time.Time,
+) (*MsgGrantAuthorization, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) Type() string { return TypeMsgGrantAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidExpirationTime, "missing expiration time")
+	}
+
+	if msg.Authorization == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "missing authorization")
+	}
+
+	authorization := msg.GetGrantAuthorization()
+	if authorization == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not an Authorization", msg.Authorization.TypeUrl)
+	}
+
+	return authorization.ValidateBasic()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// GetGrantAuthorization returns the unpacked authorization of the message, or
+// nil if it is missing or not an Authorization.
+func (msg MsgGrantAuthorization) GetGrantAuthorization() Authorization {
+	if msg.Authorization == nil {
+		return nil
+	}
+
+	authorization, ok := msg.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+
+	return authorization
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAuthorization) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if msg.Authorization == nil {
+		return nil
+	}
+
+	var authorization Authorization
+	return unpacker.UnpackAny(msg.Authorization, &authorization)
+}
+
+// NewMsgRevokeAuthorization creates a new MsgRevokeAuthorization.
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgType string) *MsgRevokeAuthorization {
+	return &MsgRevokeAuthorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) Type() string { return TypeMsgRevokeAuthorization }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "granter and grantee cannot be the same")
+	}
+	if msg.MsgType == "" {
+		return sdkerrors.Wrap(ErrInvalidMsgType, "missing message type")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// NewMsgExecAuthorized creates a new MsgExecAuthorized.
+func NewMsgExecAuthorized(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExecAuthorized, error) {
+	msgsAny := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return &MsgExecAuthorized{
+		Grantee: grantee,
+		Msgs:    msgsAny,
+	}, nil
+}
+
+// GetMessages returns the unpacked messages of the MsgExecAuthorized.
+func (msg MsgExecAuthorized) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.Msg", i)
+		}
+
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) Type() string { return TypeMsgExecAuthorized }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) ValidateBasic() error {
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// execAuthorizedSignDoc mirrors the amino JSON encoding of MsgExecAuthorized
+// with the wrapped messages replaced by their sign bytes.
+type execAuthorizedSignDoc struct {
+	Type  string                     `json:"type"`
+	Value execAuthorizedSignDocValue `json:"value"`
+}
+
+type execAuthorizedSignDocValue struct {
+	Grantee sdk.AccAddress    `json:"grantee"`
+	Msgs    []json.RawMessage `json:"msgs"`
+}
+
+// GetSignBytes implements the sdk.Msg interface. The wrapped messages are
+// included through their own sign bytes, so that the authz codec does not need
+// to know about every message type that may be executed.
+func (msg MsgExecAuthorized) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBytes[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(execAuthorizedSignDoc{
+		Type:  "cosmos-sdk/MsgExecAuthorized",
+		Value: execAuthorizedSignDocValue{Grantee: msg.Grantee, Msgs: msgsBytes},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgExecAuthorized) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grantee}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExecAuthorized) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
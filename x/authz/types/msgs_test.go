@@ -0,0 +1,112 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	granter = sdk.AccAddress("_______granter______")
+	grantee = sdk.AccAddress("_______grantee______")
+)
+
+func TestMsgGrantAuthorization(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	expiration := time.Now().Add(time.Hour)
+
+	cases := map[string]struct {
+		granter, grantee sdk.AccAddress
+		authorization    types.Authorization
+		expiration       time.Time
+		valid            bool
+	}{
+		"valid send":         {granter, grantee, types.NewSendAuthorization(coins), expiration, true},
+		"valid generic":      {granter, grantee, types.NewGenericAuthorization("/cosmos.gov.MsgVote"), expiration, true},
+		"missing granter":    {nil, grantee, types.NewSendAuthorization(coins), expiration, false},
+		"missing grantee":    {granter, nil, types.NewSendAuthorization(coins), expiration, false},
+		"self grant":         {granter, granter, types.NewSendAuthorization(coins), expiration, false},
+		"missing expiration": {granter, grantee, types.NewSendAuthorization(coins), time.Time{}, false},
+		"invalid limit":      {granter, grantee, types.NewSendAuthorization(nil), expiration, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, err := types.NewMsgGrantAuthorization(tc.granter, tc.grantee, tc.authorization, tc.expiration)
+			require.NoError(t, err)
+
+			if tc.valid {
+				require.NoError(t, msg.ValidateBasic())
+				require.Equal(t, []sdk.AccAddress{tc.granter}, msg.GetSigners())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgGrantAuthorizationMissingAuthorization(t *testing.T) {
+	authorization := types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	expiration := time.Now().Add(time.Hour)
+
+	msg, err := types.NewMsgGrantAuthorization(granter, grantee, authorization, expiration)
+	require.NoError(t, err)
+
+	grant, err := types.NewAuthorizationGrant(authorization, expiration)
+	require.NoError(t, err)
+	require.NoError(t, grant.ValidateBasic())
+
+	// a missing authorization is rejected instead of panicking
+	msg.Authorization = nil
+	require.Nil(t, msg.GetGrantAuthorization())
+	require.True(t, sdkerrors.ErrInvalidType.Is(msg.ValidateBasic()))
+
+	grant.Authorization = nil
+	require.Nil(t, grant.GetAuthorizationGrant())
+	require.True(t, sdkerrors.ErrInvalidType.Is(grant.ValidateBasic()))
+
+	// an authorization which is not an Authorization is rejected
+	msg.Authorization = &codectypes.Any{TypeUrl: "/cosmos.bank.MsgSend"}
+	require.Nil(t, msg.GetGrantAuthorization())
+	require.True(t, sdkerrors.ErrInvalidType.Is(msg.ValidateBasic()))
+}
+
+func TestMsgRevokeAuthorization(t *testing.T) {
+	require.NoError(t, types.NewMsgRevokeAuthorization(granter, grantee, "/cosmos.bank.MsgSend").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granter, grantee, "").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(granter, granter, "/cosmos.bank.MsgSend").ValidateBasic())
+	require.Error(t, types.NewMsgRevokeAuthorization(nil, grantee, "/cosmos.bank.MsgSend").ValidateBasic())
+}
+
+func TestMsgExecAuthorized(t *testing.T) {
+	send := banktypes.NewMsgSend(granter, grantee, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)))
+
+	msg, err := types.NewMsgExecAuthorized(grantee, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{grantee}, msg.GetSigners())
+
+	msgs, err := msg.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{send}, msgs)
+
+	// the sign bytes embed those of the wrapped messages
+	require.Contains(t, string(msg.GetSignBytes()), string(send.GetSignBytes()))
+
+	empty, err := types.NewMsgExecAuthorized(grantee, nil)
+	require.NoError(t, err)
+	require.Error(t, empty.ValidateBasic())
+
+	invalid, err := types.NewMsgExecAuthorized(grantee, []sdk.Msg{banktypes.NewMsgSend(granter, grantee, nil)})
+	require.NoError(t, err)
+	require.Error(t, invalid.ValidateBasic())
+}
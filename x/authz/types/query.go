@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryAuthorizationResponse{}
+	_ types.UnpackInterfacesMessage = QueryAuthorizationsResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryAuthorizationResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if q.Authorization == nil {
+		return nil
+	}
+
+	return q.Authorization.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryAuthorizationsResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range q.Authorizations {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
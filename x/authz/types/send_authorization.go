@@ -0,0 +1,54 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var _ Authorization = &SendAuthorization{}
+
+// NewSendAuthorization creates a new SendAuthorization object.
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgType implements Authorization.MsgType.
+func (authorization SendAuthorization) MsgType() string {
+	return MsgTypeURL(&banktypes.MsgSend{})
+}
+
+// Accept implements Authorization.Accept. It deducts the amount sent from the
+// spend limit, and deletes the authorization once the limit is used up.
+func (authorization SendAuthorization) Accept(msg sdk.Msg, block abci.Header) (Authorization, bool, error) {
+	msgSend, ok := msg.(*banktypes.MsgSend)
+	if !ok {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expected %T, got %T", &banktypes.MsgSend{}, msg)
+	}
+
+	limitLeft, isNegative := authorization.SpendLimit.SafeSub(msgSend.Amount)
+	if isNegative {
+		return nil, false, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return nil, true, nil
+	}
+
+	return &SendAuthorization{SpendLimit: limitLeft}, false, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (authorization SendAuthorization) ValidateBasic() error {
+	if !authorization.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit is invalid: %s", authorization.SpendLimit)
+	}
+	if !authorization.SpendLimit.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestSendAuthorization(t *testing.T) {
+	fromAddr := sdk.AccAddress("_______from_________")
+	toAddr := sdk.AccAddress("_________to_________")
+	limit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+
+	authorization := types.NewSendAuthorization(limit)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/cosmos.bank.MsgSend", authorization.MsgType())
+
+	// only bank sends are accepted
+	_, _, err := authorization.Accept(banktypes.NewMsgMultiSend(nil, nil), abci.Header{})
+	require.Error(t, err)
+
+	// spending part of the limit updates the authorization
+	send := banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 40)))
+	updated, del, err := authorization.Accept(send, abci.Header{})
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Equal(t, types.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("atom", 60))), updated)
+
+	// spending more than the limit fails
+	send = banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 101)))
+	_, _, err = authorization.Accept(send, abci.Header{})
+	require.Error(t, err)
+
+	// spending other denoms fails
+	send = banktypes.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)))
+	_, _, err = authorization.Accept(send, abci.Header{})
+	require.Error(t, err)
+
+	// spending the whole limit deletes the authorization
+	send = banktypes.NewMsgSend(fromAddr, toAddr, limit)
+	updated, del, err = authorization.Accept(send, abci.Header{})
+	require.NoError(t, err)
+	require.True(t, del)
+	require.Nil(t, updated)
+
+	require.Error(t, types.NewSendAuthorization(nil).ValidateBasic())
+}
+
+func TestGenericAuthorization(t *testing.T) {
+	authorization := types.NewGenericAuthorization("/cosmos.gov.MsgVote")
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/cosmos.gov.MsgVote", authorization.MsgType())
+
+	updated, del, err := authorization.Accept(nil, abci.Header{})
+	require.NoError(t, err)
+	require.False(t, del)
+	require.Nil(t, updated)
+
+	require.Error(t, types.NewGenericAuthorization("").ValidateBasic())
+}
@@ -122,6 +122,38 @@ func TestSendNotEnoughBalance(t *testing.T) {
 	require.Equal(t, res2.GetSequence(), origSeq+1)
 }
 
+func TestSendDisabledDenom(t *testing.T) {
+	acc := &authtypes.BaseAccount{
+		Address: addr1,
+	}
+
+	genAccs := []authtypes.GenesisAccount{acc}
+	app := simapp.SetupWithGenesisAccounts(genAccs)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	err := app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 67)))
+	require.NoError(t, err)
+
+	app.BankKeeper.SetParams(ctx, types.DefaultParams().SetSendEnabledParam("foocoin", false))
+
+	app.Commit()
+
+	res1 := app.AccountKeeper.GetAccount(ctx, addr1)
+	require.NotNil(t, res1)
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{sendMsg1}, []uint64{res1.GetAccountNumber()}, []uint64{res1.GetSequence()}, false, false, priv1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "foocoin transfers are currently disabled")
+
+	header = abci.Header{Height: app.LastBlockHeight() + 1}
+	_, _, err = simapp.SignCheckDeliver(t, app.Codec(), app.BaseApp, header, []sdk.Msg{multiSendMsg1}, []uint64{res1.GetAccountNumber()}, []uint64{res1.GetSequence() + 1}, false, false, priv1)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "foocoin transfers are currently disabled")
+
+	simapp.CheckBalance(t, app, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)})
+}
+
 // A module account cannot be the recipient of bank sends unless it has been marked as such
 func TestSendToModuleAcc(t *testing.T) {
 	tests := []struct {
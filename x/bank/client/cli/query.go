@@ -31,6 +31,7 @@ func GetQueryCmd(clientCtx client.Context) *cobra.Command {
 	cmd.AddCommand(
 		GetBalancesCmd(clientCtx),
 		GetCmdQueryTotalSupply(clientCtx),
+		GetCmdDenomsMetadata(clientCtx),
 	)
 
 	return cmd
@@ -114,3 +115,47 @@ $ %s query %s total stake
 
 	return flags.GetCommands(cmd)[0]
 }
+
+func GetCmdDenomsMetadata(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-metadata",
+		Args:  cobra.NoArgs,
+		Short: "Query the client metadata for coin denominations",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client metadata for all the registered coin denominations
+
+Example:
+  To query for the client metadata of all coin denominations use:
+  $ %s query %s denom-metadata
+
+To query for the client metadata of a specific coin denomination use:
+  $ %s query %s denom-metadata --denom=[denom]
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			denom := viper.GetString(flagDenom)
+
+			if denom == "" {
+				res, err := queryClient.DenomsMetadata(context.Background(), &types.QueryDenomsMetadataRequest{})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintOutput(res.Metadatas)
+			}
+
+			res, err := queryClient.DenomMetadata(context.Background(), &types.QueryDenomMetadataRequest{Denom: denom})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintOutput(res.Metadata)
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "The specific denomination to query client metadata for")
+
+	return flags.GetCommands(cmd)[0]
+}
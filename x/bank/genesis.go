@@ -10,7 +10,7 @@ import (
 
 // InitGenesis initializes the bank module's state from a given genesis state.
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisState) {
-	keeper.SetSendEnabled(ctx, genState.SendEnabled)
+	keeper.SetParams(ctx, genState.Params)
 
 	var totalSupply sdk.Coins
 
@@ -32,6 +32,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, genState types.GenesisSt
 	}
 
 	keeper.SetSupply(ctx, types.NewSupply(genState.Supply))
+
+	for _, meta := range genState.DenomMetadata {
+		keeper.SetDenomMetaData(ctx, meta)
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -57,11 +61,31 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		})
 	}
 
-	return types.NewGenesisState(keeper.GetSendEnabled(ctx), balances, keeper.GetSupply(ctx).GetTotal())
+	return types.NewGenesisState(
+		keeper.GetParams(ctx), balances, keeper.GetSupply(ctx).GetTotal(), keeper.GetAllDenomMetaData(ctx),
+	)
 }
 
 // ValidateGenesis performs basic validation of supply genesis data returning an
 // error for any failed validation criteria.
 func ValidateGenesis(data types.GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenMetadata := make(map[string]bool)
+
+	for _, metadata := range data.DenomMetadata {
+		if seenMetadata[metadata.Base] {
+			return fmt.Errorf("duplicate metadata for denom %s", metadata.Base)
+		}
+
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		seenMetadata[metadata.Base] = true
+	}
+
 	return types.NewSupply(data.Supply).ValidateBasic()
 }
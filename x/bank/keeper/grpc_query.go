@@ -71,3 +71,29 @@ func (q BaseKeeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest)
 
 	return &types.QuerySupplyOfResponse{Amount: supply}, nil
 }
+
+// DenomMetadata implements the Query/DenomMetadata gRPC method
+func (q BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadata, found := q.GetDenomMetaData(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+// DenomsMetadata implements the Query/DenomsMetadata gRPC method
+func (q BaseKeeper) DenomsMetadata(c context.Context, _ *types.QueryDenomsMetadataRequest) (*types.QueryDenomsMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDenomsMetadataResponse{Metadatas: q.GetAllDenomMetaData(ctx)}, nil
+}
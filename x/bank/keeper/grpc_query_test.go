@@ -107,3 +107,45 @@ func (suite *IntegrationTestSuite) TestQueryTotalSupplyOf() {
 
 	suite.Require().Equal(test1Supply.Amount, res.Amount)
 }
+
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Metadatas)
+
+	metadata := suite.getTestMetadata()
+	for _, m := range metadata {
+		app.BankKeeper.SetDenomMetaData(ctx, m)
+	}
+
+	res, err = queryClient.DenomsMetadata(gocontext.Background(), &types.QueryDenomsMetadataRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res.Metadatas)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, app.BankKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	_, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().Error(err)
+
+	metadata := suite.getTestMetadata()[0]
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	res, err := queryClient.DenomMetadata(gocontext.Background(), &types.QueryDenomMetadataRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(metadata, res.Metadata)
+}
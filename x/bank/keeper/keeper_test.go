@@ -69,7 +69,7 @@ func (suite *IntegrationTestSuite) SetupTest() {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	suite.app = app
 	suite.ctx = ctx
@@ -453,9 +453,77 @@ func (suite *IntegrationTestSuite) TestBalance() {
 
 func (suite *IntegrationTestSuite) TestSendEnabled() {
 	app, ctx := suite.app, suite.ctx
-	enabled := false
-	app.BankKeeper.SetSendEnabled(ctx, enabled)
-	suite.Require().Equal(enabled, app.BankKeeper.GetSendEnabled(ctx))
+	enabled := true
+	params := types.DefaultParams()
+	suite.Require().Equal(enabled, params.DefaultSendEnabled)
+
+	app.BankKeeper.SetParams(ctx, params)
+
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	fooCoin := sdk.NewCoin("foocoin", sdk.OneInt())
+	barCoin := sdk.NewCoin("barcoin", sdk.OneInt())
+
+	// assert with default (all denom) send enabled both Bar and Bond Denom are enabled
+	suite.Require().Equal(enabled, app.BankKeeper.SendEnabledCoin(ctx, barCoin))
+	suite.Require().Equal(enabled, app.BankKeeper.SendEnabledCoin(ctx, bondCoin))
+
+	// Both coins should be send enabled.
+	err := app.BankKeeper.SendEnabledCoins(ctx, fooCoin, bondCoin)
+	suite.Require().NoError(err)
+
+	// Set default send_enabled to !enabled, add a foodenom that overrides default as enabled
+	params.DefaultSendEnabled = !enabled
+	params = params.SetSendEnabledParam(fooCoin.Denom, enabled)
+	app.BankKeeper.SetParams(ctx, params)
+
+	// Expect our specific override to be enabled, others to be !enabled.
+	suite.Require().Equal(enabled, app.BankKeeper.SendEnabledCoin(ctx, fooCoin))
+	suite.Require().Equal(!enabled, app.BankKeeper.SendEnabledCoin(ctx, barCoin))
+	suite.Require().Equal(!enabled, app.BankKeeper.SendEnabledCoin(ctx, bondCoin))
+
+	// Foo coin should be send enabled.
+	err = app.BankKeeper.SendEnabledCoins(ctx, fooCoin)
+	suite.Require().NoError(err)
+
+	// Expect an error when one coin is not send enabled.
+	err = app.BankKeeper.SendEnabledCoins(ctx, fooCoin, bondCoin)
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrSendDisabled.Is(err))
+	suite.Require().Contains(err.Error(), bondCoin.Denom)
+
+	// Expect an error when all coins are not send enabled.
+	err = app.BankKeeper.SendEnabledCoins(ctx, bondCoin, barCoin)
+	suite.Require().Error(err)
+}
+
+func (suite *IntegrationTestSuite) TestSendCoinsSendDisabled() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, addr1))
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+	suite.Require().NoError(app.BankKeeper.SetBalances(ctx, addr1, balances))
+
+	app.BankKeeper.SetParams(ctx, types.DefaultParams().SetSendEnabledParam(fooDenom, false))
+
+	sendAmt := sdk.NewCoins(newFooCoin(10))
+	suite.Require().True(types.ErrSendDisabled.Is(app.BankKeeper.SendCoins(ctx, addr1, addr2, sendAmt)))
+
+	inputs := []types.Input{types.NewInput(addr1, sendAmt)}
+	outputs := []types.Output{types.NewOutput(addr2, sendAmt)}
+	suite.Require().True(types.ErrSendDisabled.Is(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	// denoms without an explicit setting use the default
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// transfers which are not initiated by the sender, such as escrow
+	// releases, explicitly bypass the check
+	suite.Require().NoError(app.BankKeeper.SendCoinsUnchecked(ctx, addr1, addr2, sendAmt))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
 }
 
 func (suite *IntegrationTestSuite) TestHasBalance() {
@@ -526,7 +594,7 @@ func (suite *IntegrationTestSuite) TestMsgSendEvents() {
 func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	app, ctx := suite.app, suite.ctx
 
-	app.BankKeeper.SetSendEnabled(ctx, true)
+	app.BankKeeper.SetParams(ctx, types.DefaultParams())
 
 	addr := sdk.AccAddress([]byte("addr1"))
 	addr2 := sdk.AccAddress([]byte("addr2"))
@@ -924,6 +992,67 @@ func (suite *IntegrationTestSuite) TestUndelegateCoins_Invalid() {
 	suite.Require().Error(app.BankKeeper.UndelegateCoins(ctx, addrModule, addr1, delCoins))
 }
 
+func (suite *IntegrationTestSuite) TestSetDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	metadata := suite.getTestMetadata()
+
+	for i := range []int{1, 2} {
+		app.BankKeeper.SetDenomMetaData(ctx, metadata[i])
+	}
+
+	actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata[1].Base)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata[1], actualMetadata)
+
+	_, found = app.BankKeeper.GetDenomMetaData(ctx, "unknown")
+	suite.Require().False(found)
+}
+
+func (suite *IntegrationTestSuite) TestIterateAllDenomMetaData() {
+	app, ctx := suite.app, suite.ctx
+
+	expectedMetadata := suite.getTestMetadata()
+	// set metadata
+	for i := range []int{1, 2} {
+		app.BankKeeper.SetDenomMetaData(ctx, expectedMetadata[i])
+	}
+	// retrieve metadata
+	actualMetadata := make([]types.Metadata, 0)
+	app.BankKeeper.IterateAllDenomMetaData(ctx, func(metadata types.Metadata) bool {
+		actualMetadata = append(actualMetadata, metadata)
+		return false
+	})
+	// execute checks
+	suite.Require().Equal(expectedMetadata, actualMetadata)
+	suite.Require().Equal(expectedMetadata, app.BankKeeper.GetAllDenomMetaData(ctx))
+}
+
+func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
+	return []types.Metadata{
+		{
+			Description: "The native staking token of the Cosmos Hub.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "uatom", Exponent: 0, Aliases: []string{"microatom"}},
+				{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+				{Denom: "atom", Exponent: 6, Aliases: nil},
+			},
+			Base:    "uatom",
+			Display: "atom",
+		},
+		{
+			Description: "The native staking token of the TOKEN network.",
+			DenomUnits: []*types.DenomUnit{
+				{Denom: "1token", Exponent: 5, Aliases: []string{"decitoken"}},
+				{Denom: "2token", Exponent: 4, Aliases: []string{"centitoken"}},
+				{Denom: "3token", Exponent: 7, Aliases: []string{"dekatoken"}},
+			},
+			Base:    "utoken",
+			Display: "token",
+		},
+	}
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -1,6 +1,7 @@
 package bank
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -8,6 +9,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -63,6 +65,13 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 	rest.RegisterHandlers(clientCtx, rtr)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the bank module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, rtr *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), rtr, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
 // GetTxCmd returns the root tx command for the bank module.
 func (AppModuleBasic) GetTxCmd(clientCtx client.Context) *cobra.Command {
 	return cli.NewTxCmd(clientCtx)
@@ -12,11 +12,25 @@ import (
 
 // Simulation parameter constants
 const (
-	SendEnabled = "send_enabled"
+	SendEnabled        = "send_enabled"
+	DefaultSendEnabled = "default_send_enabled"
 )
 
 // GenSendEnabled randomized SendEnabled
-func GenSendEnabled(r *rand.Rand) bool {
+func GenSendEnabled(r *rand.Rand) types.SendEnabledParams {
+	params := types.SendEnabledParams{}
+
+	// 50% chance of the bond denom having an explicit send enabled setting
+	if r.Int63n(101) <= 50 {
+		// 95% chance of transfers being enabled for the bond denom
+		params = append(params, types.NewSendEnabled(sdk.DefaultBondDenom, r.Int63n(101) <= 95))
+	}
+
+	return params
+}
+
+// GenDefaultSendEnabled randomized DefaultSendEnabled
+func GenDefaultSendEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of transfers being enabled
 }
 
@@ -37,16 +51,24 @@ func RandomGenesisBalances(simState *module.SimulationState) []types.Balance {
 
 // RandomizedGenState generates a random GenesisState for bank
 func RandomizedGenState(simState *module.SimulationState) {
-	var sendEnabled bool
+	var sendEnabledParams types.SendEnabledParams
 	simState.AppParams.GetOrGenerate(
-		simState.Cdc, SendEnabled, &sendEnabled, simState.Rand,
-		func(r *rand.Rand) { sendEnabled = GenSendEnabled(r) },
+		simState.Cdc, SendEnabled, &sendEnabledParams, simState.Rand,
+		func(r *rand.Rand) { sendEnabledParams = GenSendEnabled(r) },
+	)
+
+	var defaultSendEnabled bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DefaultSendEnabled, &defaultSendEnabled, simState.Rand,
+		func(r *rand.Rand) { defaultSendEnabled = GenDefaultSendEnabled(r) },
 	)
 
 	numAccs := int64(len(simState.Accounts))
 	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
 	supply := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply))
 
-	bankGenesis := types.NewGenesisState(sendEnabled, RandomGenesisBalances(simState), supply)
+	bankGenesis := types.NewGenesisState(
+		types.NewParams(defaultSendEnabled, sendEnabledParams), RandomGenesisBalances(simState), supply, []types.Metadata{},
+	)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(bankGenesis)
 }
@@ -3,6 +3,7 @@ package simulation
 // DONTCOVER
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -12,15 +13,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-const keySendEnabled = "sendenabled"
-
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, keySendEnabled,
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySendEnabled),
+			func(r *rand.Rand) string {
+				paramsBytes, err := json.Marshal(GenSendEnabled(r))
+				if err != nil {
+					panic(err)
+				}
+				return string(paramsBytes)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultSendEnabled),
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", GenSendEnabled(r))
+				return fmt.Sprintf("%v", GenDefaultSendEnabled(r))
 			},
 		),
 	}
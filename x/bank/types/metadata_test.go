@@ -0,0 +1,157 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetadataValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata Metadata
+		expErr   bool
+	}{
+		{
+			"non-empty coins",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			false,
+		},
+		{"empty metadata", Metadata{}, true},
+		{
+			"invalid base denom",
+			Metadata{Base: ""},
+			true,
+		},
+		{
+			"invalid display denom",
+			Metadata{Base: "uatom", Display: ""},
+			true,
+		},
+		{
+			"duplicate denom unit",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"uatom", uint32(1), []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"invalid denom unit",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"", uint32(1), []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"invalid denom unit alias",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{""}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"duplicate denom unit alias",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom", "microatom"}},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+			},
+			true,
+		},
+		{
+			"no base denom unit",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"matom", uint32(3), []string{"milliatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"base denom exponent not zero",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(1), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"no display denom unit",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+		{
+			"denom units not sorted",
+			Metadata{
+				Description: "The native staking token of the Cosmos Hub.",
+				DenomUnits: []*DenomUnit{
+					{"uatom", uint32(0), []string{"microatom"}},
+					{"atom", uint32(6), nil},
+					{"matom", uint32(3), []string{"milliatom"}},
+				},
+				Base:    "uatom",
+				Display: "atom",
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
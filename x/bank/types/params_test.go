@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  Params
+		expPass bool
+	}{
+		{"default params", DefaultParams(), true},
+		{"empty send enabled", NewParams(false, SendEnabledParams{}), true},
+		{"valid send enabled", NewParams(true, SendEnabledParams{NewSendEnabled("foocoin", false)}), true},
+		{"invalid denom", NewParams(true, SendEnabledParams{NewSendEnabled("", true)}), false},
+		{"nil send enabled", NewParams(true, SendEnabledParams{nil}), false},
+		{
+			"duplicate denom",
+			NewParams(true, SendEnabledParams{NewSendEnabled("foocoin", false), NewSendEnabled("foocoin", true)}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSendEnabledDenom(t *testing.T) {
+	params := DefaultParams()
+	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
+
+	params = params.SetSendEnabledParam(sdk.DefaultBondDenom, false)
+	require.False(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
+	require.True(t, params.SendEnabledDenom("foocoin"))
+
+	// overriding an existing setting does not duplicate it
+	params = params.SetSendEnabledParam(sdk.DefaultBondDenom, true)
+	require.Len(t, params.SendEnabled, 1)
+	require.True(t, params.SendEnabledDenom(sdk.DefaultBondDenom))
+
+	params.DefaultSendEnabled = false
+	require.False(t, params.SendEnabledDenom("foocoin"))
+	require.NoError(t, params.Validate())
+}
@@ -11,6 +11,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -390,6 +391,181 @@ func (m *QuerySupplyOfResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuerySupplyOfResponse proto.InternalMessageInfo
 
+// QueryDenomMetadataRequest is the request type for the Query/DenomMetadata RPC method
+type QueryDenomMetadataRequest struct {
+	// denom is the coin denom to query the metadata for
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataRequest{} }
+func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMetadataRequest) ProtoMessage()    {}
+func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b02ea4db7d9aa9f, []int{8}
+}
+func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMetadataRequest.Merge(m, src)
+}
+func (m *QueryDenomMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMetadataRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMetadataResponse is the response type for the Query/DenomMetadata RPC method
+type QueryDenomMetadataResponse struct {
+	// metadata describes and provides all the client information for the requested token
+	Metadata Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataResponse{} }
+func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMetadataResponse) ProtoMessage()    {}
+func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b02ea4db7d9aa9f, []int{9}
+}
+func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMetadataResponse.Merge(m, src)
+}
+func (m *QueryDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMetadataResponse) GetMetadata() Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return Metadata{}
+}
+
+// QueryDenomsMetadataRequest is the request type for the Query/DenomsMetadata RPC method
+type QueryDenomsMetadataRequest struct {
+}
+
+func (m *QueryDenomsMetadataRequest) Reset()         { *m = QueryDenomsMetadataRequest{} }
+func (m *QueryDenomsMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataRequest) ProtoMessage()    {}
+func (*QueryDenomsMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b02ea4db7d9aa9f, []int{10}
+}
+func (m *QueryDenomsMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataRequest.Merge(m, src)
+}
+func (m *QueryDenomsMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataRequest proto.InternalMessageInfo
+
+// QueryDenomsMetadataResponse is the response type for the Query/DenomsMetadata RPC method
+type QueryDenomsMetadataResponse struct {
+	// metadatas provides the client information for all the registered tokens
+	Metadatas []Metadata `protobuf:"bytes,1,rep,name=metadatas,proto3" json:"metadatas"`
+}
+
+func (m *QueryDenomsMetadataResponse) Reset()         { *m = QueryDenomsMetadataResponse{} }
+func (m *QueryDenomsMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataResponse) ProtoMessage()    {}
+func (*QueryDenomsMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b02ea4db7d9aa9f, []int{11}
+}
+func (m *QueryDenomsMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataResponse.Merge(m, src)
+}
+func (m *QueryDenomsMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataResponse) GetMetadatas() []Metadata {
+	if m != nil {
+		return m.Metadatas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.QueryBalanceResponse")
@@ -399,42 +575,59 @@ func init() {
 	proto.RegisterType((*QueryTotalSupplyResponse)(nil), "cosmos.bank.QueryTotalSupplyResponse")
 	proto.RegisterType((*QuerySupplyOfRequest)(nil), "cosmos.bank.QuerySupplyOfRequest")
 	proto.RegisterType((*QuerySupplyOfResponse)(nil), "cosmos.bank.QuerySupplyOfResponse")
+	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "cosmos.bank.QueryDenomMetadataRequest")
+	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.QueryDenomMetadataResponse")
+	proto.RegisterType((*QueryDenomsMetadataRequest)(nil), "cosmos.bank.QueryDenomsMetadataRequest")
+	proto.RegisterType((*QueryDenomsMetadataResponse)(nil), "cosmos.bank.QueryDenomsMetadataResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/query.proto", fileDescriptor_1b02ea4db7d9aa9f) }
 
 var fileDescriptor_1b02ea4db7d9aa9f = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xb5, 0x8b, 0x9a, 0x94, 0x49, 0x4f, 0xdb, 0xa0, 0x06, 0x1f, 0xec, 0xb0, 0x82, 0x2a, 0x48,
-	0x74, 0x0d, 0xe5, 0x8e, 0x14, 0x57, 0x42, 0x42, 0x1c, 0x00, 0x17, 0x71, 0xa8, 0x90, 0x90, 0x3f,
-	0xb6, 0xa1, 0xaa, 0xed, 0x75, 0xbd, 0x36, 0x6a, 0xfe, 0x05, 0xbf, 0x83, 0x5f, 0xd2, 0x63, 0x8f,
-	0x88, 0x43, 0x40, 0xc9, 0x8d, 0x9f, 0xc0, 0x09, 0x79, 0x77, 0x5d, 0x39, 0xb1, 0x15, 0x7a, 0xa0,
-	0xb7, 0x64, 0xe6, 0xcd, 0x7b, 0x6f, 0xf6, 0xad, 0x17, 0x76, 0x03, 0xc6, 0x63, 0xc6, 0x6d, 0xdf,
-	0x4b, 0xce, 0xec, 0xf3, 0x82, 0x66, 0x53, 0x92, 0x66, 0x2c, 0x67, 0xa8, 0x27, 0x1b, 0xa4, 0x6c,
-	0x18, 0xfd, 0x09, 0x9b, 0x30, 0x51, 0xb7, 0xcb, 0x5f, 0x12, 0x62, 0xec, 0xa8, 0x59, 0x85, 0x14,
-	0x45, 0x7c, 0x01, 0x3b, 0xef, 0x4a, 0x1a, 0xc7, 0x8b, 0xbc, 0x24, 0xa0, 0x2e, 0x3d, 0x2f, 0x28,
-	0xcf, 0xd1, 0x6b, 0xe8, 0x7a, 0x61, 0x98, 0x51, 0xce, 0x07, 0xfa, 0x50, 0x1f, 0x6d, 0x3b, 0xcf,
-	0xfe, 0xcc, 0xac, 0xfd, 0xc9, 0x69, 0xfe, 0xb9, 0xf0, 0x49, 0xc0, 0x62, 0x7b, 0x89, 0x6b, 0x9f,
-	0x87, 0x67, 0x76, 0x3e, 0x4d, 0x29, 0x27, 0xe3, 0x20, 0x18, 0xcb, 0x41, 0xb7, 0x62, 0x40, 0x7d,
-	0xd8, 0x0c, 0x69, 0xc2, 0xe2, 0xc1, 0xc6, 0x50, 0x1f, 0xdd, 0x75, 0xe5, 0x1f, 0xfc, 0x02, 0xfa,
-	0xcb, 0xca, 0x3c, 0x65, 0x09, 0xa7, 0x68, 0x0f, 0xba, 0xbe, 0x2c, 0x09, 0xe9, 0xde, 0xc1, 0x36,
-	0x51, 0x8e, 0x0f, 0xd9, 0x69, 0xe2, 0x56, 0x4d, 0x7c, 0x02, 0xbb, 0x62, 0x7e, 0x1c, 0x45, 0x8a,
-	0x82, 0xdf, 0x86, 0x7b, 0xfc, 0x05, 0x06, 0x4d, 0x1d, 0xe5, 0xf5, 0x18, 0xb6, 0x94, 0x9d, 0x52,
-	0xe9, 0xce, 0xaa, 0x59, 0xe7, 0xe9, 0xe5, 0xcc, 0xd2, 0xbe, 0xfd, 0xb4, 0x46, 0x37, 0xd0, 0x2e,
-	0x07, 0xb8, 0x7b, 0xcd, 0x87, 0xef, 0xab, 0xfd, 0xde, 0xb3, 0xdc, 0x8b, 0x8e, 0x8a, 0x34, 0x8d,
-	0xa6, 0x6a, 0x3f, 0x9c, 0x29, 0x4b, 0x4b, 0x2d, 0x65, 0xe9, 0x03, 0x74, 0xb8, 0xa8, 0xfc, 0x27,
-	0x43, 0x8a, 0x0d, 0x3f, 0x51, 0x71, 0x49, 0xb9, 0x37, 0x27, 0xd5, 0x59, 0x5f, 0x87, 0xab, 0xd7,
-	0xc3, 0xfd, 0x04, 0xf7, 0x56, 0xd0, 0xca, 0xde, 0x4b, 0xe8, 0x78, 0x31, 0x2b, 0x92, 0x5c, 0xe2,
-	0x1d, 0x52, 0x1a, 0xfa, 0x31, 0xb3, 0xf6, 0x6e, 0x60, 0xe8, 0x55, 0x92, 0xbb, 0x6a, 0xfa, 0xe0,
-	0xf7, 0x06, 0x6c, 0x0a, 0x05, 0xf4, 0x16, 0xba, 0x2a, 0x17, 0x34, 0x24, 0xb5, 0xaf, 0x80, 0xb4,
-	0xdc, 0x6b, 0xe3, 0xc1, 0x1a, 0x84, 0x74, 0x88, 0x35, 0xf4, 0x11, 0x7a, 0xb5, 0xb0, 0xd1, 0xc3,
-	0xe6, 0x4c, 0xf3, 0xce, 0x19, 0x8f, 0xfe, 0x81, 0xaa, 0xb3, 0xd7, 0x72, 0x6b, 0x63, 0x6f, 0x26,
-	0xde, 0xc6, 0xde, 0x12, 0x3e, 0xd6, 0xd0, 0x11, 0x6c, 0x55, 0x67, 0x8e, 0x5a, 0x96, 0x5d, 0x49,
-	0xcf, 0xc0, 0xeb, 0x20, 0x15, 0xa9, 0x73, 0x78, 0x39, 0x37, 0xf5, 0xab, 0xb9, 0xa9, 0xff, 0x9a,
-	0x9b, 0xfa, 0xd7, 0x85, 0xa9, 0x5d, 0x2d, 0x4c, 0xed, 0xfb, 0xc2, 0xd4, 0x8e, 0x1f, 0xaf, 0x8d,
-	0xed, 0x42, 0xbe, 0x53, 0x22, 0x3d, 0xbf, 0x23, 0x1e, 0x9c, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x27, 0x7c, 0xfe, 0x36, 0xc3, 0x04, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x4f, 0x13, 0x5d,
+	0x14, 0xc6, 0x3b, 0xbc, 0x2f, 0x05, 0x4e, 0xd1, 0xc5, 0x05, 0xa4, 0x0c, 0x38, 0x85, 0x2b, 0x94,
+	0x92, 0xc0, 0x8c, 0xe0, 0xc2, 0xb8, 0x31, 0xa1, 0x18, 0x13, 0x63, 0x8c, 0x71, 0xfc, 0x13, 0xc3,
+	0x86, 0xdc, 0xb6, 0x43, 0x6d, 0x68, 0xe7, 0x96, 0xde, 0x29, 0x42, 0x08, 0x31, 0xd1, 0x95, 0x1b,
+	0x63, 0xe2, 0xd6, 0x4f, 0xe0, 0x27, 0x61, 0x49, 0xe2, 0xc6, 0xb8, 0x40, 0x03, 0x7e, 0x0a, 0x57,
+	0x66, 0xee, 0x3d, 0x53, 0x3b, 0x9d, 0x71, 0xca, 0x42, 0x37, 0x4d, 0x7b, 0xce, 0x73, 0xce, 0xf3,
+	0x9b, 0x3b, 0xe7, 0xdc, 0xc2, 0x64, 0x99, 0x8b, 0x06, 0x17, 0x56, 0x89, 0xb9, 0x3b, 0xd6, 0x6e,
+	0xdb, 0x69, 0x1d, 0x98, 0xcd, 0x16, 0xf7, 0x38, 0xc9, 0xa8, 0x84, 0xe9, 0x27, 0xf4, 0xf1, 0x2a,
+	0xaf, 0x72, 0x19, 0xb7, 0xfc, 0x6f, 0x4a, 0xa2, 0xcf, 0x54, 0x39, 0xaf, 0xd6, 0x1d, 0x8b, 0x35,
+	0x6b, 0x16, 0x73, 0x5d, 0xee, 0x31, 0xaf, 0xc6, 0x5d, 0x81, 0xd9, 0x31, 0xec, 0x8c, 0x7d, 0x54,
+	0xf0, 0x4a, 0xb7, 0x9d, 0xff, 0xa1, 0xe2, 0x74, 0x1f, 0xc6, 0x1e, 0xf9, 0xe6, 0x45, 0x56, 0x67,
+	0x6e, 0xd9, 0xb1, 0x9d, 0xdd, 0xb6, 0x23, 0x3c, 0x72, 0x1f, 0x86, 0x58, 0xa5, 0xd2, 0x72, 0x84,
+	0xc8, 0x6a, 0xb3, 0x5a, 0x61, 0xb4, 0xb8, 0xfa, 0xf3, 0x34, 0xb7, 0x52, 0xad, 0x79, 0x2f, 0xda,
+	0x25, 0xb3, 0xcc, 0x1b, 0x56, 0xc8, 0x63, 0x45, 0x54, 0x76, 0x2c, 0xef, 0xa0, 0xe9, 0x08, 0x73,
+	0xbd, 0x5c, 0x5e, 0x57, 0x85, 0x76, 0xd0, 0x81, 0x8c, 0xc3, 0x60, 0xc5, 0x71, 0x79, 0x23, 0x3b,
+	0x30, 0xab, 0x15, 0x46, 0x6c, 0xf5, 0x83, 0xde, 0x86, 0xf1, 0xb0, 0xb3, 0x68, 0x72, 0x57, 0x38,
+	0x24, 0x0f, 0x43, 0x25, 0x15, 0x92, 0xd6, 0x99, 0xb5, 0x51, 0x13, 0x9f, 0x64, 0x83, 0xd7, 0x5c,
+	0x3b, 0x48, 0xd2, 0x6d, 0x98, 0x94, 0xf5, 0xeb, 0xf5, 0x3a, 0xb6, 0x10, 0xff, 0x82, 0x9e, 0xee,
+	0x41, 0x36, 0xea, 0x83, 0xac, 0x9b, 0x30, 0x8c, 0x38, 0xbe, 0xd3, 0x7f, 0xbd, 0xb0, 0xc5, 0xeb,
+	0xc7, 0xa7, 0xb9, 0xd4, 0xa7, 0x6f, 0xb9, 0xc2, 0x05, 0xbc, 0xfd, 0x02, 0x61, 0x77, 0xfa, 0xd1,
+	0x29, 0x7c, 0xbe, 0x27, 0xdc, 0x63, 0xf5, 0xc7, 0xed, 0x66, 0xb3, 0x7e, 0x80, 0xcf, 0x47, 0x5b,
+	0x90, 0x8d, 0xa6, 0x10, 0xe9, 0x19, 0xa4, 0x85, 0x8c, 0xfc, 0x25, 0x20, 0xec, 0x46, 0x97, 0xf1,
+	0x75, 0x29, 0xbb, 0x87, 0xdb, 0xc1, 0x59, 0x77, 0x5e, 0xae, 0xd6, 0xfd, 0x72, 0xb7, 0x60, 0xa2,
+	0x47, 0x8d, 0x78, 0x77, 0x21, 0xcd, 0x1a, 0xbc, 0xed, 0x7a, 0x4a, 0x5f, 0x34, 0x7d, 0xa0, 0xaf,
+	0xa7, 0xb9, 0xfc, 0x05, 0x80, 0xee, 0xb9, 0x9e, 0x8d, 0xd5, 0x74, 0x15, 0xa6, 0xa4, 0xc1, 0x1d,
+	0xdf, 0xee, 0x81, 0xe3, 0xb1, 0x0a, 0xf3, 0x58, 0x32, 0xd3, 0x53, 0xd0, 0xe3, 0x4a, 0x10, 0xec,
+	0x26, 0x0c, 0x37, 0x30, 0x86, 0x73, 0x37, 0x61, 0x76, 0x6d, 0xa2, 0x19, 0x14, 0x14, 0xff, 0xf7,
+	0x89, 0xed, 0x8e, 0x98, 0xce, 0x74, 0xb7, 0x15, 0x3d, 0x28, 0xf4, 0x39, 0x4c, 0xc7, 0x66, 0xd1,
+	0xf5, 0x16, 0x8c, 0x04, 0x8d, 0x82, 0x09, 0x4a, 0xb4, 0xfd, 0xad, 0x5e, 0xfb, 0x98, 0x86, 0x41,
+	0xd9, 0x9a, 0xbc, 0x82, 0x21, 0x9c, 0x4c, 0x32, 0x1b, 0x2a, 0x8e, 0xd9, 0x6c, 0x7d, 0x2e, 0x41,
+	0xa1, 0xa0, 0xa8, 0xf5, 0xfa, 0xf3, 0x8f, 0x0f, 0x03, 0x4b, 0x64, 0xd1, 0x0a, 0x5f, 0x1a, 0x52,
+	0x25, 0xac, 0x43, 0xdc, 0x8c, 0x23, 0xeb, 0x50, 0x1e, 0xec, 0x11, 0x79, 0xa3, 0x41, 0xa6, 0x6b,
+	0x3d, 0xc8, 0x7c, 0xd4, 0x23, 0xba, 0xa5, 0xfa, 0x42, 0x1f, 0x15, 0xd2, 0x2c, 0x4a, 0x9a, 0x39,
+	0x92, 0xeb, 0x43, 0x43, 0x5e, 0x42, 0xa6, 0x6b, 0x21, 0xe2, 0x20, 0xa2, 0xab, 0xa4, 0x2f, 0xf4,
+	0x51, 0x21, 0xc4, 0xb4, 0x84, 0x98, 0x20, 0x63, 0x21, 0x08, 0xb5, 0x1a, 0x64, 0x0f, 0x86, 0x83,
+	0x39, 0x27, 0x31, 0xc7, 0xdb, 0xb3, 0x31, 0x3a, 0x4d, 0x92, 0xa0, 0xdf, 0x35, 0xe9, 0x77, 0x95,
+	0x4c, 0xc7, 0xf8, 0x75, 0x8e, 0xfd, 0x9d, 0x06, 0x97, 0x42, 0xc3, 0x4c, 0xf2, 0xd1, 0xd6, 0x71,
+	0x0b, 0xa2, 0x2f, 0xf6, 0xd5, 0x21, 0xc7, 0xb2, 0xe4, 0xc8, 0x93, 0xf9, 0x10, 0x87, 0xf4, 0x17,
+	0x5b, 0xc1, 0x2c, 0x76, 0x80, 0xde, 0x6a, 0x70, 0x39, 0x3c, 0xe8, 0xe4, 0x4f, 0x4e, 0xbd, 0x8b,
+	0xa2, 0x17, 0xfa, 0x0b, 0x91, 0x69, 0x5e, 0x32, 0x19, 0x64, 0x26, 0x89, 0xa9, 0xb8, 0x71, 0x7c,
+	0x66, 0x68, 0x27, 0x67, 0x86, 0xf6, 0xfd, 0xcc, 0xd0, 0xde, 0x9f, 0x1b, 0xa9, 0x93, 0x73, 0x23,
+	0xf5, 0xe5, 0xdc, 0x48, 0x6d, 0x2e, 0x25, 0x5e, 0x35, 0xfb, 0xaa, 0x9d, 0xbc, 0x71, 0x4a, 0x69,
+	0xf9, 0x27, 0x79, 0xe3, 0xd7, 0x00, 0x7f, 0xc3, 0x3d, 0x48, 0xad, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -457,6 +650,10 @@ type QueryClient interface {
 	TotalSupply(ctx context.Context, in *QueryTotalSupplyRequest, opts ...grpc.CallOption) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin
 	SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, opts ...grpc.CallOption) (*QuerySupplyOfResponse, error)
+	// DenomMetadata queries the client metadata of a given coin denomination
+	DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error)
+	// DenomsMetadata queries the client metadata of all registered coin denominations
+	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
 }
 
 type queryClient struct {
@@ -503,6 +700,24 @@ func (c *queryClient) SupplyOf(ctx context.Context, in *QuerySupplyOfRequest, op
 	return out, nil
 }
 
+func (c *queryClient) DenomMetadata(ctx context.Context, in *QueryDenomMetadataRequest, opts ...grpc.CallOption) (*QueryDenomMetadataResponse, error) {
+	out := new(QueryDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.Query/DenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error) {
+	out := new(QueryDenomsMetadataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.Query/DenomsMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account
@@ -513,6 +728,10 @@ type QueryServer interface {
 	TotalSupply(context.Context, *QueryTotalSupplyRequest) (*QueryTotalSupplyResponse, error)
 	// SupplyOf queries the supply of a single coin
 	SupplyOf(context.Context, *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error)
+	// DenomMetadata queries the client metadata of a given coin denomination
+	DenomMetadata(context.Context, *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error)
+	// DenomsMetadata queries the client metadata of all registered coin denominations
+	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -531,6 +750,12 @@ func (*UnimplementedQueryServer) TotalSupply(ctx context.Context, req *QueryTota
 func (*UnimplementedQueryServer) SupplyOf(ctx context.Context, req *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SupplyOf not implemented")
 }
+func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDenomMetadataRequest) (*QueryDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMetadata not implemented")
+}
+func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -608,6 +833,42 @@ func _Query_SupplyOf_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.Query/DenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMetadata(ctx, req.(*QueryDenomMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.Query/DenomsMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadata(ctx, req.(*QueryDenomsMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -628,6 +889,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SupplyOf",
 			Handler:    _Query_SupplyOf_Handler,
 		},
+		{
+			MethodName: "DenomMetadata",
+			Handler:    _Query_DenomMetadata_Handler,
+		},
+		{
+			MethodName: "DenomsMetadata",
+			Handler:    _Query_DenomsMetadata_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/query.proto",
@@ -895,6 +1164,129 @@ func (m *QuerySupplyOfResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1012,11 +1404,59 @@ func (m *QuerySupplyOfResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryDenomMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomsMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1712,6 +2152,317 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
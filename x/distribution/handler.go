@@ -25,6 +25,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgFundCommunityPool:
 			return handleMsgFundCommunityPool(ctx, msg, k)
 
+		case *types.MsgWithdrawTokenizeShareRecordReward:
+			return handleMsgWithdrawTokenizeShareRecordReward(ctx, msg, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized distribution message type: %T", msg)
 		}
@@ -100,6 +103,22 @@ func handleMsgFundCommunityPool(ctx sdk.Context, msg *types.MsgFundCommunityPool
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+func handleMsgWithdrawTokenizeShareRecordReward(ctx sdk.Context, msg *types.MsgWithdrawTokenizeShareRecordReward, k keeper.Keeper) (*sdk.Result, error) {
+	if _, err := k.WithdrawTokenizeShareRecordReward(ctx, msg.OwnerAddress); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k keeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
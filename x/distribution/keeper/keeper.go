@@ -112,6 +112,53 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations
+// backing the tokenize share records owned by ownerAddr to the owner. Rewards
+// withdrawn to the record module accounts when their shares were modified are
+// sent to the owner as well.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		recordAddr := record.GetModuleAddress()
+
+		// the rewards are withdrawn to the record module account, which cannot
+		// set another withdraw address
+		if k.stakingKeeper.Delegation(ctx, recordAddr, record.ValidatorAddress) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, record.ValidatorAddress); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		// rewards are paid out regardless of the send enabled denoms, like the
+		// rewards withdrawn from the distribution module account
+		if err := k.bankKeeper.SendCoinsUnchecked(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
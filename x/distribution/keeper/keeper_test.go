@@ -11,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -89,6 +91,73 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 	require.True(t, true)
 }
 
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	owner := addr[2]
+
+	sh := staking.NewHandler(app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, distrAcc.GetAddress(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission
+	valTokens := sdk.TokensFromConsensusPower(100)
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg := stakingtypes.NewMsgCreateValidator(
+		valAddrs[0], valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, valTokens),
+		stakingtypes.Description{}, commission, sdk.OneInt(),
+	)
+	_, err := sh(ctx, msg)
+	require.NoError(t, err)
+
+	// delegate the same amount from a second account and tokenize all of it
+	_, err = sh(ctx, stakingtypes.NewMsgDelegate(addr[1], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens)))
+	require.NoError(t, err)
+
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	shareTokens, err := app.StakingKeeper.TokenizeShares(ctx, addr[1], valAddrs[0], valTokens, owner)
+	require.NoError(t, err)
+
+	// only the record owner can withdraw the record rewards
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, addr[1])
+	require.True(t, types.ErrNoTokenizeShareRecords.Is(err))
+
+	// next block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate some rewards, half of them accrue to the record delegation
+	initial := sdk.TokensFromConsensusPower(10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	balanceBefore := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	require.Equal(t, balanceBefore.Amount.Add(initial.QuoRaw(2)), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount)
+
+	// rewards accrued before a full redemption are paid to the record owner
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, owner, shareTokens)
+	require.NoError(t, err)
+	require.Equal(t, balanceBefore.Amount.Add(initial), app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom).Amount)
+
+	_, err = app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.True(t, types.ErrNoTokenizeShareRecords.Is(err))
+}
+
 func TestGetTotalRewards(t *testing.T) {
 	app := simapp.Setup(false)
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/grpc"
 
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -68,6 +69,9 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx sdkclient.Context, rtr *mux.R
 	rest.RegisterHandlers(clientCtx, rtr)
 }
 
+// RegisterGRPCGatewayRoutes registers no gRPC Gateway routes for the distribution module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ sdkclient.Context, _ *runtime.ServeMux) {}
+
 // GetTxCmd returns the root tx command for the distribution module.
 func (AppModuleBasic) GetTxCmd(clientCtx sdkclient.Context) *cobra.Command {
 	return cli.NewTxCmd(clientCtx)
@@ -231,6 +231,52 @@ func (m *MsgFundCommunityPool) GetDepositor() github_com_cosmos_cosmos_sdk_types
 	return nil
 }
 
+// MsgWithdrawTokenizeShareRecordReward defines a Msg type that allows the owner
+// of tokenize share records to withdraw the rewards of their delegations.
+type MsgWithdrawTokenizeShareRecordReward struct {
+	OwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner_address,omitempty" yaml:"owner_address"`
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) Reset()         { *m = MsgWithdrawTokenizeShareRecordReward{} }
+func (m *MsgWithdrawTokenizeShareRecordReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawTokenizeShareRecordReward) ProtoMessage()    {}
+func (*MsgWithdrawTokenizeShareRecordReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49870d4e3df20cf9, []int{4}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.Merge(m, src)
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawTokenizeShareRecordReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawTokenizeShareRecordReward proto.InternalMessageInfo
+
+func (m *MsgWithdrawTokenizeShareRecordReward) GetOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
 // Params defines the set of distribution parameters.
 type Params struct {
 	CommunityTax        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=community_tax,json=communityTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_tax" yaml:"community_tax"`
@@ -242,7 +288,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{4}
+	return fileDescriptor_49870d4e3df20cf9, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,10 +332,11 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // which might need to reference this historical entry
 // at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and might need to read
-//    that record)
-//  + number of slashes which ended the associated period (and might need to read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and might need to read
+//	  that record)
+//	+ number of slashes which ended the associated period (and might need to read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio" yaml:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty" yaml:"reference_count"`
@@ -299,7 +346,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{5}
+	return fileDescriptor_49870d4e3df20cf9, []int{6}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +401,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{6}
+	return fileDescriptor_49870d4e3df20cf9, []int{7}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +454,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{7}
+	return fileDescriptor_49870d4e3df20cf9, []int{8}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +500,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{8}
+	return fileDescriptor_49870d4e3df20cf9, []int{9}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -502,7 +549,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{9}
+	return fileDescriptor_49870d4e3df20cf9, []int{10}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +593,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{10}
+	return fileDescriptor_49870d4e3df20cf9, []int{11}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +638,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{11}
+	return fileDescriptor_49870d4e3df20cf9, []int{12}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +685,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{12}
+	return fileDescriptor_49870d4e3df20cf9, []int{13}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +731,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_49870d4e3df20cf9, []int{13}
+	return fileDescriptor_49870d4e3df20cf9, []int{14}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,6 +779,7 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegatorReward)(nil), "cosmos.distribution.MsgWithdrawDelegatorReward")
 	proto.RegisterType((*MsgWithdrawValidatorCommission)(nil), "cosmos.distribution.MsgWithdrawValidatorCommission")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.MsgFundCommunityPool")
+	proto.RegisterType((*MsgWithdrawTokenizeShareRecordReward)(nil), "cosmos.distribution.MsgWithdrawTokenizeShareRecordReward")
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.ValidatorCurrentRewards")
@@ -749,76 +797,79 @@ func init() {
 }
 
 var fileDescriptor_49870d4e3df20cf9 = []byte{
-	// 1098 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x38, 0x6e, 0x9a, 0x4c, 0xd3, 0xa4, 0xdd, 0xd8, 0x49, 0xe4, 0x80, 0x37, 0x1a, 0x89,
-	0x2a, 0x12, 0x8a, 0x43, 0xe9, 0x2d, 0x07, 0xa4, 0x38, 0x1f, 0x02, 0xd4, 0x90, 0x68, 0x13, 0x82,
-	0xc4, 0x01, 0x6b, 0xbc, 0x3b, 0x71, 0x46, 0x59, 0xef, 0xac, 0x66, 0xc6, 0xf9, 0xe8, 0x05, 0xa9,
-	0xe2, 0xeb, 0x80, 0x44, 0x91, 0x38, 0x70, 0x40, 0xa8, 0x07, 0x0e, 0xd0, 0x7f, 0x82, 0x6b, 0x8f,
-	0xbd, 0x81, 0x38, 0xb8, 0x28, 0xb9, 0x71, 0xcc, 0x0d, 0x4e, 0x68, 0x67, 0x66, 0x3f, 0xec, 0xb8,
-	0x10, 0x47, 0x15, 0xdc, 0x3c, 0x6f, 0xde, 0xfc, 0x7e, 0xbf, 0x79, 0xef, 0xcd, 0x7b, 0x6b, 0x78,
-	0xc7, 0x65, 0xa2, 0xc5, 0xc4, 0xa2, 0x47, 0x85, 0xe4, 0xb4, 0xd1, 0x96, 0x94, 0x05, 0x5d, 0x8b,
-	0x6a, 0xc8, 0x99, 0x64, 0xd6, 0xa4, 0xf6, 0xab, 0x66, 0xb7, 0xca, 0xc5, 0x26, 0x6b, 0x32, 0xb5,
-	0xbf, 0x18, 0xfd, 0xd2, 0xae, 0x65, 0xe3, 0xba, 0x68, 0x4e, 0x28, 0x23, 0xfa, 0x32, 0x0f, 0x4b,
-	0x1b, 0xa2, 0xb9, 0x4d, 0xe4, 0x07, 0x54, 0xee, 0x7b, 0x1c, 0x1f, 0x2d, 0x7b, 0x1e, 0x27, 0x42,
-	0x58, 0x0f, 0xe0, 0x6d, 0x8f, 0xf8, 0xa4, 0x89, 0x25, 0xe3, 0x75, 0xac, 0x8d, 0x33, 0x60, 0x0e,
-	0xcc, 0x8f, 0xd5, 0x36, 0xce, 0x3b, 0xf6, 0xcc, 0x09, 0x6e, 0xf9, 0x4b, 0xe8, 0x82, 0x0b, 0xfa,
-	0xab, 0x63, 0x2f, 0x34, 0xa9, 0xdc, 0x6f, 0x37, 0xaa, 0x2e, 0x6b, 0x2d, 0x76, 0x91, 0x2e, 0x08,
-	0xef, 0x60, 0x51, 0x9e, 0x84, 0x44, 0x54, 0x97, 0x5d, 0xd7, 0x30, 0x39, 0xb7, 0x12, 0x90, 0x98,
-	0xfb, 0x08, 0xde, 0x3a, 0x32, 0x72, 0x12, 0xea, 0xbc, 0xa2, 0xbe, 0x7f, 0xde, 0xb1, 0xa7, 0x35,
-	0x75, 0xaf, 0xc7, 0x15, 0x98, 0x27, 0x8e, 0xba, 0x2f, 0x8d, 0xbe, 0xc9, 0xc3, 0xf2, 0x86, 0x68,
-	0xc6, 0xb1, 0x58, 0x8d, 0x85, 0x39, 0xe4, 0x08, 0x73, 0xef, 0x7f, 0x8d, 0xc9, 0x03, 0x78, 0xfb,
-	0x10, 0xfb, 0xd4, 0xeb, 0xe2, 0xce, 0xf7, 0x72, 0x5f, 0x70, 0xb9, 0x2c, 0xf7, 0x2e, 0xf6, 0x13,
-	0xee, 0x04, 0x24, 0x0e, 0xcb, 0x77, 0x00, 0x56, 0x32, 0x61, 0xd9, 0x8d, 0xf7, 0x57, 0x58, 0xab,
-	0x45, 0x85, 0xa0, 0x2c, 0xe8, 0x2f, 0x0f, 0xfc, 0x37, 0xf2, 0x7e, 0x06, 0xb0, 0xb8, 0x21, 0x9a,
-	0xeb, 0xed, 0xc0, 0x8b, 0x14, 0xb5, 0x03, 0x2a, 0x4f, 0xb6, 0x18, 0xf3, 0xad, 0x5d, 0x38, 0x8c,
-	0x5b, 0xac, 0x1d, 0xc8, 0x19, 0x30, 0x37, 0x34, 0x7f, 0xe3, 0xcd, 0xb1, 0xaa, 0x29, 0xfe, 0x15,
-	0x46, 0x83, 0xda, 0x1b, 0x4f, 0x3b, 0x76, 0xee, 0xc9, 0x73, 0x7b, 0xfe, 0x12, 0xfc, 0xd1, 0x01,
-	0xe1, 0x18, 0x34, 0x6b, 0x13, 0x8e, 0x7a, 0x24, 0x64, 0x82, 0x4a, 0xc6, 0x4d, 0x0e, 0xee, 0x0e,
-	0x9e, 0xe3, 0x14, 0x03, 0xfd, 0x32, 0x04, 0x87, 0xb7, 0x30, 0xc7, 0x2d, 0x61, 0x1d, 0xc0, 0x9b,
-	0x6e, 0x7c, 0x89, 0xba, 0xc4, 0xc7, 0x2a, 0x88, 0xa3, 0xb5, 0xf5, 0x48, 0xec, 0x6f, 0x1d, 0xfb,
-	0xce, 0x25, 0x38, 0x56, 0x89, 0x7b, 0xde, 0xb1, 0x8b, 0x3a, 0xe4, 0x5d, 0x60, 0xc8, 0x19, 0x4b,
-	0xd6, 0x3b, 0xf8, 0xd8, 0xfa, 0x18, 0x16, 0x1b, 0x58, 0x90, 0x7a, 0xc8, 0x59, 0xc8, 0x04, 0xe1,
-	0x75, 0xae, 0x0a, 0x5d, 0xdd, 0x69, 0xb4, 0xb6, 0x31, 0x30, 0xe7, 0xac, 0xe6, 0xec, 0x87, 0x89,
-	0x1c, 0x2b, 0x32, 0x6f, 0x19, 0xab, 0x79, 0x51, 0x0f, 0x01, 0x2c, 0x35, 0x58, 0xd0, 0x16, 0x17,
-	0x24, 0x0c, 0x29, 0x09, 0xef, 0x0d, 0x2c, 0xe1, 0x15, 0x23, 0xa1, 0x1f, 0x28, 0x72, 0x26, 0x95,
-	0xbd, 0x47, 0xc4, 0x0e, 0x2c, 0x75, 0x35, 0x93, 0x3a, 0x09, 0x70, 0xc3, 0x27, 0xde, 0x4c, 0x61,
-	0x0e, 0xcc, 0x8f, 0xd4, 0xe6, 0x52, 0xd4, 0xbe, 0x6e, 0xc8, 0x99, 0xcc, 0xf6, 0x91, 0x35, 0x6d,
-	0x5d, 0x2a, 0x7c, 0xfb, 0xd8, 0xce, 0xa1, 0x87, 0x79, 0x58, 0x4e, 0xde, 0xcb, 0xdb, 0x54, 0x48,
-	0xc6, 0xa9, 0x8b, 0x7d, 0xcd, 0x2c, 0xac, 0xef, 0x01, 0x9c, 0x76, 0xdb, 0xad, 0xb6, 0x8f, 0x25,
-	0x3d, 0x24, 0x46, 0x66, 0x9d, 0x63, 0x49, 0x99, 0xa9, 0xd9, 0x89, 0xb8, 0x66, 0x57, 0x89, 0xab,
-	0xca, 0xf6, 0xfd, 0x28, 0x24, 0xe7, 0x1d, 0xbb, 0x62, 0xf2, 0xdb, 0xff, 0x34, 0x7a, 0xf2, 0xdc,
-	0x7e, 0xfd, 0x72, 0x41, 0xd3, 0xb5, 0x5d, 0x4a, 0x81, 0xb4, 0x38, 0x27, 0x82, 0xb1, 0x56, 0xe0,
-	0x04, 0x27, 0x7b, 0x84, 0x93, 0xc0, 0x25, 0x75, 0x57, 0xbd, 0xa5, 0xa8, 0x38, 0x6e, 0xd6, 0xca,
-	0xe7, 0x1d, 0x7b, 0x4a, 0x4b, 0xe8, 0x71, 0x40, 0xce, 0x78, 0x62, 0x59, 0x51, 0x86, 0xaf, 0x01,
-	0x9c, 0x4e, 0x9b, 0x46, 0x9b, 0x73, 0x12, 0xc8, 0x38, 0x02, 0x1f, 0xc1, 0xeb, 0x5a, 0xb7, 0x78,
-	0xd1, 0x85, 0xef, 0x99, 0x77, 0x3a, 0xd0, 0x75, 0x62, 0x50, 0x6b, 0x0a, 0x0e, 0x87, 0x84, 0x53,
-	0xa6, 0x8b, 0xba, 0xe0, 0x98, 0x15, 0xfa, 0x0c, 0xc0, 0x4a, 0xa2, 0x69, 0xd9, 0x35, 0xb7, 0x27,
-	0x5e, 0xa6, 0xa7, 0x79, 0x10, 0xba, 0xc9, 0xea, 0xa5, 0xaa, 0xcb, 0xe0, 0xa2, 0xaf, 0x00, 0x9c,
-	0x4d, 0x84, 0x6c, 0xb6, 0xa5, 0x90, 0x38, 0xf0, 0x68, 0xd0, 0x8c, 0x03, 0x14, 0xfe, 0x6b, 0x80,
-	0xd6, 0x4c, 0x45, 0x8c, 0xc7, 0xe9, 0x50, 0xde, 0xe8, 0xaa, 0x21, 0x43, 0x3f, 0x01, 0x38, 0x99,
-	0x28, 0xda, 0xf6, 0xb1, 0xd8, 0x5f, 0x3b, 0x24, 0x81, 0xb4, 0xd6, 0x61, 0xda, 0x7b, 0xeb, 0x26,
-	0xa8, 0x51, 0x77, 0x2a, 0xd4, 0x66, 0xd3, 0xb1, 0xdc, 0xeb, 0x81, 0x9c, 0x89, 0xc4, 0xb4, 0xa5,
-	0x2c, 0xd6, 0xbb, 0x70, 0x64, 0x8f, 0x63, 0x37, 0xfa, 0x56, 0x31, 0x9d, 0xa6, 0x3a, 0xd8, 0x33,
-	0x77, 0x92, 0xf3, 0xe8, 0x07, 0x00, 0x8b, 0x7d, 0xb4, 0x0a, 0xeb, 0x53, 0x00, 0xa7, 0x52, 0x2d,
-	0x22, 0xda, 0xa9, 0x13, 0xb5, 0x65, 0xc2, 0x38, 0x5f, 0xed, 0xf3, 0xed, 0x54, 0xed, 0x83, 0x55,
-	0x7b, 0xcd, 0xc4, 0xf7, 0xd5, 0xde, 0x1b, 0x66, 0x51, 0x91, 0x53, 0x3c, 0xec, 0xa3, 0xc3, 0xb4,
-	0x81, 0x47, 0x00, 0x5e, 0x5f, 0x27, 0x44, 0x4d, 0xa5, 0x4f, 0x00, 0x1c, 0x4f, 0xbb, 0x72, 0xc8,
-	0x98, 0xff, 0xa2, 0xc4, 0xde, 0x37, 0xc4, 0xa5, 0xde, 0x56, 0x1e, 0x1d, 0x1a, 0x38, 0xbf, 0xe9,
-	0x5c, 0x89, 0x64, 0xa0, 0xcf, 0xf3, 0xb0, 0xdc, 0x35, 0x2e, 0xb7, 0x43, 0x12, 0x78, 0xba, 0x35,
-	0x62, 0xdf, 0x2a, 0xc2, 0x6b, 0x92, 0x4a, 0x9f, 0xe8, 0xf9, 0xe3, 0xe8, 0x85, 0x35, 0x07, 0x6f,
-	0x78, 0x44, 0xb8, 0x9c, 0x86, 0x69, 0xf6, 0x9c, 0xac, 0x29, 0x9a, 0x8d, 0x9c, 0xb8, 0x34, 0xa4,
-	0x24, 0x90, 0xaa, 0x89, 0x5f, 0x6d, 0x36, 0x26, 0x18, 0x99, 0x21, 0x5e, 0x78, 0x99, 0x43, 0x7c,
-	0x69, 0xe4, 0x8b, 0xc7, 0x76, 0x4e, 0x25, 0xe7, 0x4f, 0x00, 0x4b, 0xc9, 0xa7, 0xde, 0xb6, 0xc4,
-	0x5c, 0xd2, 0xa0, 0xf9, 0x4e, 0xb0, 0xa7, 0xba, 0x5f, 0xc8, 0xc9, 0x21, 0x65, 0xd1, 0x2c, 0xc9,
-	0x16, 0x7c, 0xa6, 0xfb, 0xf5, 0x38, 0x20, 0x67, 0x3c, 0xb6, 0x98, 0x72, 0xdf, 0x81, 0xd7, 0x84,
-	0xc4, 0x07, 0xc4, 0xd4, 0xfa, 0x5b, 0x03, 0x8f, 0xb4, 0x31, 0x4d, 0xa4, 0x40, 0x90, 0xa3, 0xc1,
-	0xac, 0x35, 0x38, 0xbc, 0x4f, 0x68, 0x73, 0x5f, 0x07, 0xb9, 0x50, 0x5b, 0xf8, 0xa3, 0x63, 0x4f,
-	0xb8, 0x9c, 0x44, 0x5d, 0x3b, 0xa8, 0xeb, 0xad, 0x54, 0x64, 0xcf, 0x06, 0x72, 0xcc, 0xe1, 0xda,
-	0xe6, 0x8f, 0xa7, 0x15, 0xf0, 0xf4, 0xb4, 0x02, 0x9e, 0x9d, 0x56, 0xc0, 0xef, 0xa7, 0x15, 0xf0,
-	0xe8, 0xac, 0x92, 0x7b, 0x76, 0x56, 0xc9, 0xfd, 0x7a, 0x56, 0xc9, 0x7d, 0x78, 0xf7, 0x1f, 0x35,
-	0x1e, 0x77, 0xff, 0x3d, 0x51, 0x92, 0x1b, 0xc3, 0xea, 0x8f, 0xc5, 0xbd, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xda, 0x06, 0xab, 0xc7, 0xc2, 0x0c, 0x00, 0x00,
+	// 1138 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xf6, 0x38, 0x6e, 0x9a, 0x4c, 0xf3, 0xd1, 0x6e, 0xec, 0x24, 0x72, 0xc0, 0x1b, 0x8d, 0xa0,
+	0x8a, 0x84, 0xe2, 0x50, 0x7a, 0xcb, 0x01, 0x29, 0xce, 0x87, 0x28, 0x6a, 0x48, 0xb4, 0x09, 0x41,
+	0xe2, 0x80, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xac, 0x77, 0x56, 0x33, 0xe3, 0x38, 0xc9, 0x05, 0xa9,
+	0xe2, 0xeb, 0x80, 0x44, 0x91, 0x10, 0xe2, 0x80, 0x50, 0x0f, 0x1c, 0xa0, 0x7f, 0x82, 0x6b, 0x8f,
+	0xbd, 0x81, 0x38, 0xb8, 0x28, 0xb9, 0x71, 0xcc, 0x0d, 0x4e, 0x68, 0x67, 0x67, 0x3f, 0xec, 0xb8,
+	0x10, 0x47, 0x15, 0xbd, 0x79, 0xdf, 0x79, 0xe7, 0x79, 0x9e, 0x79, 0x66, 0xe6, 0x7d, 0xc7, 0xf0,
+	0xb6, 0xcd, 0x44, 0x93, 0x89, 0x25, 0x87, 0x0a, 0xc9, 0x69, 0xad, 0x25, 0x29, 0xf3, 0xba, 0x3e,
+	0xca, 0x3e, 0x67, 0x92, 0x19, 0x53, 0x61, 0x5e, 0x39, 0x3d, 0x54, 0xcc, 0xd7, 0x59, 0x9d, 0xa9,
+	0xf1, 0xa5, 0xe0, 0x57, 0x98, 0x5a, 0xd4, 0xa9, 0x4b, 0x7a, 0x86, 0x0a, 0xa2, 0x2f, 0xb3, 0xb0,
+	0xb0, 0x29, 0xea, 0x3b, 0x44, 0x7e, 0x40, 0x65, 0xc3, 0xe1, 0xb8, 0xbd, 0xe2, 0x38, 0x9c, 0x08,
+	0x61, 0x9c, 0xc0, 0x5b, 0x0e, 0x71, 0x49, 0x1d, 0x4b, 0xc6, 0xab, 0x38, 0x0c, 0xce, 0x82, 0x79,
+	0xb0, 0x30, 0x56, 0xd9, 0x3c, 0xef, 0x98, 0xb3, 0xc7, 0xb8, 0xe9, 0x2e, 0xa3, 0x0b, 0x29, 0xe8,
+	0xef, 0x8e, 0xb9, 0x58, 0xa7, 0xb2, 0xd1, 0xaa, 0x95, 0x6d, 0xd6, 0x5c, 0xea, 0x22, 0x5d, 0x14,
+	0xce, 0xc1, 0x92, 0x3c, 0xf6, 0x89, 0x28, 0xaf, 0xd8, 0xb6, 0x66, 0xb2, 0x6e, 0xc6, 0x20, 0x11,
+	0x77, 0x1b, 0xde, 0x6c, 0x6b, 0x39, 0x31, 0x75, 0x56, 0x51, 0xdf, 0x3f, 0xef, 0x98, 0x33, 0x21,
+	0x75, 0x6f, 0xc6, 0x15, 0x98, 0x27, 0xdb, 0xdd, 0x8b, 0x46, 0xdf, 0x64, 0x61, 0x71, 0x53, 0xd4,
+	0x23, 0x2f, 0xd6, 0x22, 0x61, 0x16, 0x69, 0x63, 0xee, 0xbc, 0x54, 0x4f, 0x4e, 0xe0, 0xad, 0x43,
+	0xec, 0x52, 0xa7, 0x8b, 0x3b, 0xdb, 0xcb, 0x7d, 0x21, 0xe5, 0xb2, 0xdc, 0x7b, 0xd8, 0x8d, 0xb9,
+	0x63, 0x90, 0xc8, 0x96, 0xef, 0x01, 0x2c, 0xa5, 0x6c, 0xd9, 0x8b, 0xc6, 0x57, 0x59, 0xb3, 0x49,
+	0x85, 0xa0, 0xcc, 0xeb, 0x2f, 0x0f, 0xfc, 0x3f, 0xf2, 0x7e, 0x01, 0x30, 0xbf, 0x29, 0xea, 0x1b,
+	0x2d, 0xcf, 0x09, 0x14, 0xb5, 0x3c, 0x2a, 0x8f, 0xb7, 0x19, 0x73, 0x8d, 0x3d, 0x38, 0x8c, 0x9b,
+	0xac, 0xe5, 0xc9, 0x59, 0x30, 0x3f, 0xb4, 0x70, 0xe3, 0xad, 0xb1, 0xb2, 0x3e, 0xfc, 0xab, 0x8c,
+	0x7a, 0x95, 0x37, 0x9f, 0x74, 0xcc, 0xcc, 0xe3, 0x67, 0xe6, 0xc2, 0x25, 0xf8, 0x83, 0x09, 0xc2,
+	0xd2, 0x68, 0xc6, 0x16, 0x1c, 0x75, 0x88, 0xcf, 0x04, 0x95, 0x8c, 0xeb, 0x3d, 0xb8, 0x33, 0xf8,
+	0x1e, 0x27, 0x18, 0xe8, 0x5b, 0x00, 0x5f, 0x4b, 0x19, 0xbc, 0xcb, 0x0e, 0x88, 0x47, 0x4f, 0xc8,
+	0x4e, 0x03, 0x73, 0x62, 0x11, 0x9b, 0x71, 0x47, 0x9f, 0x40, 0x0f, 0x8e, 0xb3, 0xb6, 0x47, 0x7a,
+	0x2d, 0xbe, 0x77, 0xde, 0x31, 0xf3, 0xa1, 0xc5, 0x5d, 0xc3, 0x57, 0x38, 0x79, 0x63, 0x0a, 0x20,
+	0xb2, 0xf6, 0xd7, 0x21, 0x38, 0xbc, 0x8d, 0x39, 0x6e, 0x0a, 0xe3, 0x00, 0x8e, 0xdb, 0x91, 0xbb,
+	0x55, 0x89, 0x8f, 0x14, 0xf5, 0x68, 0x65, 0x23, 0x70, 0xf1, 0xf7, 0x8e, 0x79, 0xfb, 0x12, 0x34,
+	0x6b, 0xc4, 0x4e, 0x84, 0x76, 0x81, 0x21, 0x6b, 0x2c, 0xfe, 0xde, 0xc5, 0x47, 0xc6, 0xc7, 0x30,
+	0x5f, 0xc3, 0x82, 0x54, 0x7d, 0xce, 0x7c, 0x26, 0x08, 0xaf, 0x72, 0xb5, 0x7e, 0x65, 0xf6, 0x68,
+	0x65, 0x73, 0x60, 0xce, 0xb9, 0x90, 0xb3, 0x1f, 0x26, 0xb2, 0x8c, 0x20, 0xbc, 0xad, 0xa3, 0xda,
+	0xe8, 0x07, 0x00, 0x16, 0x6a, 0xcc, 0x6b, 0x89, 0x0b, 0x12, 0x86, 0x94, 0x84, 0xf7, 0x06, 0x96,
+	0xf0, 0x8a, 0x96, 0xd0, 0x0f, 0x14, 0x59, 0x53, 0x2a, 0xde, 0x23, 0x62, 0x17, 0x16, 0xba, 0xaa,
+	0x5c, 0x95, 0x78, 0xb8, 0xe6, 0x12, 0x67, 0x36, 0x37, 0x0f, 0x16, 0x46, 0x2a, 0xf3, 0x09, 0x6a,
+	0xdf, 0x34, 0x64, 0x4d, 0xa5, 0x0b, 0xdc, 0x7a, 0x18, 0x5d, 0xce, 0x7d, 0xf7, 0xc8, 0xcc, 0xa0,
+	0x07, 0x59, 0x58, 0x8c, 0x2f, 0xf2, 0x3b, 0x54, 0x48, 0xc6, 0xa9, 0x8d, 0xdd, 0x90, 0x59, 0x18,
+	0x3f, 0x00, 0x38, 0x63, 0xb7, 0x9a, 0x2d, 0x17, 0x4b, 0x7a, 0x48, 0xb4, 0xcc, 0x2a, 0xc7, 0x92,
+	0x32, 0x7d, 0x99, 0x26, 0xa3, 0xcb, 0xb4, 0x46, 0x6c, 0x75, 0x9f, 0xde, 0x0f, 0x2c, 0x39, 0xef,
+	0x98, 0x25, 0xbd, 0xbf, 0xfd, 0x67, 0xa3, 0xc7, 0xcf, 0xcc, 0x37, 0x2e, 0x67, 0x5a, 0x78, 0xe9,
+	0x0a, 0x09, 0x50, 0x28, 0xce, 0x0a, 0x60, 0x8c, 0x55, 0x38, 0xc9, 0xc9, 0x3e, 0xe1, 0xc4, 0xb3,
+	0x49, 0xd5, 0x56, 0x97, 0x3c, 0x38, 0x1c, 0xe3, 0x95, 0xe2, 0x79, 0xc7, 0x9c, 0x0e, 0x25, 0xf4,
+	0x24, 0x20, 0x6b, 0x22, 0x8e, 0xac, 0xaa, 0xc0, 0xd7, 0x00, 0xce, 0x24, 0xd5, 0xac, 0xc5, 0x39,
+	0xf1, 0x64, 0xe4, 0xc0, 0x47, 0xf0, 0x7a, 0xa8, 0x5b, 0x3c, 0x6f, 0xc1, 0x77, 0x75, 0x01, 0x19,
+	0x68, 0x39, 0x11, 0xa8, 0x31, 0x0d, 0x87, 0x7d, 0xc2, 0x29, 0x0b, 0x0f, 0x75, 0xce, 0xd2, 0x5f,
+	0xe8, 0x33, 0x00, 0x4b, 0xb1, 0xa6, 0x15, 0x5b, 0xaf, 0x9e, 0x38, 0xa9, 0x62, 0xeb, 0x40, 0x68,
+	0xc7, 0x5f, 0x2f, 0x54, 0x5d, 0x0a, 0x17, 0x7d, 0x05, 0xe0, 0x5c, 0x2c, 0x64, 0xab, 0x25, 0x85,
+	0xc4, 0x9e, 0x43, 0xbd, 0x7a, 0x64, 0x90, 0xff, 0x9f, 0x06, 0xad, 0xeb, 0x13, 0x31, 0x11, 0x6d,
+	0x87, 0xca, 0x46, 0x57, 0xb5, 0x0c, 0xfd, 0x0c, 0xe0, 0x54, 0xac, 0x68, 0xc7, 0xc5, 0xa2, 0xb1,
+	0x7e, 0x48, 0x3c, 0x69, 0x6c, 0xc0, 0xa4, 0x29, 0x54, 0xb5, 0xa9, 0x41, 0x75, 0xca, 0x55, 0xe6,
+	0x92, 0xf7, 0x42, 0x6f, 0x06, 0xb2, 0x26, 0xe3, 0xd0, 0xb6, 0x8a, 0x18, 0xef, 0xc2, 0x91, 0x7d,
+	0x8e, 0xed, 0xe0, 0x11, 0xa5, 0x2b, 0x4d, 0x79, 0xb0, 0x6b, 0x6e, 0xc5, 0xf3, 0xd1, 0x8f, 0x00,
+	0xe6, 0xfb, 0x68, 0x15, 0xc6, 0xa7, 0x00, 0x4e, 0x27, 0x5a, 0x44, 0x30, 0x52, 0x25, 0x6a, 0x48,
+	0xdb, 0xb8, 0x50, 0xee, 0xf3, 0xa8, 0x2b, 0xf7, 0xc1, 0xaa, 0xbc, 0xae, 0xfd, 0x7d, 0xb5, 0x77,
+	0x85, 0x69, 0x54, 0x64, 0xe5, 0x0f, 0xfb, 0xe8, 0xd0, 0x65, 0xe0, 0x21, 0x80, 0xd7, 0x37, 0x08,
+	0x51, 0xed, 0xf2, 0x13, 0x00, 0x27, 0x92, 0xaa, 0xec, 0x33, 0xe6, 0x3e, 0x6f, 0x63, 0xef, 0x6b,
+	0xe2, 0x42, 0x6f, 0x29, 0x0f, 0x26, 0x0d, 0xbc, 0xbf, 0x49, 0x5f, 0x09, 0x64, 0xa0, 0xcf, 0xb3,
+	0xb0, 0xd8, 0xd5, 0xc7, 0x77, 0x7c, 0xe2, 0x39, 0x61, 0x69, 0xc4, 0xae, 0x91, 0x87, 0xd7, 0x24,
+	0x95, 0x2e, 0x09, 0xfb, 0x8f, 0x15, 0x7e, 0x18, 0xf3, 0xf0, 0x86, 0x43, 0x84, 0xcd, 0xa9, 0x9f,
+	0xec, 0x9e, 0x95, 0x0e, 0x05, 0x4d, 0x9b, 0x13, 0x9b, 0xfa, 0x94, 0x78, 0x72, 0x76, 0xe8, 0xca,
+	0x4d, 0x3b, 0xc6, 0x48, 0xbd, 0x2e, 0x72, 0x2f, 0xf2, 0x75, 0xb1, 0x3c, 0xf2, 0xc5, 0x23, 0x33,
+	0xa3, 0x36, 0xe7, 0x2f, 0x00, 0x0b, 0xf1, 0x1b, 0x74, 0x47, 0x62, 0x2e, 0xa9, 0x57, 0xbf, 0xe7,
+	0xed, 0xab, 0xea, 0xe7, 0x73, 0x72, 0x48, 0x59, 0xd0, 0x4b, 0xd2, 0x07, 0x3e, 0x55, 0xfd, 0x7a,
+	0x12, 0x90, 0x35, 0x11, 0x45, 0xf4, 0x71, 0xdf, 0x85, 0xd7, 0x84, 0xc4, 0x07, 0x44, 0x9f, 0xf5,
+	0xb7, 0x07, 0x6e, 0x69, 0x63, 0x21, 0x91, 0x02, 0x41, 0x56, 0x08, 0x66, 0xac, 0xc3, 0xe1, 0x06,
+	0xa1, 0xf5, 0x46, 0x68, 0x72, 0xae, 0xb2, 0xf8, 0x67, 0xc7, 0x9c, 0xb4, 0x39, 0x09, 0xaa, 0xb6,
+	0x57, 0x0d, 0x87, 0x12, 0x91, 0x3d, 0x03, 0xc8, 0xd2, 0x93, 0x2b, 0x5b, 0x3f, 0x9d, 0x96, 0xc0,
+	0x93, 0xd3, 0x12, 0x78, 0x7a, 0x5a, 0x02, 0x7f, 0x9c, 0x96, 0xc0, 0xc3, 0xb3, 0x52, 0xe6, 0xe9,
+	0x59, 0x29, 0xf3, 0xdb, 0x59, 0x29, 0xf3, 0xe1, 0x9d, 0x7f, 0xd5, 0x78, 0xd4, 0xfd, 0xbf, 0x49,
+	0x49, 0xae, 0x0d, 0xab, 0x7f, 0x3c, 0x77, 0xff, 0x19, 0x00, 0x8d, 0x68, 0xb8, 0x90, 0x5b, 0x0d,
+	0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddress) Equal(that interface{}) bool {
@@ -931,6 +982,30 @@ func (this *MsgFundCommunityPool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgWithdrawTokenizeShareRecordReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgWithdrawTokenizeShareRecordReward)
+	if !ok {
+		that2, ok := that.(MsgWithdrawTokenizeShareRecordReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1387,6 +1462,36 @@ func (m *MsgFundCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTokenizeShareRecordReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1898,6 +2003,19 @@ func (m *MsgFundCommunityPool) Size() (n int) {
 	return n
 }
 
+func (m *MsgWithdrawTokenizeShareRecordReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2526,6 +2644,93 @@ func (m *MsgFundCommunityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgWithdrawTokenizeShareRecordReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTokenizeShareRecordReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
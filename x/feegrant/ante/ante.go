@@ -0,0 +1,36 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+	ibcante "github.com/cosmos/cosmos-sdk/x/ibc/ante"
+	ibckeeper "github.com/cosmos/cosmos-sdk/x/ibc/keeper"
+)
+
+// NewAnteHandler returns an AnteHandler that checks and increments sequence
+// numbers, checks signatures & account numbers, and deducts fees from the
+// fee granter of the tx if one is set, or from the first signer otherwise.
+//
+// It mirrors the x/auth AnteHandler, replacing its DeductFeeDecorator with a
+// DeductGrantedFeeDecorator.
+func NewAnteHandler(
+	ak authante.AccountKeeper, bankKeeper types.BankKeeper, feeGrantKeeper keeper.Keeper,
+	ibcKeeper ibckeeper.Keeper, sigGasConsumer authante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		authante.NewValidateMemoDecorator(ak),
+		authante.NewConsumeGasForTxSizeDecorator(ak),
+		authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewValidateSigCountDecorator(ak),
+		NewDeductGrantedFeeDecorator(ak, bankKeeper, feeGrantKeeper),
+		authante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		authante.NewSigVerificationDecorator(ak),
+		authante.NewIncrementSequenceDecorator(ak),
+		ibcante.NewProofVerificationDecorator(ibcKeeper.ClientKeeper, ibcKeeper.ChannelKeeper), // innermost AnteDecorator
+	)
+}
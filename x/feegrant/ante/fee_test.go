@@ -0,0 +1,139 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/ante"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestDeductFeesNoDelegation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	anteHandler := ante.NewAnteHandler(
+		app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, *app.IBCKeeper,
+		authante.DefaultSigVerificationGasConsumer,
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	priv3, _, addr3 := authtypes.KeyTestPubAddr()
+	priv4, _, addr4 := authtypes.KeyTestPubAddr()
+
+	nonExistentPriv, _, nonExistentAddr := authtypes.KeyTestPubAddr()
+
+	// set accounts and balances
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3, addr4} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 100000))))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+	// addr3 has no funds and relies on grants from addr2
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr4, sdk.NewCoins(sdk.NewInt64Coin("atom", 99999))))
+
+	// addr2 grants 500atom to addr3, addr4 grants 20atom to addr3
+	require.NoError(t, app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr2, addr3, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 500)),
+	}))
+	require.NoError(t, app.FeeGrantKeeper.GrantFeeAllowance(ctx, addr4, addr3, &types.BasicFeeAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 20)),
+	}))
+
+	cases := map[string]struct {
+		signerKey  crypto.PrivKey
+		signer     sdk.AccAddress
+		feeAccount sdk.AccAddress
+		fee        int64
+		valid      bool
+	}{
+		"paying with good funds": {
+			signerKey: priv1,
+			signer:    addr1,
+			fee:       50,
+			valid:     true,
+		},
+		"paying with no account": {
+			signerKey: priv3,
+			signer:    addr3,
+			fee:       1,
+			valid:     false,
+		},
+		"no fee with real account": {
+			signerKey: priv1,
+			signer:    addr1,
+			fee:       0,
+			valid:     true,
+		},
+		"no fee with no account": {
+			signerKey: nonExistentPriv,
+			signer:    nonExistentAddr,
+			fee:       0,
+			valid:     false,
+		},
+		"valid fee grant": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: addr2,
+			fee:        50,
+			valid:      true,
+		},
+		"no fee grant": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: addr1,
+			fee:        2,
+			valid:      false,
+		},
+		"allowance smaller than requested fee": {
+			signerKey:  priv3,
+			signer:     addr3,
+			feeAccount: addr4,
+			fee:        50,
+			valid:      false,
+		},
+		"fee grant to a different grantee": {
+			signerKey:  priv4,
+			signer:     addr4,
+			feeAccount: addr2,
+			fee:        50,
+			valid:      false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			acc := app.AccountKeeper.GetAccount(cacheCtx, tc.signer)
+			accNum, seq := uint64(0), uint64(0)
+			if acc != nil {
+				accNum, seq = acc.GetAccountNumber(), acc.GetSequence()
+			}
+
+			fee := authtypes.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			fee.Granter = tc.feeAccount
+			msgs := []sdk.Msg{authtypes.NewTestMsg(tc.signer)}
+			tx := authtypes.NewTestTx(cacheCtx, msgs, []crypto.PrivKey{tc.signerKey}, []uint64{accNum}, []uint64{seq}, fee)
+
+			_, err := anteHandler(cacheCtx, tx, false)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	feegrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feegrant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantQueryCmd.AddCommand(
+		GetCmdQueryFeeGrant(clientCtx),
+		GetCmdQueryFeeGrants(clientCtx),
+	)
+
+	return feegrantQueryCmd
+}
+
+// GetCmdQueryFeeGrant returns the command to query a single fee grant.
+func GetCmdQueryFeeGrant(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter] [grantee]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query details of a single grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details for a grant.
+You can find the fee-grant of a granter and grantee.
+
+Example:
+$ %s query %s grant [granter] [grantee]
+`, version.ClientName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeAllowance(
+				context.Background(),
+				&types.QueryFeeAllowanceRequest{
+					Granter: granterAddr,
+					Grantee: granteeAddr,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.FeeAllowance)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdQueryFeeGrants returns the command to query all the fee grants of a
+// grantee.
+func GetCmdQueryFeeGrants(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants [grantee]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all grants of a grantee",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queries all the grants for a grantee address.
+
+Example:
+$ %s query %s grants [grantee]
+`, version.ClientName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FeeAllowances(
+				context.Background(),
+				&types.QueryFeeAllowancesRequest{
+					Grantee: granteeAddr,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.FeeAllowances)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// flag for feegrant module
+const (
+	FlagSpendLimit       = "spend-limit"
+	FlagExpiration       = "expiration"
+	FlagExpirationHeight = "expiration-height"
+	FlagPeriod           = "period"
+	FlagPeriodLimit      = "period-limit"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/feegrant transaction commands.
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	feegrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Feegrant transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feegrantTxCmd.AddCommand(
+		NewCmdFeeGrant(clientCtx),
+		NewCmdRevokeFeegrant(clientCtx),
+	)
+
+	return feegrantTxCmd
+}
+
+// NewCmdFeeGrant returns a CLI command handler for creating a MsgGrantFeeAllowance transaction.
+func NewCmdFeeGrant(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [granter_key_or_address] [grantee]",
+		Short: "Grant Fee allowance to an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant authorization to pay fees from your address. Note, the '--from' flag is
+ignored as it is implied from [granter].
+
+Examples:
+$ %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z
+$ %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			limit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			basic := types.BasicFeeAllowance{
+				SpendLimit: limit,
+			}
+
+			switch exp, expHeight := viper.GetString(FlagExpiration), viper.GetInt64(FlagExpirationHeight); {
+			case exp != "" && expHeight != 0:
+				return fmt.Errorf("only one of --%s and --%s may be set", FlagExpiration, FlagExpirationHeight)
+
+			case exp != "":
+				expiresAt, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				basic.Expiration = types.ExpiresAtTime(expiresAt)
+
+			case expHeight != 0:
+				basic.Expiration = types.ExpiresAtHeight(expHeight)
+			}
+
+			var grant types.FeeAllowanceI = &basic
+
+			periodClock := viper.GetInt64(FlagPeriod)
+			periodLimitVal := viper.GetString(FlagPeriodLimit)
+
+			// if only one of the period flags is set, the allowance is invalid
+			if periodClock > 0 || periodLimitVal != "" {
+				if periodClock <= 0 || periodLimitVal == "" {
+					return fmt.Errorf("both --%s and --%s must be set for a periodic allowance", FlagPeriod, FlagPeriodLimit)
+				}
+
+				periodLimit, err := sdk.ParseCoins(periodLimitVal)
+				if err != nil {
+					return err
+				}
+
+				grant = &types.PeriodicFeeAllowance{
+					Basic:            basic,
+					Period:           types.ClockDuration(time.Duration(periodClock) * time.Second),
+					PeriodSpendLimit: periodLimit,
+				}
+			}
+
+			msg, err := types.NewMsgGrantFeeAllowance(grant, clientCtx.GetFromAddress(), grantee)
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the grant expires for the user")
+	cmd.Flags().Int64(FlagExpirationHeight, 0, "The block height at which the grant expires for the user")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period specifies the time duration in seconds for periodic allowance")
+	cmd.Flags().String(FlagPeriodLimit, "", "Period limit specifies the maximum number of coins that can be spent in the period")
+
+	return flags.PostCommands(cmd)[0]
+}
+
+// NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeFeeAllowance transaction.
+func NewCmdRevokeFeegrant(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [granter_key_or_address] [grantee]",
+		Short: "revoke fee-grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke fee grant from a granter to a grantee. Note, the '--from' flag is
+ignored as it is implied from [granter].
+
+Example:
+$ %s tx %s revoke cosmos1skj.. cosmos1skj..
+`, version.ClientName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInputAndFrom(cmd.InOrStdin(), args[0])
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(clientCtx.GetFromAddress(), grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+
+	return flags.PostCommands(cmd)[0]
+}
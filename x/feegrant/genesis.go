@@ -0,0 +1,49 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// ValidateGenesis ensures all grants in the genesis state are valid
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
+
+// InitGenesis will initialize the keeper from a *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	for _, f := range data.FeeAllowances {
+		if err := k.GrantFeeAllowance(ctx, f.Granter, f.Grantee, f.GetFeeGrant()); err != nil {
+			panic(fmt.Sprintf("failed to import %s grant from %s to %s: %s", types.ModuleName, f.Granter, f.Grantee, err))
+		}
+	}
+}
+
+// ExportGenesis will dump the contents of the keeper into a serializable GenesisState
+//
+// All expiration heights will be thrown off if we dump state and start at a new
+// chain at height 0. Thus, we allow the Allowances to "prepare themselves"
+// for export, like if they have expiry at 5000 and current is 4000, they export with
+// expiry of 1000. Every FeeAllowance has a method `PrepareForExport` that allows
+// them to perform any changes needed prior to export.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	time, height := ctx.BlockTime(), ctx.BlockHeight()
+
+	var grants []types.FeeAllowanceGrant
+	k.IterateAllFeeAllowances(ctx, func(grant types.FeeAllowanceGrant) bool {
+		exported := grant.PrepareForExport(time, height)
+
+		// expired grants can never be used again, so they are dropped from the export
+		if _, err := grant.GetFeeGrant().Accept(sdk.NewCoins(), time, height); err != nil {
+			return false
+		}
+
+		grants = append(grants, exported)
+		return false
+	})
+
+	return types.NewGenesisState(grants)
+}
@@ -0,0 +1,69 @@
+package feegrant_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	checkTx := false
+	app := simapp.Setup(checkTx)
+
+	suite.ctx = app.BaseApp.NewContext(checkTx, abci.Header{Height: 100})
+	suite.app = app
+}
+
+func (suite *GenesisTestSuite) TestImportExportGenesis() {
+	addrs := simapp.AddTestAddrsIncremental(suite.app, suite.ctx, 3, sdk.NewInt(30000000))
+	k := suite.app.FeeGrantKeeper
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+
+	// a grant expiring at a future height, and one that has already expired
+	suite.Require().NoError(k.GrantFeeAllowance(suite.ctx, addrs[0], addrs[1], &types.BasicFeeAllowance{
+		SpendLimit: coins,
+		Expiration: types.ExpiresAtHeight(150),
+	}))
+	suite.Require().NoError(k.GrantFeeAllowance(suite.ctx, addrs[0], addrs[2], &types.BasicFeeAllowance{
+		SpendLimit: coins,
+		Expiration: types.ExpiresAtHeight(50),
+	}))
+
+	genesis := feegrant.ExportGenesis(suite.ctx, k)
+	suite.Require().NoError(feegrant.ValidateGenesis(genesis))
+
+	// expired grants are dropped and heights are made relative to the export height
+	suite.Require().Len(genesis.FeeAllowances, 1)
+	suite.Require().Equal(addrs[0], genesis.FeeAllowances[0].Granter)
+	suite.Require().Equal(addrs[1], genesis.FeeAllowances[0].Grantee)
+	suite.Require().Equal(&types.BasicFeeAllowance{
+		SpendLimit: coins,
+		Expiration: types.ExpiresAtHeight(50),
+	}, genesis.FeeAllowances[0].GetFeeGrant())
+
+	// import into a new chain, starting over from height zero
+	suite.SetupTest()
+	ctx := suite.ctx.WithBlockHeight(0)
+	feegrant.InitGenesis(ctx, suite.app.FeeGrantKeeper, genesis)
+
+	newGenesis := feegrant.ExportGenesis(ctx, suite.app.FeeGrantKeeper)
+	suite.Require().Equal(genesis, newGenesis)
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
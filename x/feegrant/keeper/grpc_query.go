@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeAllowance implements the Query/FeeAllowance gRPC method
+func (k Keeper) FeeAllowance(c context.Context, req *types.QueryFeeAllowanceRequest) (*types.QueryFeeAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Granter) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address")
+	}
+
+	if len(req.Grantee) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	grant, found := k.GetFeeGrant(ctx, req.Granter, req.Grantee)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no fee allowance from %s to %s", req.Granter, req.Grantee)
+	}
+
+	return &types.QueryFeeAllowanceResponse{FeeAllowance: &grant}, nil
+}
+
+// FeeAllowances implements the Query/FeeAllowances gRPC method
+func (k Keeper) FeeAllowances(c context.Context, req *types.QueryFeeAllowancesRequest) (*types.QueryFeeAllowancesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.Grantee) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var grants []*types.FeeAllowanceGrant
+	k.IterateAllGranteeFeeAllowances(ctx, req.Grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, &grant)
+		return false
+	})
+
+	return &types.QueryFeeAllowancesResponse{FeeAllowances: grants}, nil
+}
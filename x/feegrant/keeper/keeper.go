@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// Keeper manages state of all fee grants, as well as calculating approval.
+// It must have a codec with all available allowances registered.
+type Keeper struct {
+	cdc        codec.Marshaler
+	storeKey   sdk.StoreKey
+	authKeeper types.AccountKeeper
+}
+
+// NewKeeper creates a fee grant Keeper
+func NewKeeper(cdc codec.Marshaler, storeKey sdk.StoreKey, ak types.AccountKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		authKeeper: ak,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GrantFeeAllowance creates a new grant, overwriting any existing grant from
+// the same granter to the same grantee.
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance types.FeeAllowanceI) error {
+	// create the account if it is not in account state
+	granteeAcc := k.authKeeper.GetAccount(ctx, grantee)
+	if granteeAcc == nil {
+		granteeAcc = k.authKeeper.NewAccountWithAddress(ctx, grantee)
+		k.authKeeper.SetAccount(ctx, granteeAcc)
+	}
+
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, feeAllowance)
+	if err != nil {
+		return err
+	}
+
+	k.setFeeAllowanceGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, grant.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grant.Grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// RevokeFeeAllowance removes an existing grant. It returns an error if no
+// grant exists from the granter to the grantee.
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.FeeAllowanceKey(granter, grantee)
+
+	if !store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeGrant,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+	)
+
+	return nil
+}
+
+// GetFeeAllowance returns the allowance between the granter and grantee.
+// If there is none, it returns nil.
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) types.FeeAllowanceI {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return nil
+	}
+
+	return grant.GetFeeGrant()
+}
+
+// GetFeeGrant returns the entire FeeAllowanceGrant between the granter and
+// grantee, and a boolean indicating whether it was found.
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (types.FeeAllowanceGrant, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeeAllowanceKey(granter, grantee))
+	if len(bz) == 0 {
+		return types.FeeAllowanceGrant{}, false
+	}
+
+	var grant types.FeeAllowanceGrant
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant, true
+}
+
+// IterateAllGranteeFeeAllowances iterates over all the grants to the given
+// grantee. Callback to get all data, returns true to stop, false to keep
+// reading.
+func (k Keeper) IterateAllGranteeFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowancePrefixByGrantee(grantee))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// IterateAllFeeAllowances iterates over all the grants in the store.
+// Callback to get all data, returns true to stop, false to keep reading.
+// Calling this without pagination is very expensive and only designed for
+// export genesis.
+func (k Keeper) IterateAllFeeAllowances(ctx sdk.Context, cb func(types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FeeAllowanceKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees will try to pay the given fee from the granter's account as
+// requested by the grantee. The allowance is updated to reflect the spent
+// fee, and removed once it is exhausted or expired.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	allowance := grant.GetFeeGrant()
+	if allowance == nil {
+		return sdkerrors.Wrapf(types.ErrNoAllowance, "grant from %s to %s", granter, grantee)
+	}
+
+	remove, err := allowance.Accept(fee, ctx.BlockTime(), ctx.BlockHeight())
+	if err == nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUseFeeGrant,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			),
+		)
+	}
+
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(types.FeeAllowanceKey(granter, grantee))
+		// note this returns nil if err == nil
+		return sdkerrors.Wrap(err, "removed grant")
+	}
+
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid grant")
+	}
+
+	// if we accepted, store the updated state of the allowance
+	grant, err = types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	if err != nil {
+		return err
+	}
+
+	k.setFeeAllowanceGrant(ctx, grant)
+	return nil
+}
+
+func (k Keeper) setFeeAllowanceGrant(ctx sdk.Context, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryBare(&grant)
+	store.Set(types.FeeAllowanceKey(grant.Granter, grant.Grantee), bz)
+}
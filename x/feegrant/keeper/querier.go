@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+// NewQuerier creates a new querier
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		var (
+			res []byte
+			err error
+		)
+
+		switch path[0] {
+		case types.QueryGetFeeAllowances:
+			res, err = queryGetFeeAllowances(ctx, path[1:], k)
+
+		default:
+			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+
+		return res, err
+	}
+}
+
+func queryGetFeeAllowances(ctx sdk.Context, args []string, k Keeper) ([]byte, error) {
+	if len(args) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing grantee address")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s", args[0])
+	}
+
+	var grants []*types.FeeAllowanceGrant
+	k.IterateAllGranteeFeeAllowances(ctx, grantee, func(grant types.FeeAllowanceGrant) bool {
+		grants = append(grants, &grant)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, types.QueryFeeAllowancesResponse{FeeAllowances: grants})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestBasicFeeValidAllow(t *testing.T) {
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	bigAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+
+	cases := map[string]struct {
+		allow types.BasicFeeAllowance
+		// all other checks are ignored if valid=false
+		fee       sdk.Coins
+		blockTime time.Time
+		valid     bool
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"empty": {
+			allow:  types.BasicFeeAllowance{},
+			valid:  true,
+			accept: true,
+		},
+		"small fee without expire": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+			},
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"all fee without expire": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    smallAtom,
+			accept: true,
+			remove: true,
+		},
+		"wrong fee": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: smallAtom,
+			},
+			valid:  true,
+			fee:    eth,
+			accept: false,
+		},
+		"non-expired": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: types.ExpiresAtHeight(100),
+			},
+			valid:   true,
+			fee:     smallAtom,
+			accept:  true,
+			remove:  false,
+			remains: leftAtom,
+		},
+		"expired": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: types.ExpiresAtHeight(10),
+			},
+			valid:  true,
+			fee:    smallAtom,
+			accept: false,
+			remove: true,
+		},
+		"time expired": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: types.ExpiresAtTime(time.Unix(1000, 0)),
+			},
+			valid:     true,
+			fee:       smallAtom,
+			blockTime: time.Unix(1000, 0),
+			accept:    false,
+			remove:    true,
+		},
+		"fee more than allowed": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: types.ExpiresAtHeight(100),
+			},
+			valid:  true,
+			fee:    bigAtom,
+			accept: false,
+		},
+		"negative spend limit": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(-1)}},
+			},
+			valid: false,
+		},
+		"both expirations": {
+			allow: types.BasicFeeAllowance{
+				SpendLimit: atom,
+				Expiration: types.ExpiresAt{Time: time.Unix(1000, 0), Height: 100},
+			},
+			valid: false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// now try to deduct
+			remove, err := tc.allow.Accept(tc.fee, tc.blockTime, 20)
+			if !tc.accept {
+				require.Error(t, err)
+				require.Equal(t, tc.remove, remove)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.remove, remove)
+			if !remove {
+				require.Equal(t, tc.allow.SpendLimit, tc.remains)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestExpiresAt(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		example types.ExpiresAt
+		valid   bool
+		zero    bool
+		before  types.ExpiresAt
+		after   types.ExpiresAt
+	}{
+		"basic": {
+			example: types.ExpiresAtHeight(100),
+			valid:   true,
+			before:  types.ExpiresAt{Height: 50, Time: now},
+			after:   types.ExpiresAt{Height: 122, Time: now},
+		},
+		"zero": {
+			example: types.ExpiresAt{},
+			zero:    true,
+			valid:   true,
+			before:  types.ExpiresAt{Height: 1},
+		},
+		"double": {
+			example: types.ExpiresAt{Height: 100, Time: now},
+			valid:   false,
+		},
+		"match height": {
+			example: types.ExpiresAtHeight(1000),
+			valid:   true,
+			before:  types.ExpiresAt{Height: 999, Time: now},
+			after:   types.ExpiresAt{Height: 1000, Time: now},
+		},
+		"match time": {
+			example: types.ExpiresAtTime(now),
+			valid:   true,
+			before:  types.ExpiresAt{Height: 43, Time: now.Add(-1 * time.Second)},
+			after:   types.ExpiresAt{Height: 76, Time: now},
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.example.ValidateBasic()
+			require.Equal(t, tc.zero, tc.example.IsZero())
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if !tc.before.IsZero() {
+				require.False(t, tc.example.IsExpired(tc.before.Time, tc.before.Height))
+			}
+			if !tc.after.IsZero() {
+				require.True(t, tc.example.IsExpired(tc.after.Time, tc.after.Height))
+			}
+		})
+	}
+}
+
+func TestDurationValid(t *testing.T) {
+	now := time.Now()
+
+	cases := map[string]struct {
+		period     types.Duration
+		valid      bool
+		compatible types.ExpiresAt
+		incompat   types.ExpiresAt
+		init       types.ExpiresAt
+		expected   types.ExpiresAt
+	}{
+		"height": {
+			period:     types.BlockDuration(100),
+			valid:      true,
+			compatible: types.ExpiresAtHeight(50),
+			incompat:   types.ExpiresAtTime(now),
+			init:       types.ExpiresAtHeight(50),
+			expected:   types.ExpiresAtHeight(150),
+		},
+		"time": {
+			period:     types.ClockDuration(time.Hour),
+			valid:      true,
+			compatible: types.ExpiresAtTime(now),
+			incompat:   types.ExpiresAtHeight(50),
+			init:       types.ExpiresAtTime(now),
+			expected:   types.ExpiresAtTime(now.Add(time.Hour)),
+		},
+		"zero": {
+			period: types.Duration{},
+			valid:  false,
+		},
+		"double": {
+			period: types.Duration{Block: 100, Clock: time.Hour},
+			valid:  false,
+		},
+		"negative clock": {
+			period: types.ClockDuration(-1 * time.Hour),
+			valid:  false,
+		},
+		"negative block": {
+			period: types.BlockDuration(-5),
+			valid:  false,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			err := tc.period.ValidateBasic()
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			require.True(t, tc.compatible.IsCompatible(tc.period))
+			require.False(t, tc.incompat.IsCompatible(tc.period))
+
+			next, err := tc.init.Step(tc.period)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func TestExpiresAtPrepareForExport(t *testing.T) {
+	now := time.Now()
+
+	require.Equal(t, types.ExpiresAtHeight(30), types.ExpiresAtHeight(50).PrepareForExport(now, 20))
+	require.Equal(t, types.ExpiresAt{}, types.ExpiresAtHeight(10).PrepareForExport(now, 20))
+	require.Equal(t, types.ExpiresAtTime(now), types.ExpiresAtTime(now).PrepareForExport(now, 20))
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ types.UnpackInterfacesMessage = FeeAllowanceGrant{}
+
+// NewFeeAllowanceGrant creates a new FeeAllowanceGrant.
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, feeAllowance FeeAllowanceI) (FeeAllowanceGrant, error) {
+	msg, ok := feeAllowance.(proto.Message)
+	if !ok {
+		return FeeAllowanceGrant{}, fmt.Errorf("cannot proto marshal %T", feeAllowance)
+	}
+
+	any, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return FeeAllowanceGrant{}, err
+	}
+
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: any,
+	}, nil
+}
+
+// ValidateBasic performs basic validation on
+// FeeAllowanceGrant
+func (a FeeAllowanceGrant) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if a.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if a.Grantee.Equals(a.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "cannot self-grant fee authorization")
+	}
+
+	if a.Allowance == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidType, "missing allowance")
+	}
+
+	allowance := a.GetFeeGrant()
+	if allowance == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s is not a FeeAllowanceI", a.Allowance.TypeUrl)
+	}
+
+	return allowance.ValidateBasic()
+}
+
+// GetFeeGrant unpacks the allowance of a FeeAllowanceGrant, returning nil if
+// it is missing or not a FeeAllowanceI.
+func (a FeeAllowanceGrant) GetFeeGrant() FeeAllowanceI {
+	if a.Allowance == nil {
+		return nil
+	}
+
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+
+	return allowance
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a FeeAllowanceGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if a.Allowance == nil {
+		return nil
+	}
+
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// PrepareForExport will make all needed changes to the allowance to prepare to be
+// re-imported at height 0, and return a copy of this grant.
+func (a FeeAllowanceGrant) PrepareForExport(dumpTime time.Time, dumpHeight int64) FeeAllowanceGrant {
+	allowance := a.GetFeeGrant().PrepareForExport(dumpTime, dumpHeight)
+	grant, err := NewFeeAllowanceGrant(a.Granter, a.Grantee, allowance)
+	if err != nil {
+		panic(err)
+	}
+
+	return grant
+}
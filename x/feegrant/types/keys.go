@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feegrant"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// QueryGetFeeAllowances is the legacy querier route for the fee allowances
+	// of a grantee
+	QueryGetFeeAllowances = "fees"
+)
+
+// KVStore key prefixes
+var (
+	// FeeAllowanceKeyPrefix is the set of the kvstore for fee allowance data
+	FeeAllowanceKeyPrefix = []byte{0x00}
+)
+
+// FeeAllowanceKey is the canonical key to store a grant from granter to grantee.
+// Keys are ordered by grantee first, so all the allowances of a grantee can be
+// iterated over with FeeAllowancePrefixByGrantee.
+func FeeAllowanceKey(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(FeeAllowancePrefixByGrantee(grantee), granter.Bytes()...)
+}
+
+// FeeAllowancePrefixByGrantee returns a prefix to scan for all grants to this
+// given address.
+//
+// NOTE: addresses are fixed length, so a grantee can't be a prefix of another.
+func FeeAllowancePrefixByGrantee(grantee sdk.AccAddress) []byte {
+	return append(append([]byte{}, FeeAllowanceKeyPrefix...), grantee.Bytes()...)
+}
@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/types"
+)
+
+func TestMsgGrantFeeAllowance(t *testing.T) {
+	granter := sdk.AccAddress("_______granter______")
+	grantee := sdk.AccAddress("_______grantee______")
+	allowance := &types.BasicFeeAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))}
+
+	msg, err := types.NewMsgGrantFeeAllowance(allowance, granter, grantee)
+	require.NoError(t, err)
+	require.NoError(t, msg.ValidateBasic())
+
+	grant, err := types.NewFeeAllowanceGrant(granter, grantee, allowance)
+	require.NoError(t, err)
+	require.NoError(t, grant.ValidateBasic())
+
+	// a missing allowance is rejected instead of panicking
+	msg.Allowance = nil
+	require.Nil(t, msg.GetFeeAllowanceI())
+	require.True(t, sdkerrors.ErrInvalidType.Is(msg.ValidateBasic()))
+
+	grant.Allowance = nil
+	require.Nil(t, grant.GetFeeGrant())
+	require.True(t, sdkerrors.ErrInvalidType.Is(grant.ValidateBasic()))
+
+	// an allowance which is not a FeeAllowanceI is rejected
+	msg.Allowance = &codectypes.Any{TypeUrl: "/cosmos.bank.MsgSend"}
+	require.Nil(t, msg.GetFeeAllowanceI())
+	require.True(t, sdkerrors.ErrInvalidType.Is(msg.ValidateBasic()))
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var (
+	_ types.UnpackInterfacesMessage = QueryFeeAllowanceResponse{}
+	_ types.UnpackInterfacesMessage = QueryFeeAllowancesResponse{}
+)
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryFeeAllowanceResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	if q.FeeAllowance == nil {
+		return nil
+	}
+
+	return q.FeeAllowance.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (q QueryFeeAllowancesResponse) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, grant := range q.FeeAllowances {
+		if err := grant.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker adjusts the base fee of the next block given the gas used by the
+// current block. The maximum block gas is the limit of the block gas meter,
+// i.e. the consensus parameter MaxGas, which is zero when blocks have no gas
+// limit.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	blockGasMeter := ctx.BlockGasMeter()
+	if blockGasMeter == nil {
+		return
+	}
+
+	gasUsed := blockGasMeter.GasConsumedToLimit()
+	baseFee := types.NextBaseFee(k.GetParams(ctx), k.GetBaseFee(ctx), gasUsed, blockGasMeter.Limit())
+	k.SetBaseFee(ctx, baseFee)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseFee, baseFee.String()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", gasUsed)),
+		),
+	)
+}
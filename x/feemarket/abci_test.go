@@ -0,0 +1,52 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDec(100))
+
+	// blocks without a gas limit leave the base fee unchanged
+	ctx = ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
+	ctx.BlockGasMeter().ConsumeGas(1000, "txs")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDec(100), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	// a full block increases the base fee by 1/8th
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000)).WithEventManager(sdk.NewEventManager())
+	ctx.BlockGasMeter().ConsumeGas(1000, "txs")
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(1125, 1), app.FeeMarketKeeper.GetBaseFee(ctx))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeFeeMarket, events[0].Type)
+
+	// an empty block decreases it by 1/8th
+	ctx = ctx.WithBlockGasMeter(sdk.NewGasMeter(1000))
+	feemarket.EndBlocker(ctx, app.FeeMarketKeeper)
+	require.Equal(t, sdk.NewDecWithPrec(984375, 4), app.FeeMarketKeeper.GetBaseFee(ctx))
+}
+
+func TestGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.NewDec(5)
+	gs := types.NewGenesisState(params, sdk.NewDec(10))
+
+	feemarket.InitGenesis(ctx, app.FeeMarketKeeper, gs)
+	require.Equal(t, gs, feemarket.ExportGenesis(ctx, app.FeeMarketKeeper))
+}
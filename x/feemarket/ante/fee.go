@@ -0,0 +1,63 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// BaseFeeDecorator ensures the gas price of the tx is at least the base fee
+// and burns the base fee portion of the tx fee, i.e. the base fee times the
+// gas limit of the tx. The rest of the fee is left to the fee collector.
+//
+// It must be called after the fees have been deducted to the fee collector
+// module account, e.g. after the x/auth DeductFeeDecorator.
+// CONTRACT: Tx must implement FeeTx interface to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	k keeper.Keeper
+}
+
+func NewBaseFeeDecorator(k keeper.Keeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		k: k,
+	}
+}
+
+// AnteHandle performs a decorated ante-handler responsible for charging the
+// base fee. It is skipped when the base fee is zero. In simulation mode the
+// fee is not required to cover the base fee, as it is usually not known yet,
+// but the base fee paid is still burned so that its gas is accounted for.
+func (d BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseFee := d.k.GetBaseFee(ctx)
+	if baseFee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.k.GetParams(ctx)
+	gas := feeTx.GetGas()
+	fee := feeTx.GetFee()
+
+	required := sdk.NewCoin(params.BaseFeeDenom, types.BaseFeeAmount(baseFee, gas))
+	if paid := fee.AmountOf(params.BaseFeeDenom); paid.LT(required.Amount) {
+		if !simulate {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"gas price is lower than the base fee %s%s; got: %s required: %s", baseFee, params.BaseFeeDenom, fee, required,
+			)
+		}
+
+		required.Amount = paid
+	}
+
+	if err := d.k.BurnBaseFee(ctx, sdk.NewCoins(required)); err != nil {
+		return ctx, sdkerrors.Wrapf(err, "failed to burn base fee %s", required)
+	}
+
+	return next(ctx, tx, simulate)
+}
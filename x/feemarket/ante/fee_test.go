@@ -0,0 +1,119 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+)
+
+func TestBaseFeeDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.AccountKeeper.SetParams(ctx, authtypes.DefaultParams())
+
+	anteHandler := sdk.ChainAnteDecorators(
+		authante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper),
+		ante.NewBaseFeeDecorator(app.FeeMarketKeeper),
+	)
+
+	priv, _, addr := authtypes.KeyTestPubAddr()
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000))
+	require.NoError(t, app.BankKeeper.SetBalances(ctx, addr, coins))
+	app.BankKeeper.SetSupply(ctx, banktypes.NewSupply(coins))
+
+	// base gas price of 1.5stake
+	app.FeeMarketKeeper.SetBaseFee(ctx, sdk.NewDecWithPrec(15, 1))
+
+	cases := map[string]struct {
+		fee      sdk.Coins
+		simulate bool
+		burned   int64
+		err      *sdkerrors.Error
+	}{
+		"fee equal to the base fee": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15000)),
+			burned: 15000,
+		},
+		"fee above the base fee": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20000)),
+			burned: 15000,
+		},
+		"fee below the base fee": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 14999)),
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		"fee in another denom": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 20000)),
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		"simulation without fee": {
+			fee:      sdk.NewCoins(),
+			simulate: true,
+		},
+		"simulation with fee below the base fee": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)),
+			simulate: true,
+			burned:   10000,
+		},
+		"simulation with fee above the base fee": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20000)),
+			simulate: true,
+			burned:   15000,
+		},
+	}
+
+	for name, stc := range cases {
+		tc := stc // to make scopelint happy
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			if !tc.fee.AmountOf("atom").IsZero() {
+				require.NoError(t, app.BankKeeper.SetBalances(cacheCtx, addr, coins.Add(tc.fee...)))
+			}
+
+			fee := authtypes.NewStdFee(10000, tc.fee)
+			msgs := []sdk.Msg{authtypes.NewTestMsg(addr)}
+			tx := authtypes.NewTestTx(cacheCtx, msgs, []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}, fee)
+
+			_, err := anteHandler(cacheCtx, tx, tc.simulate)
+			if tc.err != nil {
+				require.True(t, tc.err.Is(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			supply := app.BankKeeper.GetSupply(cacheCtx).GetTotal().AmountOf(sdk.DefaultBondDenom)
+			require.True(t, coins.AmountOf(sdk.DefaultBondDenom).SubRaw(tc.burned).Equal(supply))
+
+			feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			collected := app.BankKeeper.GetBalance(cacheCtx, feeCollector, sdk.DefaultBondDenom)
+			require.True(t, tc.fee.AmountOf(sdk.DefaultBondDenom).SubRaw(tc.burned).Equal(collected.Amount))
+		})
+	}
+
+	// the simulation consumes the gas of the base fee burn
+	fee := authtypes.NewStdFee(10000, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15000)))
+	tx := authtypes.NewTestTx(ctx, []sdk.Msg{authtypes.NewTestMsg(addr)}, []crypto.PrivKey{priv}, []uint64{0}, []uint64{0}, fee)
+
+	gasUsed := func(simulate bool) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := anteHandler(cacheCtx, tx, simulate)
+		require.NoError(t, err)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	require.Equal(t, gasUsed(false), gasUsed(true))
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	feemarketQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the feemarket module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feemarketQueryCmd.AddCommand(
+		GetCmdQueryParams(clientCtx),
+		GetCmdQueryBaseFee(clientCtx),
+	)
+
+	return feemarketQueryCmd
+}
+
+// GetCmdQueryParams returns the command to query the feemarket parameters.
+func GetCmdQueryParams(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current feemarket parameters",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Params)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
+
+// GetCmdQueryBaseFee returns the command to query the current base fee.
+func GetCmdQueryBaseFee(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Args:  cobra.NoArgs,
+		Short: "Query the current base gas price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current base gas price, in the base fee denom per unit of gas.
+The fee of a tx must be at least the base gas price times its gas limit.
+
+Example:
+$ %s query %s base-fee
+`, version.ClientName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			res, err := queryClient.BaseFee(context.Background(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.BaseFee)
+		},
+	}
+
+	return flags.GetCommands(cmd)[0]
+}
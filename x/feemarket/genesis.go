@@ -0,0 +1,24 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// ValidateGenesis performs basic validation of the feemarket genesis state
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
+
+// InitGenesis initializes the feemarket params and base fee from a
+// *previously validated* GenesisState
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetBaseFee(ctx, data.BaseFee)
+}
+
+// ExportGenesis returns a GenesisState for the current params and base fee
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetBaseFee(ctx))
+}
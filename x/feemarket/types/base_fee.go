@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseFee returns the base fee of the next block given the base fee and
+// the gas used by the current block. The target gas of a block is the maximum
+// block gas divided by the elasticity multiplier. The base fee increases when
+// the block used more than the target gas and decreases when it used less, by
+// at most 1/BaseFeeChangeDenominator of its value, and is never lower than
+// MinBaseFee. The base fee does not change when there is no maximum block gas.
+func NextBaseFee(params Params, baseFee sdk.Dec, gasUsed, maxGas uint64) sdk.Dec {
+	targetGas := maxGas / uint64(params.ElasticityMultiplier)
+	if targetGas == 0 {
+		return baseFee
+	}
+
+	var (
+		target = sdk.NewIntFromUint64(targetGas)
+		used   = sdk.NewIntFromUint64(gasUsed)
+		denom  = sdk.NewIntFromUint64(uint64(params.BaseFeeChangeDenominator))
+	)
+
+	// baseFee * |gasUsed - targetGas| / targetGas / BaseFeeChangeDenominator
+	var next sdk.Dec
+	if used.GT(target) {
+		next = baseFee.Add(baseFee.MulInt(used.Sub(target)).QuoInt(target).QuoInt(denom))
+	} else {
+		next = baseFee.Sub(baseFee.MulInt(target.Sub(used)).QuoInt(target).QuoInt(denom))
+	}
+
+	return sdk.MaxDec(next, params.MinBaseFee)
+}
+
+// BaseFeeAmount returns the base fee portion of the fee of a tx with the given
+// gas limit, i.e. the base fee times the gas limit rounded up.
+func BaseFeeAmount(baseFee sdk.Dec, gas uint64) sdk.Int {
+	return baseFee.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
@@ -0,0 +1,76 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	baseFee := sdk.NewDec(100)
+
+	minParams := types.DefaultParams()
+	minParams.MinBaseFee = sdk.NewDec(95)
+
+	testCases := []struct {
+		name     string
+		params   types.Params
+		baseFee  sdk.Dec
+		gasUsed  uint64
+		maxGas   uint64
+		expected sdk.Dec
+	}{
+		{"no block gas limit", params, baseFee, 1000, 0, baseFee},
+		{"target gas used", params, baseFee, 500, 1000, baseFee},
+		{"full block", params, baseFee, 1000, 1000, sdk.NewDecWithPrec(1125, 1)},
+		{"empty block", params, baseFee, 0, 1000, sdk.NewDecWithPrec(875, 1)},
+		{"partially filled block", params, baseFee, 750, 1000, sdk.NewDecWithPrec(10625, 2)},
+		{"min base fee", minParams, baseFee, 0, 1000, sdk.NewDec(95)},
+		{"zero base fee", params, sdk.ZeroDec(), 1000, 1000, sdk.ZeroDec()},
+		{"target gas rounded down", params, baseFee, 1, 1, baseFee},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			next := types.NextBaseFee(tc.params, tc.baseFee, tc.gasUsed, tc.maxGas)
+			require.True(t, tc.expected.Equal(next), "expected %s, got %s", tc.expected, next)
+		})
+	}
+}
+
+func TestBaseFeeAmount(t *testing.T) {
+	require.Equal(t, sdk.NewInt(0), types.BaseFeeAmount(sdk.ZeroDec(), 100000))
+	require.Equal(t, sdk.NewInt(150000), types.BaseFeeAmount(sdk.NewDecWithPrec(15, 1), 100000))
+	require.Equal(t, sdk.NewInt(2), types.BaseFeeAmount(sdk.NewDecWithPrec(15, 1), 1))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(types.DefaultGenesisState()))
+
+	params := types.DefaultParams()
+	params.MinBaseFee = sdk.NewDec(10)
+	require.NoError(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.NewDec(10))))
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.NewDec(9))))
+
+	params = types.DefaultParams()
+	params.BaseFeeDenom = ""
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.ZeroDec())))
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.ZeroDec())))
+
+	params = types.DefaultParams()
+	params.ElasticityMultiplier = 0
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.ZeroDec())))
+
+	params = types.DefaultParams()
+	params.MinBaseFee = sdk.NewDec(-1)
+	require.Error(t, types.ValidateGenesis(types.NewGenesisState(params, sdk.ZeroDec())))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseFee sdk.Dec) GenesisState {
+	return GenesisState{
+		Params:  params,
+		BaseFee: baseFee,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state. As the base fee is
+// adjusted proportionally to its current value, the default zero base fee and
+// min base fee disable the fee market.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.ZeroDec())
+}
+
+// ValidateGenesis validates the feemarket genesis state
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseFee.IsNil() || data.BaseFee.LT(data.Params.MinBaseFee) {
+		return fmt.Errorf("base fee cannot be lower than the min base fee %s: %s", data.Params.MinBaseFee, data.BaseFee)
+	}
+
+	return nil
+}
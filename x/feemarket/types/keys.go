@@ -0,0 +1,22 @@
+package types
+
+// BaseFeeKey is the key to use for the base fee in the keeper store.
+var BaseFeeKey = []byte{0x00}
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "feemarket"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// DefaultParamspace defines the default paramspace for the params keeper
+	DefaultParamspace = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+
+	// Query endpoints supported by the feemarket legacy querier
+	QueryParameters = "parameters"
+	QueryBaseFee    = "base_fee"
+)
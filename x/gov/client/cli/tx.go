@@ -26,6 +26,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagExpedited    = "expedited"
 )
 
 type proposal struct {
@@ -70,6 +71,8 @@ func NewTxCmd(
 	govTxCmd.AddCommand(flags.PostCommands(
 		NewCmdDeposit(ctx),
 		NewCmdVote(ctx),
+		NewCmdWeightedVote(ctx),
+		NewCmdCancelProposal(ctx),
 		cmdSubmitProp,
 	)...)
 
@@ -100,6 +103,10 @@ Where proposal.json contains:
 Which is equivalent to:
 
 $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="10test" --from mykey
+
+Pass --expedited to vote on the proposal over the shorter expedited voting period,
+with the higher expedited threshold. An expedited proposal that doesn't pass is
+converted to a regular proposal.
 `,
 				version.ClientName, version.ClientName,
 			),
@@ -124,6 +131,12 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 				return err
 			}
 
+			expedited, err := cmd.Flags().GetBool(FlagExpedited)
+			if err != nil {
+				return err
+			}
+			msg.SetExpedited(expedited)
+
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -137,6 +150,7 @@ $ %s tx gov submit-proposal --title="Test Proposal" --description="My awesome pr
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal, types: text/parameter_change/software_upgrade")
 	cmd.Flags().String(FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(FlagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
+	cmd.Flags().Bool(FlagExpedited, false, "submit the proposal as an expedited proposal")
 
 	return cmd
 }
@@ -233,4 +247,92 @@ $ %s tx gov vote 1 yes --from mykey
 	}
 }
 
+// NewCmdWeightedVote implements creating a new weighted vote command.
+func NewCmdWeightedVote(clientCtx client.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "weighted-vote [proposal-id] [weighted-options]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Vote for an active proposal, options: yes/no/no_with_veto/abstain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a vote for an active proposal, with the voting power split across
+several options. The weights of the options must sum up to one. You can find
+the proposal-id by running "%s query gov proposals".
+
+
+Example:
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			// Get voting address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Figure out which vote options user chose
+			options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+}
+
+// NewCmdCancelProposal implements cancelling a proposal during its deposit period.
+func NewCmdCancelProposal(clientCtx client.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal during its deposit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal that is still in its deposit period. Only the
+proposer can cancel a proposal. A fraction of the deposits, defined by the
+proposal_cancel_ratio deposit parameter, is burned and the rest is refunded.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := clientCtx.InitWithInput(cmd.InOrStdin())
+
+			// Get proposer address
+			from := clientCtx.GetFromAddress()
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgCancelProposal(from, proposalID)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+}
+
 // DONTCOVER
@@ -28,6 +28,10 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	}
 
 	for _, vote := range data.Votes {
+		// votes exported before weighted voting only carry a single option
+		if len(vote.Options) == 0 && vote.Option != types.OptionEmpty {
+			vote.Options = types.NewNonSplitVoteOption(vote.Option)
+		}
 		k.SetVote(ctx, vote)
 	}
 
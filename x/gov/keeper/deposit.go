@@ -168,3 +168,38 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 		return false
 	})
 }
+
+// BurnAndRefundDeposits burns the given fraction of all the deposits on a
+// specific proposal, refunds the remainder to the depositors and deletes the
+// deposits. It returns the total amount burned.
+func (keeper Keeper) BurnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) sdk.Coins {
+	store := ctx.KVStore(keeper.storeKey)
+	burned := sdk.NewCoins()
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		burn := sdk.NewCoins()
+		for _, coin := range deposit.Amount {
+			burn = burn.Add(sdk.NewCoin(coin.Denom, burnRatio.MulInt(coin.Amount).TruncateInt()))
+		}
+
+		if !burn.IsZero() {
+			if err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burn); err != nil {
+				panic(err)
+			}
+		}
+
+		refund := deposit.Amount.Sub(burn)
+		if !refund.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, deposit.Depositor, refund)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		burned = burned.Add(burn...)
+		store.Delete(types.DepositKey(proposalID, deposit.Depositor))
+		return false
+	})
+
+	return burned
+}
@@ -9,8 +9,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-// SubmitProposal create new proposal given a content
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (types.Proposal, error) {
+// SubmitProposal create new proposal given a content. Expedited proposals are
+// voted on over the expedited voting period with the expedited threshold.
+func (keeper Keeper) SubmitProposal(
+	ctx sdk.Context, content types.Content, proposer sdk.AccAddress, expedited bool,
+) (types.Proposal, error) {
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
@@ -32,7 +35,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := types.NewProposal(content, proposalID, submitTime, submitTime.Add(depositPeriod))
+	proposal, err := types.NewProposal(content, proposalID, proposer, submitTime, submitTime.Add(depositPeriod), expedited)
 	if err != nil {
 		return types.Proposal{}, err
 	}
@@ -182,7 +185,12 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingParams := keeper.GetVotingParams(ctx)
+	votingPeriod := votingParams.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = votingParams.ExpeditedVotingPeriod
+	}
+
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -191,6 +199,37 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 }
 
+// CancelProposal cancels a proposal that is still in its deposit period. Only
+// the proposer can cancel a proposal. A ProposalCancelRatio fraction of every
+// deposit is burned and the remainder is refunded to its depositor.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusDepositPeriod {
+		return sdkerrors.Wrapf(types.ErrAlreadyActiveProposal, "%d", proposalID)
+	}
+
+	if !proposal.Proposer.Equals(proposer) {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	burned := keeper.BurnAndRefundDeposits(ctx, proposalID, keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyBurnedAmount, burned.String()),
+		),
+	)
+
+	return nil
+}
+
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.MarshalBinaryBare(&proposal)
 	if err != nil {
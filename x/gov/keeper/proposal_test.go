@@ -19,7 +19,7 @@ func TestGetSetProposal(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 	proposalID := proposal.ProposalID
 	app.GovKeeper.SetProposal(ctx, proposal)
@@ -34,7 +34,7 @@ func TestActivateVotingPeriod(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, abci.Header{})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, nil, false)
 	require.NoError(t, err)
 
 	require.True(t, proposal.VotingStartTime.Equal(time.Time{}))
@@ -77,7 +77,7 @@ func TestSubmitProposal(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content)
+		_, err := app.GovKeeper.SubmitProposal(ctx, tc.content, nil, false)
 		require.True(t, errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -93,14 +93,14 @@ func TestGetProposalsFiltered(t *testing.T) {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := types.NewProposal(TestProposal, proposalID, time.Now(), time.Now())
+			p, err := types.NewProposal(TestProposal, proposalID, nil, time.Now(), time.Now(), false)
 			require.NoError(t, err)
 
 			p.Status = s
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.OptionYes)
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
 				app.GovKeeper.SetDeposit(ctx, d)
 				app.GovKeeper.SetVote(ctx, v)
 			}
@@ -139,3 +139,85 @@ func TestGetProposalsFiltered(t *testing.T) {
 		}
 	}
 }
+
+func TestActivateVotingPeriodExpedited(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{Time: time.Now()})
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, true)
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+
+	expeditedPeriod := app.GovKeeper.GetVotingParams(ctx).ExpeditedVotingPeriod
+	require.Equal(t, ctx.BlockHeader().Time.Add(expeditedPeriod), proposal.VotingEndTime)
+
+	activeIterator := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime)
+	require.True(t, activeIterator.Valid())
+	require.Equal(t, proposal.ProposalID, types.GetProposalIDFromBytes(activeIterator.Value()))
+	activeIterator.Close()
+}
+
+func TestCancelProposal(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(100))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], false)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalID
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(4)))
+	for _, addr := range addrs {
+		_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addr, deposit)
+		require.NoError(t, err)
+	}
+
+	addr0Initial := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+	addr1Initial := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+	supplyInitial := app.BankKeeper.GetSupply(ctx).GetTotal()
+
+	// only the proposer can cancel the proposal
+	err = app.GovKeeper.CancelProposal(ctx, proposalID, addrs[1])
+	require.True(t, errors.Is(err, types.ErrInvalidProposer))
+
+	err = app.GovKeeper.CancelProposal(ctx, proposalID+1, addrs[0])
+	require.True(t, errors.Is(err, types.ErrUnknownProposal))
+
+	require.NoError(t, app.GovKeeper.CancelProposal(ctx, proposalID, addrs[0]))
+
+	_, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.False(t, ok)
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposalID))
+
+	inactiveIterator := app.GovKeeper.InactiveProposalQueueIterator(ctx, proposal.DepositEndTime)
+	require.False(t, inactiveIterator.Valid())
+	inactiveIterator.Close()
+
+	// half of every deposit is burned and the other half refunded
+	refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(2)))
+	require.Equal(t, addr0Initial.Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, addr1Initial.Add(refund...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, supplyInitial.Sub(refund.Add(refund...)), app.BankKeeper.GetSupply(ctx).GetTotal())
+}
+
+func TestCancelProposalInVotingPeriod(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.TokensFromConsensusPower(100))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, addrs[0], false)
+	require.NoError(t, err)
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	err = app.GovKeeper.CancelProposal(ctx, proposal.ProposalID, addrs[0])
+	require.True(t, errors.Is(err, types.ErrAlreadyActiveProposal))
+
+	_, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalID)
+	require.True(t, ok)
+}
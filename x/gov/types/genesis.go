@@ -67,11 +67,34 @@ func ValidateGenesis(data GenesisState) error {
 			veto.String())
 	}
 
+	expeditedThreshold := data.TallyParams.ExpeditedThreshold
+	if expeditedThreshold.IsNil() || expeditedThreshold.LTE(threshold) || expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("governance expedited vote threshold should be greater than the vote threshold and less or equal to one, is %s",
+			expeditedThreshold.String())
+	}
+
+	if err := validateVotingParams(data.VotingParams); err != nil {
+		return err
+	}
+
 	if !data.DepositParams.MinDeposit.IsValid() {
 		return fmt.Errorf("governance deposit amount must be a valid sdk.Coins amount, is %s",
 			data.DepositParams.MinDeposit.String())
 	}
 
+	for _, vote := range data.Votes {
+		if len(vote.Options) == 0 {
+			if !ValidVoteOption(vote.Option) {
+				return fmt.Errorf("invalid vote option %s for proposal %d", vote.Option, vote.ProposalID)
+			}
+			continue
+		}
+
+		if err := ValidateWeightedVoteOptions(vote.Options); err != nil {
+			return fmt.Errorf("invalid vote for proposal %d: %w", vote.ProposalID, err)
+		}
+	}
+
 	return nil
 }
 
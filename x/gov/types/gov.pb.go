@@ -114,6 +114,9 @@ type MsgSubmitProposal struct {
 	Content        *types.Any                                    `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	InitialDeposit github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,2,rep,name=initial_deposit,json=initialDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"initial_deposit" yaml:"initial_deposit"`
 	Proposer       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+	// expedited defines whether the proposal is voted on over the shorter
+	// expedited voting period and with the higher expedited threshold
+	Expedited bool `protobuf:"varint,4,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()      { *m = MsgSubmitProposal{} }
@@ -148,6 +151,45 @@ func (m *MsgSubmitProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitProposal proto.InternalMessageInfo
 
+// MsgCancelProposal defines a message to cancel a proposal that is still in its
+// deposit period
+type MsgCancelProposal struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Proposer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()      { *m = MsgCancelProposal{} }
+func (*MsgCancelProposal) ProtoMessage() {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{1}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
 // MsgVote defines a message to cast a vote
 type MsgVote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -158,7 +200,7 @@ type MsgVote struct {
 func (m *MsgVote) Reset()      { *m = MsgVote{} }
 func (*MsgVote) ProtoMessage() {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{1}
+	return fileDescriptor_67fb57f9a603bed5, []int{2}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,6 +229,46 @@ func (m *MsgVote) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVote proto.InternalMessageInfo
 
+// MsgVoteWeighted defines a message to cast a vote whose voting power is split
+// across several options
+type MsgVoteWeighted struct {
+	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,3,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
+}
+
+func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
+func (*MsgVoteWeighted) ProtoMessage() {}
+func (*MsgVoteWeighted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{3}
+}
+func (m *MsgVoteWeighted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteWeighted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteWeighted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteWeighted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteWeighted.Merge(m, src)
+}
+func (m *MsgVoteWeighted) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteWeighted) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteWeighted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteWeighted proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal
 type MsgDeposit struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -197,7 +279,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{2}
+	return fileDescriptor_67fb57f9a603bed5, []int{4}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -226,6 +308,45 @@ func (m *MsgDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeposit proto.InternalMessageInfo
 
+// WeightedVoteOption defines a vote option together with the fraction of the
+// voting power assigned to it
+type WeightedVoteOption struct {
+	Option VoteOption                             `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+}
+
+func (m *WeightedVoteOption) Reset()      { *m = WeightedVoteOption{} }
+func (*WeightedVoteOption) ProtoMessage() {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67fb57f9a603bed5, []int{5}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
 // TextProposal defines a standard text proposal whose changes need to be
 // manually updated in case of approval
 type TextProposal struct {
@@ -236,7 +357,7 @@ type TextProposal struct {
 func (m *TextProposal) Reset()      { *m = TextProposal{} }
 func (*TextProposal) ProtoMessage() {}
 func (*TextProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{3}
+	return fileDescriptor_67fb57f9a603bed5, []int{6}
 }
 func (m *TextProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +396,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{4}
+	return fileDescriptor_67fb57f9a603bed5, []int{7}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,21 +427,23 @@ var xxx_messageInfo_Deposit proto.InternalMessageInfo
 
 // Proposal defines the core field members of a governance proposal
 type Proposal struct {
-	ProposalID       uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
-	Content          *types.Any                               `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Status           ProposalStatus                           `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
-	FinalTallyResult TallyResult                              `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
-	SubmitTime       time.Time                                `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
-	DepositEndTime   time.Time                                `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time" yaml:"deposit_end_time"`
-	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
-	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
-	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	ProposalID       uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"id" yaml:"id"`
+	Content          *types.Any                                    `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Status           ProposalStatus                                `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.gov.ProposalStatus" json:"status,omitempty" yaml:"proposal_status"`
+	FinalTallyResult TallyResult                                   `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result" yaml:"final_tally_result"`
+	SubmitTime       time.Time                                     `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time" yaml:"submit_time"`
+	DepositEndTime   time.Time                                     `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time" yaml:"deposit_end_time"`
+	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins      `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
+	VotingStartTime  time.Time                                     `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
+	VotingEndTime    time.Time                                     `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	Expedited        bool                                          `protobuf:"varint,10,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	Proposer         github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,11,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{5}
+	return fileDescriptor_67fb57f9a603bed5, []int{8}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +483,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{6}
+	return fileDescriptor_67fb57f9a603bed5, []int{9}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,17 +513,19 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
 // Vote defines a vote on a governance proposal. A vote corresponds to a proposal
-// ID, the voter, and the vote option.
+// ID, the voter, and the weighted vote options. The option is only set when the
+// whole voting power is assigned to a single option.
 type Vote struct {
 	ProposalID uint64                                        `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=voter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"voter,omitempty"`
 	Option     VoteOption                                    `protobuf:"varint,3,opt,name=option,proto3,enum=cosmos.gov.VoteOption" json:"option,omitempty"`
+	Options    WeightedVoteOptions                           `protobuf:"bytes,4,rep,name=options,proto3,castrepeated=WeightedVoteOptions" json:"options"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_67fb57f9a603bed5, []int{7}
+	return fileDescriptor_67fb57f9a603bed5, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -433,8 +558,11 @@ func init() {
 	proto.RegisterEnum("cosmos.gov.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.MsgSubmitProposal")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.MsgCancelProposal")
 	proto.RegisterType((*MsgVote)(nil), "cosmos.gov.MsgVote")
+	proto.RegisterType((*MsgVoteWeighted)(nil), "cosmos.gov.MsgVoteWeighted")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.MsgDeposit")
+	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "cosmos.gov.TextProposal")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.Proposal")
@@ -445,85 +573,95 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/gov.proto", fileDescriptor_67fb57f9a603bed5) }
 
 var fileDescriptor_67fb57f9a603bed5 = []byte{
-	// 1247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x6e, 0x7e, 0x3f, 0x3b, 0xc9, 0x76, 0x12, 0x25, 0xee, 0x22, 0xd6, 0x5b, 0x53, 0xa1,
-	0xa8, 0x6a, 0x9d, 0x92, 0x9e, 0x28, 0x12, 0xc2, 0x8e, 0xb7, 0xad, 0xab, 0xc6, 0xb6, 0xd6, 0x5b,
-	0x57, 0x85, 0xc3, 0x6a, 0x63, 0x4f, 0x9d, 0x05, 0x7b, 0xc7, 0xf2, 0x4e, 0x42, 0x2d, 0x2e, 0x70,
-	0x43, 0x3e, 0x95, 0x3f, 0xc0, 0x12, 0x12, 0x3d, 0x00, 0x27, 0x0e, 0xfc, 0x11, 0x11, 0xe2, 0x50,
-	0x21, 0x0e, 0x15, 0x07, 0x97, 0xa6, 0x12, 0x42, 0x1c, 0x38, 0xf4, 0xc8, 0x05, 0xb4, 0x33, 0xb3,
-	0xf5, 0xda, 0x89, 0x68, 0xcd, 0x0f, 0x21, 0x71, 0x88, 0xe4, 0x7d, 0xf3, 0x7d, 0xdf, 0x9b, 0xf7,
-	0xed, 0x9b, 0x37, 0x1b, 0x58, 0xad, 0x11, 0xbf, 0x45, 0xfc, 0xcd, 0x06, 0x39, 0x08, 0xfe, 0x32,
-	0xed, 0x0e, 0xa1, 0x04, 0x01, 0x8f, 0x66, 0x1a, 0xe4, 0x40, 0x5d, 0x11, 0x08, 0x11, 0x62, 0x00,
-	0x75, 0xb5, 0x41, 0x1a, 0x84, 0xfd, 0xdc, 0x0c, 0x7e, 0x89, 0xe8, 0x69, 0x8e, 0xb1, 0xf9, 0xc2,
-	0x08, 0x21, 0xd5, 0x20, 0xa4, 0xd1, 0xc4, 0x9b, 0xec, 0x69, 0x77, 0xff, 0xce, 0x26, 0x75, 0x5b,
-	0xd8, 0xa7, 0x4e, 0xab, 0x1d, 0x72, 0xc7, 0x01, 0x8e, 0xd7, 0xe5, 0x4b, 0xe9, 0x2f, 0x64, 0x38,
-	0xb5, 0xe3, 0x37, 0x2a, 0xfb, 0xbb, 0x2d, 0x97, 0x96, 0x3b, 0xa4, 0x4d, 0x7c, 0xa7, 0x89, 0xde,
-	0x80, 0xb9, 0x1a, 0xf1, 0x28, 0xf6, 0x68, 0x52, 0xd2, 0xa5, 0x8d, 0xf8, 0xd6, 0x6a, 0x86, 0x4b,
-	0x64, 0x42, 0x89, 0x4c, 0xd6, 0xeb, 0xe6, 0xe2, 0xdf, 0x7c, 0x7d, 0x61, 0x6e, 0x9b, 0x03, 0xcd,
-	0x90, 0x81, 0x3e, 0x92, 0x60, 0xd9, 0xf5, 0x5c, 0xea, 0x3a, 0x4d, 0xbb, 0x8e, 0xdb, 0xc4, 0x77,
-	0x69, 0x52, 0xd6, 0xa7, 0x36, 0xe2, 0x5b, 0x89, 0x8c, 0xd8, 0xf7, 0x36, 0x71, 0xbd, 0xdc, 0xf5,
-	0xc3, 0x41, 0x2a, 0xf6, 0x74, 0x90, 0x5a, 0xeb, 0x3a, 0xad, 0xe6, 0xe5, 0xf4, 0x18, 0x25, 0xfd,
-	0xe5, 0xa3, 0xd4, 0x46, 0xc3, 0xa5, 0x7b, 0xfb, 0xbb, 0x99, 0x1a, 0x69, 0x6d, 0x8e, 0x38, 0x75,
-	0xc1, 0xaf, 0xbf, 0xb7, 0x49, 0xbb, 0x6d, 0xcc, 0xa5, 0x7c, 0x73, 0x49, 0xb0, 0xf3, 0x9c, 0x8c,
-	0x76, 0x60, 0xbe, 0xcd, 0x8a, 0xc1, 0x9d, 0xe4, 0x94, 0x2e, 0x6d, 0x24, 0x72, 0xaf, 0xfd, 0x36,
-	0x48, 0x5d, 0x78, 0x01, 0xbd, 0x6c, 0xad, 0x96, 0xad, 0xd7, 0x3b, 0xd8, 0xf7, 0xcd, 0x67, 0x12,
-	0x97, 0xa7, 0x7f, 0xfe, 0x34, 0x25, 0xa5, 0x07, 0x12, 0xcc, 0xed, 0xf8, 0x8d, 0x2a, 0xa1, 0x18,
-	0x59, 0x10, 0x6f, 0x0b, 0xb7, 0x6c, 0xb7, 0xce, 0x5c, 0x9a, 0xce, 0x5d, 0x3a, 0x1a, 0xa4, 0x20,
-	0x34, 0xb1, 0x90, 0xff, 0x65, 0x90, 0x8a, 0x82, 0x9e, 0x0e, 0x52, 0x88, 0x97, 0x1a, 0x09, 0xa6,
-	0x4d, 0x08, 0x9f, 0x0a, 0x75, 0x74, 0x15, 0x66, 0x0e, 0x08, 0xc5, 0x9d, 0xa4, 0xfc, 0x57, 0xf7,
-	0xcc, 0xf9, 0x28, 0x03, 0xb3, 0xa4, 0x4d, 0x5d, 0xe2, 0xb1, 0xea, 0x97, 0xb6, 0xd6, 0x32, 0xc3,
-	0xae, 0xcb, 0x04, 0x05, 0x94, 0xd8, 0xaa, 0x29, 0x50, 0xa2, 0xc0, 0x4f, 0x64, 0x80, 0x1d, 0xbf,
-	0x11, 0x9a, 0xf8, 0xef, 0xd4, 0x58, 0x82, 0x05, 0xf1, 0x8a, 0xc9, 0xdf, 0xa8, 0x73, 0xa8, 0x81,
-	0xaa, 0x30, 0xeb, 0xb4, 0xc8, 0xbe, 0x47, 0x93, 0x53, 0x27, 0x74, 0xd9, 0xc5, 0xa0, 0xcb, 0x26,
-	0xea, 0x25, 0xa1, 0x26, 0x3c, 0xb9, 0x05, 0x09, 0x0b, 0xdf, 0x1d, 0x1e, 0x8d, 0x55, 0x98, 0xa1,
-	0x2e, 0x6d, 0x62, 0x66, 0xc7, 0x82, 0xc9, 0x1f, 0x90, 0x0e, 0xf1, 0x3a, 0xf6, 0x6b, 0x1d, 0x97,
-	0x9b, 0x2e, 0xb3, 0xb5, 0x68, 0xe8, 0xf2, 0x72, 0xa0, 0xf6, 0xdd, 0xf0, 0xbc, 0xa4, 0x7f, 0x97,
-	0x60, 0x2e, 0x74, 0xda, 0x38, 0xc9, 0xe9, 0xb3, 0xa3, 0x4e, 0xff, 0xff, 0xac, 0xfd, 0x69, 0x16,
-	0xe6, 0x9f, 0xf9, 0x9a, 0x3b, 0xc9, 0x82, 0x33, 0xc7, 0x9a, 0x4d, 0x66, 0x3d, 0xb6, 0x20, 0x46,
-	0xc6, 0x58, 0xfd, 0x91, 0xb1, 0x25, 0x4f, 0x3c, 0xb6, 0x8a, 0x30, 0xeb, 0x53, 0x87, 0xee, 0xfb,
-	0xe2, 0xc8, 0xa8, 0xd1, 0x23, 0x13, 0xee, 0xa1, 0xc2, 0x10, 0x39, 0x75, 0x38, 0xb6, 0x9e, 0x6d,
-	0x9a, 0x93, 0xd3, 0xa6, 0x50, 0x41, 0x7b, 0x80, 0xee, 0xb8, 0x9e, 0xd3, 0xb4, 0xa9, 0xd3, 0x6c,
-	0x76, 0xed, 0x0e, 0xf6, 0xf7, 0x9b, 0x34, 0x39, 0xcd, 0xf6, 0xb5, 0x1e, 0xd5, 0xb6, 0x82, 0x75,
-	0x93, 0x2d, 0xe7, 0xce, 0x88, 0x99, 0x78, 0x9a, 0x8b, 0x1f, 0x17, 0x48, 0x9b, 0x0a, 0x0b, 0x46,
-	0x48, 0xe8, 0x1d, 0x88, 0xfb, 0x6c, 0x7e, 0xdb, 0xc1, 0xe0, 0x4f, 0xce, 0xb0, 0x14, 0xea, 0xb1,
-	0xd2, 0xad, 0xf0, 0x56, 0xc8, 0x69, 0x22, 0x8b, 0xe8, 0xa7, 0x08, 0x39, 0x7d, 0xef, 0x51, 0x4a,
-	0x32, 0x81, 0x47, 0x02, 0x02, 0x72, 0x41, 0x11, 0xfd, 0x60, 0x63, 0xaf, 0xce, 0x33, 0xcc, 0x3e,
-	0x37, 0xc3, 0x2b, 0x22, 0xc3, 0x3a, 0xcf, 0x30, 0xae, 0xc0, 0xd3, 0x2c, 0x89, 0xb0, 0xe1, 0xd5,
-	0x59, 0xaa, 0x0f, 0x60, 0x91, 0x12, 0x1a, 0xb9, 0x35, 0xe6, 0x4e, 0x68, 0xba, 0x6b, 0x42, 0x79,
-	0x95, 0x2b, 0x8f, 0x10, 0x26, 0xbb, 0x33, 0x12, 0x8c, 0x1b, 0x1e, 0xc1, 0x26, 0x9c, 0x3a, 0x20,
-	0xd4, 0xf5, 0x1a, 0xc1, 0x8b, 0xec, 0x08, 0x2b, 0xe7, 0x9f, 0x5b, 0xe8, 0x59, 0xb1, 0x9d, 0x24,
-	0xdf, 0xce, 0x31, 0x09, 0x5e, 0xe9, 0x32, 0x8f, 0x57, 0x82, 0x30, 0x2b, 0xf5, 0x0e, 0x88, 0xd0,
-	0xd0, 0xd4, 0x85, 0xe7, 0xe6, 0x4a, 0x8f, 0x5e, 0x98, 0x63, 0x02, 0x3c, 0xd3, 0x22, 0x8f, 0x0a,
-	0x4b, 0xc5, 0x41, 0x3b, 0x94, 0x21, 0x1e, 0x6d, 0x98, 0xb7, 0x60, 0xaa, 0x8b, 0x7d, 0x3e, 0xc1,
-	0x72, 0x99, 0x40, 0xf5, 0x87, 0x41, 0xea, 0xd5, 0x17, 0x30, 0xae, 0xe0, 0x51, 0x33, 0xa0, 0xa2,
-	0x6b, 0x30, 0xe7, 0xec, 0xfa, 0xd4, 0x71, 0xc5, 0xac, 0x9b, 0x58, 0x25, 0xa4, 0xa3, 0x37, 0x41,
-	0xf6, 0x08, 0x3b, 0x72, 0x93, 0x8b, 0xc8, 0x1e, 0x41, 0x0d, 0x48, 0x78, 0xc4, 0x7e, 0xdf, 0xa5,
-	0x7b, 0xf6, 0x01, 0xa6, 0x84, 0x1d, 0xb0, 0x85, 0x9c, 0x31, 0x99, 0xd2, 0xd3, 0x41, 0x6a, 0x85,
-	0x9b, 0x1a, 0xd5, 0x4a, 0x9b, 0xe0, 0x91, 0x5b, 0x2e, 0xdd, 0xab, 0x62, 0x4a, 0x84, 0x95, 0xdf,
-	0x4a, 0x30, 0xcd, 0x3e, 0x00, 0xfe, 0xa1, 0x91, 0xfd, 0xdf, 0xde, 0xf8, 0xe7, 0x7e, 0x95, 0x00,
-	0x86, 0x8b, 0xe8, 0x3c, 0xac, 0x57, 0x4b, 0x96, 0x61, 0x97, 0xca, 0x56, 0xa1, 0x54, 0xb4, 0x6f,
-	0x16, 0x2b, 0x65, 0x63, 0xbb, 0x70, 0xa5, 0x60, 0xe4, 0x95, 0x98, 0xba, 0xdc, 0xeb, 0xeb, 0x71,
-	0x0e, 0x34, 0x5a, 0x6d, 0xda, 0x45, 0x69, 0x58, 0x8e, 0xa2, 0x6f, 0x1b, 0x15, 0x45, 0x52, 0x17,
-	0x7b, 0x7d, 0x7d, 0x81, 0xa3, 0x6e, 0x63, 0x1f, 0x9d, 0x83, 0x95, 0x28, 0x26, 0x9b, 0xab, 0x58,
-	0xd9, 0x42, 0x51, 0x91, 0xd5, 0x53, 0xbd, 0xbe, 0xbe, 0xc8, 0x71, 0x59, 0xd1, 0x0a, 0x3a, 0x2c,
-	0x45, 0xb1, 0xc5, 0x92, 0x32, 0xa5, 0x26, 0x7a, 0x7d, 0x7d, 0x9e, 0xc3, 0x8a, 0x04, 0x6d, 0x41,
-	0x72, 0x14, 0x61, 0xdf, 0x2a, 0x58, 0xd7, 0xec, 0xaa, 0x61, 0x95, 0x94, 0x69, 0x75, 0xb5, 0xd7,
-	0xd7, 0x95, 0x10, 0x1b, 0xbe, 0x37, 0x35, 0xf1, 0xf1, 0x67, 0x5a, 0xec, 0xf3, 0xfb, 0x5a, 0xec,
-	0xab, 0xfb, 0x5a, 0xec, 0xdc, 0xf7, 0x32, 0x2c, 0x8d, 0x0e, 0x73, 0x94, 0x81, 0x97, 0xca, 0x66,
-	0xa9, 0x5c, 0xaa, 0x64, 0x6f, 0xd8, 0x15, 0x2b, 0x6b, 0xdd, 0xac, 0x8c, 0x15, 0xce, 0x4a, 0xe2,
-	0xe0, 0xa2, 0x1b, 0x7c, 0x1c, 0x6b, 0xe3, 0xf8, 0xbc, 0x51, 0x2e, 0x55, 0x0a, 0x96, 0x5d, 0x36,
-	0xcc, 0x42, 0x29, 0xaf, 0x48, 0xea, 0x7a, 0xaf, 0xaf, 0xaf, 0x70, 0x8a, 0x18, 0x30, 0x65, 0xdc,
-	0x71, 0x49, 0x1d, 0xbd, 0x0e, 0x2f, 0x8f, 0x93, 0xab, 0x25, 0xab, 0x50, 0xbc, 0x1a, 0x72, 0x65,
-	0x75, 0xad, 0xd7, 0xd7, 0x11, 0xe7, 0x56, 0xd9, 0x61, 0x16, 0xd4, 0xf3, 0xb0, 0x36, 0x4e, 0x2d,
-	0x67, 0x2b, 0x15, 0x23, 0xaf, 0x4c, 0xa9, 0x4a, 0xaf, 0xaf, 0x27, 0x38, 0xa7, 0xec, 0xf8, 0x3e,
-	0xae, 0xa3, 0x8b, 0x90, 0x1c, 0x47, 0x9b, 0xc6, 0x75, 0x63, 0xdb, 0x32, 0xf2, 0xca, 0xb4, 0x8a,
-	0x7a, 0x7d, 0x7d, 0x89, 0xe3, 0x4d, 0xfc, 0x2e, 0xae, 0x51, 0x7c, 0xa2, 0xfe, 0x95, 0x6c, 0xe1,
-	0x86, 0x91, 0x57, 0x66, 0xa2, 0xfa, 0x57, 0x1c, 0xb7, 0x89, 0xeb, 0xa3, 0xb6, 0xe6, 0x8a, 0x87,
-	0x8f, 0xb5, 0xd8, 0xc3, 0xc7, 0x5a, 0xec, 0xc3, 0x23, 0x2d, 0x76, 0x78, 0xa4, 0x49, 0x0f, 0x8e,
-	0x34, 0xe9, 0xc7, 0x23, 0x4d, 0xba, 0xf7, 0x44, 0x8b, 0x3d, 0x78, 0xa2, 0xc5, 0x1e, 0x3e, 0xd1,
-	0x62, 0x6f, 0xff, 0xf9, 0x6c, 0xbe, 0xcb, 0xfe, 0x51, 0x62, 0xfd, 0xbd, 0x3b, 0xcb, 0xc6, 0xdf,
-	0xa5, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xa5, 0x92, 0x7f, 0x43, 0x0d, 0x00, 0x00,
+	// 1395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xb1, 0x6f, 0xdb, 0x46,
+	0x17, 0x17, 0x29, 0xdb, 0xb2, 0x4e, 0xb2, 0xad, 0x9c, 0x0d, 0x5b, 0x61, 0xbe, 0x8f, 0x64, 0xf4,
+	0x05, 0x1f, 0x8c, 0x20, 0x91, 0xf3, 0x39, 0xd3, 0x97, 0x02, 0x6d, 0x45, 0x8b, 0x49, 0x14, 0xc4,
+	0x92, 0x40, 0x31, 0x32, 0xd2, 0x0e, 0x04, 0x2d, 0x5e, 0x64, 0xb6, 0x12, 0x4f, 0x10, 0xcf, 0x4e,
+	0x84, 0x2e, 0xed, 0x56, 0x08, 0x28, 0x90, 0xfe, 0x01, 0x02, 0x0a, 0x24, 0x43, 0x91, 0xa9, 0x43,
+	0xe7, 0xae, 0x35, 0x3a, 0x05, 0x6d, 0x87, 0xa0, 0x83, 0xd2, 0x38, 0x4b, 0xd1, 0xa1, 0x83, 0xc7,
+	0x2e, 0x2d, 0x74, 0x77, 0xb4, 0x28, 0xd9, 0x68, 0xec, 0x26, 0x41, 0x8b, 0x0e, 0x06, 0xc8, 0x77,
+	0xbf, 0xdf, 0xef, 0xde, 0x7b, 0x7c, 0xf7, 0xee, 0x59, 0x60, 0xa1, 0x86, 0xfd, 0x26, 0xf6, 0x57,
+	0xea, 0x78, 0x67, 0xf0, 0x97, 0x6d, 0xb5, 0x31, 0xc1, 0x10, 0x30, 0x6b, 0xb6, 0x8e, 0x77, 0xa4,
+	0x79, 0x8e, 0xe0, 0x26, 0x0a, 0x90, 0x16, 0xea, 0xb8, 0x8e, 0xe9, 0xe3, 0xca, 0xe0, 0x89, 0x5b,
+	0x4f, 0x33, 0x8c, 0xc5, 0x16, 0x46, 0x08, 0x4a, 0x1d, 0xe3, 0x7a, 0x03, 0xad, 0xd0, 0xb7, 0xcd,
+	0xed, 0x3b, 0x2b, 0xc4, 0x6d, 0x22, 0x9f, 0xd8, 0xcd, 0x56, 0xc0, 0x1d, 0x07, 0xd8, 0x5e, 0x87,
+	0x2d, 0x65, 0xbe, 0x16, 0xc1, 0xa9, 0x75, 0xbf, 0x5e, 0xd9, 0xde, 0x6c, 0xba, 0xa4, 0xdc, 0xc6,
+	0x2d, 0xec, 0xdb, 0x0d, 0xf8, 0x06, 0x88, 0xd5, 0xb0, 0x47, 0x90, 0x47, 0xd2, 0x82, 0x2a, 0x2c,
+	0x27, 0x56, 0x17, 0xb2, 0x4c, 0x22, 0x1b, 0x48, 0x64, 0x73, 0x5e, 0x47, 0x4b, 0x7c, 0xf3, 0xe5,
+	0xc5, 0xd8, 0x1a, 0x03, 0x1a, 0x01, 0x03, 0x7e, 0x24, 0x80, 0x39, 0xd7, 0x73, 0x89, 0x6b, 0x37,
+	0x2c, 0x07, 0xb5, 0xb0, 0xef, 0x92, 0xb4, 0xa8, 0x46, 0x97, 0x13, 0xab, 0xc9, 0x2c, 0xf7, 0x7b,
+	0x0d, 0xbb, 0x9e, 0x76, 0x63, 0xb7, 0xaf, 0x44, 0xf6, 0xfb, 0xca, 0x62, 0xc7, 0x6e, 0x36, 0xae,
+	0x64, 0xc6, 0x28, 0x99, 0x47, 0x4f, 0x95, 0xe5, 0xba, 0x4b, 0xb6, 0xb6, 0x37, 0xb3, 0x35, 0xdc,
+	0x5c, 0x19, 0xc9, 0xd4, 0x45, 0xdf, 0x79, 0x7f, 0x85, 0x74, 0x5a, 0x88, 0x49, 0xf9, 0xc6, 0x2c,
+	0x67, 0xe7, 0x19, 0x19, 0xae, 0x83, 0xe9, 0x16, 0x0d, 0x06, 0xb5, 0xd3, 0x51, 0x55, 0x58, 0x4e,
+	0x6a, 0xff, 0xfb, 0xb5, 0xaf, 0x5c, 0x3c, 0x86, 0x5e, 0xae, 0x56, 0xcb, 0x39, 0x4e, 0x1b, 0xf9,
+	0xbe, 0x71, 0x20, 0x01, 0xff, 0x05, 0xe2, 0xe8, 0x5e, 0x0b, 0x39, 0x2e, 0x41, 0x4e, 0x7a, 0x42,
+	0x15, 0x96, 0xa7, 0x8d, 0xa1, 0xe1, 0xca, 0xc4, 0x4f, 0x9f, 0x29, 0x42, 0xe6, 0x2b, 0x81, 0x66,
+	0x72, 0xcd, 0xf6, 0x6a, 0xa8, 0x71, 0x90, 0x49, 0x13, 0x24, 0x5a, 0xfc, 0xd9, 0x72, 0x1d, 0x9a,
+	0xcd, 0x09, 0xed, 0xf2, 0x5e, 0x5f, 0x01, 0x01, 0xa4, 0x90, 0xff, 0xb9, 0xaf, 0x84, 0x41, 0xfb,
+	0x7d, 0x05, 0xb2, 0x94, 0x84, 0x8c, 0x19, 0x03, 0x04, 0x6f, 0x05, 0x67, 0x24, 0x3c, 0xf1, 0xa5,
+	0xc3, 0xe3, 0x01, 0xf4, 0x05, 0x10, 0x5b, 0xf7, 0xeb, 0x55, 0x4c, 0xd0, 0x6b, 0x72, 0xfb, 0x1a,
+	0x98, 0xdc, 0xc1, 0xe4, 0x65, 0x7c, 0x66, 0x7c, 0x98, 0x05, 0x53, 0xb8, 0x45, 0x5c, 0xec, 0xd1,
+	0x8f, 0x3b, 0xbb, 0xba, 0x98, 0x1d, 0x1e, 0xaa, 0xec, 0x20, 0x80, 0x12, 0x5d, 0x35, 0x38, 0x8a,
+	0x07, 0xf8, 0x89, 0x08, 0xe6, 0x78, 0x80, 0x1b, 0xc8, 0xad, 0x6f, 0x11, 0xe4, 0xfc, 0xdd, 0x03,
+	0xbd, 0x05, 0x62, 0x2c, 0x04, 0x3f, 0x1d, 0xa5, 0x47, 0x48, 0x0e, 0x47, 0x1a, 0x44, 0x31, 0x8c,
+	0x58, 0x3b, 0x33, 0x38, 0x54, 0x8f, 0x9e, 0x2a, 0xf3, 0x87, 0xd7, 0x7c, 0x23, 0xd0, 0xe2, 0xf9,
+	0xf8, 0x54, 0x04, 0x60, 0xdd, 0xaf, 0x07, 0x67, 0xe6, 0xf5, 0xa4, 0xa2, 0x04, 0xe2, 0xfc, 0x44,
+	0xe3, 0x97, 0x48, 0xc7, 0x50, 0x03, 0x56, 0xc1, 0x94, 0xdd, 0xc4, 0xdb, 0x1e, 0x49, 0x47, 0x8f,
+	0x68, 0x2a, 0x97, 0x78, 0xfc, 0xc7, 0x6f, 0x1d, 0x5c, 0x8d, 0xe7, 0xe4, 0x81, 0x00, 0xe0, 0xe1,
+	0xd4, 0x85, 0x0a, 0x4e, 0x38, 0x4e, 0xc1, 0xc1, 0x0d, 0x30, 0x75, 0x97, 0xaa, 0xd0, 0x90, 0xe3,
+	0xda, 0x5b, 0x03, 0xb7, 0x7e, 0xe8, 0x2b, 0xff, 0x3d, 0x86, 0x5b, 0x79, 0x54, 0xdb, 0xef, 0x2b,
+	0x33, 0x2c, 0xaf, 0x4c, 0x25, 0x63, 0x70, 0x39, 0xee, 0xe5, 0x06, 0x48, 0x9a, 0xe8, 0xde, 0xb0,
+	0x5f, 0x2f, 0x80, 0x49, 0xe2, 0x92, 0x06, 0xa2, 0xde, 0xc5, 0x0d, 0xf6, 0x02, 0x55, 0x90, 0x70,
+	0x90, 0x5f, 0x6b, 0xbb, 0xcc, 0x73, 0xea, 0x89, 0x11, 0x36, 0x5d, 0x99, 0x1b, 0xa8, 0x7d, 0x3b,
+	0x6c, 0xe2, 0x99, 0xdf, 0x04, 0x10, 0x0b, 0xea, 0x41, 0x3f, 0xaa, 0x1e, 0xce, 0x8d, 0xd6, 0xc3,
+	0x3f, 0xaf, 0x00, 0xbe, 0x8b, 0x81, 0xe9, 0x83, 0xbc, 0x6a, 0x47, 0xa5, 0xe0, 0xec, 0xa1, 0x23,
+	0x21, 0xd2, 0x93, 0x10, 0xe7, 0xf7, 0xd8, 0x58, 0xfc, 0xa1, 0xbb, 0x54, 0x3c, 0xf1, 0x5d, 0x5a,
+	0x04, 0x53, 0x3e, 0xb1, 0xc9, 0xb6, 0xcf, 0x1b, 0x9d, 0x14, 0xae, 0xbb, 0xc0, 0x87, 0x0a, 0x45,
+	0x68, 0xd2, 0xf0, 0x2e, 0x3d, 0x70, 0x9a, 0x91, 0x33, 0x06, 0x57, 0x81, 0x5b, 0x00, 0xde, 0x71,
+	0x3d, 0xbb, 0x61, 0x11, 0xbb, 0xd1, 0xe8, 0x58, 0x6d, 0xe4, 0x6f, 0x37, 0x08, 0xbd, 0xd1, 0x12,
+	0xab, 0x4b, 0x61, 0x6d, 0x73, 0xb0, 0x6e, 0xd0, 0x65, 0xed, 0x2c, 0xbf, 0xa8, 0x4f, 0x33, 0xf1,
+	0xc3, 0x02, 0x19, 0x23, 0x45, 0x8d, 0x21, 0x12, 0x7c, 0x17, 0x24, 0x7c, 0x3a, 0x54, 0x58, 0x83,
+	0x69, 0x24, 0x3d, 0x49, 0xb7, 0x90, 0x0e, 0x85, 0x6e, 0x06, 0xa3, 0x8a, 0x26, 0xf3, 0x5d, 0x78,
+	0x3d, 0x85, 0xc8, 0x99, 0xfb, 0x4f, 0x15, 0xc1, 0x00, 0xcc, 0x32, 0x20, 0x40, 0x17, 0xa4, 0x78,
+	0x3d, 0x58, 0xc8, 0x73, 0xd8, 0x0e, 0x53, 0x2f, 0xdc, 0xe1, 0x3f, 0x7c, 0x87, 0x25, 0xb6, 0xc3,
+	0xb8, 0x02, 0xdb, 0x66, 0x96, 0x9b, 0x75, 0xcf, 0xa1, 0x5b, 0x7d, 0x00, 0x66, 0x08, 0x26, 0xa1,
+	0x51, 0x26, 0x76, 0x44, 0xd1, 0x5d, 0xe7, 0xca, 0x0b, 0x4c, 0x79, 0x84, 0x70, 0xb2, 0x41, 0x26,
+	0x49, 0xb9, 0xc1, 0x11, 0x6c, 0x80, 0x53, 0x3b, 0x98, 0xb8, 0x5e, 0x7d, 0xf0, 0x21, 0xdb, 0x3c,
+	0x95, 0xd3, 0x2f, 0x0c, 0xf4, 0x1c, 0x77, 0x27, 0xcd, 0xdc, 0x39, 0x24, 0xc1, 0x22, 0x9d, 0x63,
+	0xf6, 0xca, 0xc0, 0x4c, 0x43, 0xbd, 0x03, 0xb8, 0x69, 0x98, 0xd4, 0xf8, 0x0b, 0xf7, 0xca, 0x8c,
+	0x4e, 0x71, 0x63, 0x02, 0x6c, 0xa7, 0x19, 0x66, 0x0d, 0x52, 0x3a, 0x32, 0x4d, 0x81, 0xb1, 0x69,
+	0x6a, 0x64, 0xb6, 0x49, 0xbc, 0xaa, 0xd9, 0x66, 0x57, 0x04, 0x89, 0x70, 0x75, 0xbe, 0x0d, 0xa2,
+	0x1d, 0xe4, 0xb3, 0x76, 0xa9, 0x65, 0x4f, 0xd0, 0x9c, 0x0b, 0x1e, 0x31, 0x06, 0x54, 0x78, 0x1d,
+	0xc4, 0xec, 0x4d, 0x9f, 0xd8, 0x2e, 0x6f, 0xac, 0x27, 0x56, 0x09, 0xe8, 0xf0, 0x4d, 0x20, 0x7a,
+	0x38, 0x1d, 0xfd, 0x53, 0x22, 0xa2, 0x87, 0x61, 0x1d, 0x24, 0x3d, 0x6c, 0xdd, 0x75, 0xc9, 0x96,
+	0xb5, 0x83, 0x08, 0xa6, 0xa7, 0x39, 0xae, 0xe9, 0x27, 0x53, 0xda, 0xef, 0x2b, 0xf3, 0xec, 0x0b,
+	0x86, 0xb5, 0x32, 0x06, 0xf0, 0xf0, 0x86, 0x4b, 0xb6, 0xaa, 0x88, 0xe0, 0xe0, 0x86, 0x14, 0xc1,
+	0x04, 0x9d, 0x11, 0x5f, 0xd1, 0xfd, 0xf0, 0x57, 0x0d, 0x85, 0xe1, 0xd9, 0x6a, 0xe2, 0x55, 0xcf,
+	0x56, 0xe7, 0x7f, 0x11, 0x00, 0x18, 0x2e, 0xc3, 0x0b, 0x60, 0xa9, 0x5a, 0x32, 0x75, 0xab, 0x54,
+	0x36, 0x0b, 0xa5, 0xa2, 0x75, 0xab, 0x58, 0x29, 0xeb, 0x6b, 0x85, 0xab, 0x05, 0x3d, 0x9f, 0x8a,
+	0x48, 0x73, 0xdd, 0x9e, 0x9a, 0x60, 0x40, 0xbd, 0xd9, 0x22, 0x1d, 0x98, 0x01, 0x73, 0x61, 0xf4,
+	0x6d, 0xbd, 0x92, 0x12, 0xa4, 0x99, 0x6e, 0x4f, 0x8d, 0x33, 0xd4, 0x6d, 0xe4, 0xc3, 0xf3, 0x60,
+	0x3e, 0x8c, 0xc9, 0x69, 0x15, 0x33, 0x57, 0x28, 0xa6, 0x44, 0xe9, 0x54, 0xb7, 0xa7, 0xce, 0x30,
+	0x5c, 0x8e, 0x57, 0x98, 0x0a, 0x66, 0xc3, 0xd8, 0x62, 0x29, 0x15, 0x95, 0x92, 0xdd, 0x9e, 0x3a,
+	0xcd, 0x60, 0x45, 0x0c, 0x57, 0x41, 0x7a, 0x14, 0x61, 0x6d, 0x14, 0xcc, 0xeb, 0x56, 0x55, 0x37,
+	0x4b, 0xa9, 0x09, 0x69, 0xa1, 0xdb, 0x53, 0x53, 0x01, 0x36, 0x28, 0x07, 0x29, 0xf9, 0xf1, 0x03,
+	0x39, 0xf2, 0xf9, 0x43, 0x39, 0xf2, 0xc5, 0x43, 0x39, 0x72, 0xfe, 0x7b, 0x11, 0xcc, 0x8e, 0x5e,
+	0x48, 0x30, 0x0b, 0xce, 0x94, 0x8d, 0x52, 0xb9, 0x54, 0xc9, 0xdd, 0xb4, 0x2a, 0x66, 0xce, 0xbc,
+	0x55, 0x19, 0x0b, 0x9c, 0x86, 0xc4, 0xc0, 0x45, 0x77, 0xf0, 0x5f, 0xa7, 0x3c, 0x8e, 0xcf, 0xeb,
+	0xe5, 0x52, 0xa5, 0x60, 0x5a, 0x65, 0xdd, 0x28, 0x94, 0xf2, 0x29, 0x41, 0x5a, 0xea, 0xf6, 0xd4,
+	0x79, 0x46, 0xe1, 0x4d, 0xb2, 0x8c, 0xda, 0x2e, 0x76, 0xe0, 0xff, 0xc1, 0xbf, 0xc7, 0xc9, 0xd5,
+	0x92, 0x59, 0x28, 0x5e, 0x0b, 0xb8, 0xa2, 0xb4, 0xd8, 0xed, 0xa9, 0x90, 0x71, 0xab, 0xb4, 0x21,
+	0x71, 0xea, 0x05, 0xb0, 0x38, 0x4e, 0x2d, 0xe7, 0x2a, 0x15, 0x3d, 0x9f, 0x8a, 0x4a, 0xa9, 0x6e,
+	0x4f, 0x4d, 0x32, 0x4e, 0xd9, 0xf6, 0x7d, 0xe4, 0xc0, 0x4b, 0x20, 0x3d, 0x8e, 0x36, 0xf4, 0x1b,
+	0xfa, 0x9a, 0xa9, 0xe7, 0x53, 0x13, 0x12, 0xec, 0xf6, 0xd4, 0x59, 0x86, 0x37, 0xd0, 0x7b, 0xa8,
+	0x46, 0xd0, 0x91, 0xfa, 0x57, 0x73, 0x85, 0x9b, 0x7a, 0x3e, 0x35, 0x19, 0xd6, 0xbf, 0x6a, 0xbb,
+	0x0d, 0xe4, 0x8c, 0xa6, 0x55, 0x2b, 0xee, 0x3e, 0x93, 0x23, 0x4f, 0x9e, 0xc9, 0x91, 0x0f, 0xf7,
+	0xe4, 0xc8, 0xee, 0x9e, 0x2c, 0x3c, 0xde, 0x93, 0x85, 0x1f, 0xf7, 0x64, 0xe1, 0xfe, 0x73, 0x39,
+	0xf2, 0xf8, 0xb9, 0x1c, 0x79, 0xf2, 0x5c, 0x8e, 0xbc, 0xf3, 0xc7, 0xf7, 0xcb, 0x3d, 0xfa, 0x0b,
+	0x04, 0x3d, 0x36, 0x9b, 0x53, 0xb4, 0x85, 0x5f, 0xfe, 0x7d, 0x00, 0x29, 0x27, 0x98, 0x2c, 0x9c,
+	0x10, 0x00, 0x00,
 }
 
 func (this *MsgSubmitProposal) Equal(that interface{}) bool {
@@ -559,6 +697,36 @@ func (this *MsgSubmitProposal) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Proposer, that1.Proposer) {
 		return false
 	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	return true
+}
+func (this *MsgCancelProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgCancelProposal)
+	if !ok {
+		that2, ok := that.(MsgCancelProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Proposer, that1.Proposer) {
+		return false
+	}
 	return true
 }
 func (this *MsgVote) Equal(that interface{}) bool {
@@ -591,6 +759,41 @@ func (this *MsgVote) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgVoteWeighted) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgVoteWeighted)
+	if !ok {
+		that2, ok := that.(MsgVoteWeighted)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalID != that1.ProposalID {
+		return false
+	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *MsgDeposit) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -626,6 +829,33 @@ func (this *MsgDeposit) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *WeightedVoteOption) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WeightedVoteOption)
+	if !ok {
+		that2, ok := that.(WeightedVoteOption)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Option != that1.Option {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
 func (this *TextProposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -739,6 +969,12 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
+	if this.Expedited != that1.Expedited {
+		return false
+	}
+	if !bytes.Equal(this.Proposer, that1.Proposer) {
+		return false
+	}
 	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
@@ -802,6 +1038,14 @@ func (this *Vote) Equal(that interface{}) bool {
 	if this.Option != that1.Option {
 		return false
 	}
+	if len(this.Options) != len(that1.Options) {
+		return false
+	}
+	for i := range this.Options {
+		if !this.Options[i].Equal(&that1.Options[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
@@ -824,6 +1068,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Proposer) > 0 {
 		i -= len(m.Proposer)
 		copy(dAtA[i:], m.Proposer)
@@ -860,7 +1114,7 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgVote) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -870,25 +1124,20 @@ func (m *MsgVote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Option != 0 {
-		i = encodeVarintGov(dAtA, i, uint64(m.Option))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -900,7 +1149,7 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+func (m *MsgVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -910,34 +1159,25 @@ func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGov(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -949,7 +1189,7 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteWeighted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -959,34 +1199,46 @@ func (m *TextProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -996,12 +1248,136 @@ func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalID != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1055,6 +1431,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
 	if err2 != nil {
 		return 0, err2
@@ -1219,6 +1612,20 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Option != 0 {
 		i = encodeVarintGov(dAtA, i, uint64(m.Option))
 		i--
@@ -1270,6 +1677,25 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1292,6 +1718,28 @@ func (m *MsgVote) Size() (n int) {
 	return n
 }
 
+func (m *MsgVoteWeighted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalID != 0 {
+		n += 1 + sovGov(uint64(m.ProposalID))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgDeposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1314,6 +1762,20 @@ func (m *MsgDeposit) Size() (n int) {
 	return n
 }
 
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *TextProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1385,6 +1847,13 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1421,16 +1890,430 @@ func (m *Vote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovGov(uint64(m.Option))
 	}
-	return n
-}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			m.ProposalID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1453,17 +2336,17 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var msglen int
+			m.ProposalID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1473,33 +2356,16 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Content == nil {
-				m.Content = &types.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1509,31 +2375,31 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1543,24 +2409,24 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proposer == nil {
-				m.Proposer = []byte{}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1587,7 +2453,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1610,10 +2476,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1637,7 +2503,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1664,16 +2530,16 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
+			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Depositor == nil {
+				m.Depositor = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1683,11 +2549,26 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -1712,7 +2593,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1735,17 +2616,17 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.ProposalID = 0
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1755,50 +2636,16 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalID |= uint64(b&0x7F) << shift
+				m.Option |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depositor = append(m.Depositor[:0], dAtA[iNdEx:postIndex]...)
-			if m.Depositor == nil {
-				m.Depositor = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1808,23 +2655,23 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2411,6 +3258,60 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2725,6 +3626,40 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
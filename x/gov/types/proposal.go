@@ -18,7 +18,9 @@ import (
 const DefaultStartingProposalID uint64 = 1
 
 // NewProposal creates a new Proposal instance
-func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(
+	content Content, id uint64, proposer sdk.AccAddress, submitTime, depositEndTime time.Time, expedited bool,
+) (Proposal, error) {
 	p := Proposal{
 		ProposalID:       id,
 		Status:           StatusDepositPeriod,
@@ -26,6 +28,8 @@ func NewProposal(content Content, id uint64, submitTime, depositEndTime time.Tim
 		TotalDeposit:     sdk.NewCoins(),
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer,
 	}
 
 	msg, ok := content.(proto.Message)
@@ -3,15 +3,23 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// NewVote creates a new Vote instance
-func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+// NewVote creates a new Vote instance. The single option of the vote is set
+// when the whole voting power is assigned to it.
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	vote := Vote{ProposalID: proposalID, Voter: voter, Options: options}
+	if len(options) == 1 && options[0].Weight.Equal(sdk.OneDec()) {
+		vote.Option = options[0].Option
+	}
+
+	return vote
 }
 
 func (v Vote) String() string {
@@ -43,7 +51,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Options)
 	}
 	return out
 }
@@ -53,6 +61,101 @@ func (v Vote) Empty() bool {
 	return v.Equal(Vote{})
 }
 
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{Option: option, Weight: weight}
+}
+
+// NewNonSplitVoteOption returns the weighted options of a vote that assigns
+// the whole voting power to a single option.
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// String implements the Stringer interface.
+func (w WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", w.Option, w.Weight)
+}
+
+// WeightedVoteOptions describes the split of a vote's voting power across
+// vote options
+type WeightedVoteOptions []WeightedVoteOption
+
+// String implements the Stringer interface.
+func (v WeightedVoteOptions) String() string {
+	out := make([]string, len(v))
+	for i, option := range v {
+		out[i] = option.String()
+	}
+
+	return strings.Join(out, ",")
+}
+
+// ValidWeightedVoteOption returns true if the option is valid and its weight
+// is positive and at most one.
+func ValidWeightedVoteOption(option WeightedVoteOption) bool {
+	if !ValidVoteOption(option.Option) {
+		return false
+	}
+
+	return !option.Weight.IsNil() && option.Weight.IsPositive() && option.Weight.LTE(sdk.OneDec())
+}
+
+// ValidateWeightedVoteOptions returns an error if any of the options is
+// invalid or repeated, or if the weights do not sum up to one.
+func ValidateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVote, "no vote options")
+	}
+
+	totalWeight := sdk.ZeroDec()
+	seen := make(map[VoteOption]bool, len(options))
+	for _, option := range options {
+		if !ValidWeightedVoteOption(option) {
+			return sdkerrors.Wrap(ErrInvalidVote, option.String())
+		}
+		if seen[option.Option] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote option %s", option.Option)
+		}
+
+		seen[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidVote, "total weight of vote options %s is not one", totalWeight)
+	}
+
+	return nil
+}
+
+// WeightedVoteOptionsFromString returns weighted vote options from a string
+// of comma separated option=weight pairs, eg. "yes=0.6,no=0.4". It returns an
+// error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, pair := range strings.Split(str, ",") {
+		fields := strings.Split(strings.TrimSpace(pair), "=")
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", pair)
+		}
+
+		option, err := VoteOptionFromString(fields[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight, err := sdk.NewDecFromStr(fields[1])
+		if err != nil {
+			return nil, err
+		}
+
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+
+	return options, nil
+}
+
 // VoteOptionFromString returns a VoteOption from a string. It returns an error
 // if the string is invalid.
 func VoteOptionFromString(str string) (VoteOption, error) {
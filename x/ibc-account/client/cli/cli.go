@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// GetQueryCmd returns the query commands for IBC interchain accounts
+func GetQueryCmd(clientCtx client.Context) *cobra.Command {
+	ics27AccountQueryCmd := &cobra.Command{
+		Use:                        "ibc-account",
+		Short:                      "IBC interchain accounts query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ics27AccountQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryInterchainAccount(clientCtx),
+		GetCmdQueryParams(clientCtx),
+	)...)
+
+	return ics27AccountQueryCmd
+}
+
+// NewTxCmd returns the transaction commands for IBC interchain accounts
+func NewTxCmd(clientCtx client.Context) *cobra.Command {
+	ics27AccountTxCmd := &cobra.Command{
+		Use:                        "ibc-account",
+		Short:                      "IBC interchain accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	ics27AccountTxCmd.AddCommand(flags.PostCommands(
+		NewRegisterAccountTxCmd(clientCtx),
+		NewSendTxCmd(clientCtx),
+	)...)
+
+	return ics27AccountTxCmd
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// GetCmdQueryInterchainAccount defines the command to query the interchain
+// account registered through a controller channel.
+func GetCmdQueryInterchainAccount(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "account [port-id] [channel-id]",
+		Short:   "Query the owner and address of the interchain account registered through a channel",
+		Long:    "Query the owner and address of the interchain account registered through a channel",
+		Example: fmt.Sprintf("%s query ibc-account account [port-id] [channel-id]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			req := &types.QueryInterchainAccountRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.InterchainAccount(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryParams defines the command to query the interchain accounts
+// parameters.
+func GetCmdQueryParams(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current interchain accounts parameters",
+		Long:    "Query the current interchain accounts parameters, including the messages allowed on the host chain",
+		Example: fmt.Sprintf("%s query ibc-account params", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.Params)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+const (
+	flagTimeoutHeight    = "timeout-height"
+	flagTimeoutTimestamp = "timeout-timestamp"
+)
+
+// NewRegisterAccountTxCmd returns the command to create a MsgRegisterAccount transaction
+func NewRegisterAccountTxCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [src-port] [src-channel]",
+		Short: "Register an interchain account on the host chain of a channel",
+		Long: "Register an interchain account on the host chain of a channel. The channel must have been opened\n" +
+			"for the sender, with the \"ics27-1|{owner}\" version.",
+		Example: fmt.Sprintf("%s tx ibc-account register [src-port] [src-channel]", version.ClientName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			owner := clientCtx.GetFromAddress()
+			timeoutHeight := viper.GetUint64(flagTimeoutHeight)
+			timeoutTimestamp := viper.GetUint64(flagTimeoutTimestamp)
+
+			msg := types.NewMsgRegisterAccount(args[0], args[1], owner, timeoutHeight, timeoutTimestamp)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+	cmd.Flags().Uint64(flagTimeoutHeight, 0, "Absolute timeout block height. The timeout is disabled when set to 0.")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "Absolute timeout timestamp in nanoseconds. The timeout is disabled when set to 0.")
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx transaction
+func NewSendTxCmd(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [src-port] [src-channel] [msgs-file]",
+		Short: "Execute messages with the interchain account registered through a channel",
+		Long: "Execute messages with the interchain account registered through a channel. The file contains the\n" +
+			"JSON encoded list of messages, which must be signed by the interchain account on the host chain.",
+		Example: fmt.Sprintf("%s tx ibc-account send-tx [src-port] [src-channel] msgs.json", version.ClientName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx = clientCtx.InitWithInput(cmd.InOrStdin())
+
+			bz, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &msgs); err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress()
+			timeoutHeight := viper.GetUint64(flagTimeoutHeight)
+			timeoutTimestamp := viper.GetUint64(flagTimeoutTimestamp)
+
+			msg, err := types.NewMsgSendTx(args[0], args[1], owner, msgs, timeoutHeight, timeoutTimestamp)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTx(clientCtx, msg)
+		},
+	}
+	cmd.Flags().Uint64(flagTimeoutHeight, 0, "Absolute timeout block height. The timeout is disabled when set to 0.")
+	cmd.Flags().Uint64(flagTimeoutTimestamp, 0, "Absolute timeout timestamp in nanoseconds. The timeout is disabled when set to 0.")
+	return cmd
+}
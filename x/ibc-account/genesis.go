@@ -0,0 +1,32 @@
+package ibcaccount
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// InitGenesis binds to portid from genesis state and sets the params
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, state types.GenesisState) {
+	keeper.SetPort(ctx, state.PortID)
+	keeper.SetParams(ctx, state.Params)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !keeper.IsBound(ctx, state.PortID) {
+		// interchain accounts module binds to the port on InitChain
+		// and claims the returned capability
+		err := keeper.BindPort(ctx, state.PortID)
+		if err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports interchain accounts module's portID and params into
+// its genesis state
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
+	return types.NewGenesisState(keeper.GetPort(ctx), keeper.GetParams(ctx))
+}
@@ -0,0 +1,71 @@
+package ibcaccount
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/keeper"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// NewHandler returns sdk.Handler for IBC interchain accounts module messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case *types.MsgRegisterAccount:
+			return handleMsgRegisterAccount(ctx, k, msg)
+		case *types.MsgSendTx:
+			return handleMsgSendTx(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ICS-27 interchain accounts message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgRegisterAccount(ctx sdk.Context, k keeper.Keeper, msg *types.MsgRegisterAccount) (*sdk.Result, error) {
+	if err := k.RegisterInterchainAccount(
+		ctx, msg.Owner, msg.SourcePort, msg.SourceChannel, msg.TimeoutHeight, msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC interchain account registration", "owner", msg.Owner, "port", msg.SourcePort, "channel", msg.SourceChannel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
+func handleMsgSendTx(ctx sdk.Context, k keeper.Keeper, msg *types.MsgSendTx) (*sdk.Result, error) {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SendTx(
+		ctx, msg.Owner, msg.SourcePort, msg.SourceChannel, msgs, msg.TimeoutHeight, msg.TimeoutTimestamp,
+	); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
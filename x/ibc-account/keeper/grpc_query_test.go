@@ -0,0 +1,48 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryInterchainAccount() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx)
+	types.RegisterQueryServer(queryHelper, suite.app.IBCAccountKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	req := &types.QueryInterchainAccountRequest{PortId: types.PortID, ChannelId: testChannel}
+
+	_, err := queryClient.InterchainAccount(gocontext.Background(), req)
+	suite.Require().Error(err) // account not found
+
+	_, err = queryClient.InterchainAccount(gocontext.Background(), &types.QueryInterchainAccountRequest{PortId: "(invalidport)", ChannelId: testChannel})
+	suite.Require().Error(err)
+
+	// the address is empty until the registration is acknowledged
+	suite.app.IBCAccountKeeper.SetOwner(suite.ctx, types.PortID, testChannel, testAddr1)
+	res, err := queryClient.InterchainAccount(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testAddr1, res.Owner)
+	suite.Require().Empty(res.Address)
+
+	account := types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+	suite.app.IBCAccountKeeper.SetInterchainAccountAddress(suite.ctx, types.PortID, testChannel, account.String())
+	res, err = queryClient.InterchainAccount(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(account.String(), res.Address)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryParams() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx)
+	types.RegisterQueryServer(queryHelper, suite.app.IBCAccountKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	params := types.NewParams([]string{msgSendTypeURL})
+	suite.app.IBCAccountKeeper.SetParams(suite.ctx, params)
+
+	res, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, res.Params)
+}
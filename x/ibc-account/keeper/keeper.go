@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper defines the IBC interchain accounts keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.Marshaler
+	paramSpace paramtypes.Subspace
+
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	authKeeper    types.AccountKeeper
+	scopedKeeper  capabilitykeeper.ScopedKeeper
+	router        sdk.Router
+}
+
+// NewKeeper creates a new IBC interchain accounts Keeper instance. The router
+// is used to dispatch the messages executed by the interchain accounts on the
+// host chain.
+func NewKeeper(
+	cdc codec.Marshaler, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	channelKeeper types.ChannelKeeper, portKeeper types.PortKeeper,
+	authKeeper types.AccountKeeper, scopedKeeper capabilitykeeper.ScopedKeeper, router sdk.Router,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		authKeeper:    authKeeper,
+		scopedKeeper:  scopedKeeper,
+		router:        router,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// GetParams returns the total set of interchain accounts parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of interchain accounts parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsBound checks if the interchain accounts module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort defines a wrapper function for the port Keeper's function in
+// order to expose it to module's InitGenesis function
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	cap := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, cap, host.PortPath(portID))
+}
+
+// GetPort returns the portID for the interchain accounts module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the portID for the interchain accounts module. Used in InitGenesis
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// ClaimCapability allows the interchain accounts module to claim a capability
+// that IBC module passes to it
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetOwner returns the owner of the interchain account of a controller channel.
+func (k Keeper) GetOwner(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.OwnerKey(portID, channelID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetOwner sets the owner of the interchain account of a controller channel.
+// The owner is bound to the channel when it is opened.
+func (k Keeper) SetOwner(ctx sdk.Context, portID, channelID string, owner sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.OwnerKey(portID, channelID), owner)
+}
+
+// GetInterchainAccountAddress returns the address of the interchain account of
+// a controller channel, as acknowledged by the host chain.
+func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, portID, channelID string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.InterchainAccountKey(portID, channelID))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetInterchainAccountAddress sets the address of the interchain account of a
+// controller channel.
+func (k Keeper) SetInterchainAccountAddress(ctx sdk.Context, portID, channelID, address string) {
+	ctx.KVStore(k.storeKey).Set(types.InterchainAccountKey(portID, channelID), []byte(address))
+}
+
+// GetHostAccount returns the address of the interchain account registered
+// through a host channel.
+func (k Keeper) GetHostAccount(ctx sdk.Context, portID, channelID string) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.HostAccountKey(portID, channelID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetHostAccount sets the address of the interchain account registered through
+// a host channel.
+func (k Keeper) SetHostAccount(ctx sdk.Context, portID, channelID string, address sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.HostAccountKey(portID, channelID), address)
+}
+
+// UnmarshalPacketData decodes the interchain account packet data, unpacking
+// the messages it contains with the application codec.
+func (k Keeper) UnmarshalPacketData(bz []byte) (types.InterchainAccountPacketData, error) {
+	var data types.InterchainAccountPacketData
+	if err := k.cdc.UnmarshalBinaryBare(bz, &data); err != nil {
+		return types.InterchainAccountPacketData{}, err
+	}
+	return data, nil
+}
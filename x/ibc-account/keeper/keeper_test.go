@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	lite "github.com/tendermint/tendermint/lite2"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// define constants used for testing
+const (
+	testClientID            = "testclientid"
+	testConnection          = "testconnectionatob"
+	testChannel             = "firstchannel"
+	testCounterpartyPort    = "ibcaccount"
+	testCounterpartyChannel = "secondchannel"
+
+	trustingPeriod time.Duration = time.Hour * 24 * 7 * 2
+	ubdPeriod      time.Duration = time.Hour * 24 * 7 * 3
+	maxClockDrift  time.Duration = time.Second * 10
+)
+
+// define variables used for testing
+var (
+	testAddr1 = sdk.AccAddress(crypto.AddressHash([]byte("testaddr1")))
+	testAddr2 = sdk.AccAddress(crypto.AddressHash([]byte("testaddr2")))
+
+	testCoins, _ = sdk.ParseCoins("100atom")
+
+	msgSendTypeURL = types.MsgTypeURL(&banktypes.MsgSend{})
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *simapp.SimApp
+	ctx sdk.Context
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = simapp.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, Time: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)})
+}
+
+// setupChannel creates a client, a connection and an ORDERED channel on the
+// interchain accounts port opened for testAddr1, and claims the channel
+// capability with the interchain accounts scoped keeper.
+func (suite *KeeperTestSuite) setupChannel() {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+	header := ibctmtypes.CreateTestHeader(testClientID, 10, suite.ctx.BlockTime(), valSet, []tmtypes.PrivValidator{privVal})
+
+	clientState := ibctmtypes.NewClientState(
+		testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, header, commitmenttypes.GetSDKSpecs(), false, false,
+	)
+	suite.app.IBCKeeper.ClientKeeper.SetClientState(suite.ctx, clientState)
+	suite.app.IBCKeeper.ClientKeeper.SetClientConsensusState(suite.ctx, testClientID, header.GetHeight(), header.ConsensusState())
+
+	counterparty := connectiontypes.NewCounterparty(
+		testClientID, testConnection, commitmenttypes.NewMerklePrefix(suite.app.IBCKeeper.ConnectionKeeper.GetCommitmentPrefix().Bytes()),
+	)
+	suite.app.IBCKeeper.ConnectionKeeper.SetConnection(suite.ctx, testConnection, connectiontypes.ConnectionEnd{
+		State:        connectiontypes.OPEN,
+		ClientID:     testClientID,
+		Counterparty: counterparty,
+		Versions:     connectiontypes.GetCompatibleVersions(),
+	})
+
+	suite.openChannel(testChannel, testCounterpartyChannel)
+}
+
+// openChannel creates an ORDERED channel opened for testAddr1 on the test
+// connection and binds testAddr1 to it, as done by OnChanOpenInit.
+func (suite *KeeperTestSuite) openChannel(channelID, counterpartyChannelID string) {
+	channel := channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.ORDERED, channeltypes.NewCounterparty(testCounterpartyPort, counterpartyChannelID),
+		[]string{testConnection}, types.NewChannelVersion(testAddr1.String()),
+	)
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, types.PortID, channelID, channel)
+	suite.app.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.ctx, types.PortID, channelID, 1)
+
+	capName := host.ChannelCapabilityPath(types.PortID, channelID)
+	cap, err := suite.app.ScopedIBCKeeper.NewCapability(suite.ctx, capName)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.app.ScopedIBCAccountKeeper.ClaimCapability(suite.ctx, cap, capName))
+
+	suite.app.IBCAccountKeeper.SetOwner(suite.ctx, types.PortID, channelID, testAddr1)
+}
+
+// newPacket returns a packet received by the interchain accounts port of the
+// host chain through the test channel.
+func (suite *KeeperTestSuite) newPacket(data types.InterchainAccountPacketData) channeltypes.Packet {
+	return suite.newChannelPacket(testChannel, testCounterpartyChannel, data)
+}
+
+// newChannelPacket returns a packet received by the interchain accounts port
+// of the host chain through the channel.
+func (suite *KeeperTestSuite) newChannelPacket(
+	channelID, counterpartyChannelID string, data types.InterchainAccountPacketData,
+) channeltypes.Packet {
+	bz, err := suite.app.AppCodec().MarshalBinaryBare(&data)
+	suite.Require().NoError(err)
+
+	return channeltypes.NewPacket(bz, 1, testCounterpartyPort, counterpartyChannelID, types.PortID, channelID, 100, 0)
+}
+
+func (suite *KeeperTestSuite) TestBindPort() {
+	suite.Require().True(suite.app.IBCAccountKeeper.IsBound(suite.ctx, types.PortID))
+	suite.Require().Equal(types.PortID, suite.app.IBCAccountKeeper.GetPort(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Require().Empty(suite.app.IBCAccountKeeper.GetParams(suite.ctx).AllowMessages)
+
+	params := types.NewParams([]string{msgSendTypeURL})
+	suite.app.IBCAccountKeeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(params, suite.app.IBCAccountKeeper.GetParams(suite.ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
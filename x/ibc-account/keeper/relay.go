@@ -0,0 +1,306 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// RegisterInterchainAccount sends a packet registering an interchain account
+// on the host chain of the channel. Only the owner bound to the channel when
+// it was opened can register the interchain account, and it becomes the only
+// account able to execute messages with it from this chain.
+func (k Keeper) RegisterInterchainAccount(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) error {
+	if err := k.checkOwner(ctx, owner, sourcePort, sourceChannel); err != nil {
+		return err
+	}
+
+	if address, found := k.GetInterchainAccountAddress(ctx, sourcePort, sourceChannel); found {
+		return sdkerrors.Wrapf(
+			types.ErrAccountAlreadyRegistered, "port %s, channel %s, account %s", sourcePort, sourceChannel, address,
+		)
+	}
+
+	return k.sendPacket(
+		ctx, sourcePort, sourceChannel, types.NewRegisterPacketData(), timeoutHeight, timeoutTimestamp,
+	)
+}
+
+// SendTx sends a packet executing the messages with the interchain account
+// registered through the channel. The messages must be signed by the
+// interchain account, and the owner must be the one bound to the channel.
+func (k Keeper) SendTx(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	sourcePort,
+	sourceChannel string,
+	msgs []sdk.Msg,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) error {
+	if err := k.checkOwner(ctx, owner, sourcePort, sourceChannel); err != nil {
+		return err
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, sourcePort, sourceChannel); !found {
+		return sdkerrors.Wrapf(
+			types.ErrAccountNotRegistered, "registration not acknowledged by the host chain, port %s, channel %s",
+			sourcePort, sourceChannel,
+		)
+	}
+
+	data, err := types.NewRunTxPacketData(msgs)
+	if err != nil {
+		return err
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.sendPacket(ctx, sourcePort, sourceChannel, data, timeoutHeight, timeoutTimestamp)
+}
+
+// checkOwner checks that the owner is the one bound to the controller channel
+// when it was opened.
+func (k Keeper) checkOwner(ctx sdk.Context, owner sdk.AccAddress, portID, channelID string) error {
+	boundOwner, found := k.GetOwner(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAccountNotRegistered, "no owner bound to port %s, channel %s", portID, channelID)
+	}
+
+	if !boundOwner.Equals(owner) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrUnauthorized, "%s is not the owner of the interchain account, expected %s", owner, boundOwner,
+		)
+	}
+
+	return nil
+}
+
+func (k Keeper) sendPacket(
+	ctx sdk.Context,
+	sourcePort,
+	sourceChannel string,
+	data types.InterchainAccountPacketData,
+	timeoutHeight,
+	timeoutTimestamp uint64,
+) error {
+	sourceChannelEnd, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrap(channeltypes.ErrChannelNotFound, sourceChannel)
+	}
+
+	destinationPort := sourceChannelEnd.GetCounterparty().GetPortID()
+	destinationChannel := sourceChannelEnd.GetCounterparty().GetChannelID()
+
+	// get the next sequence
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
+	if !found {
+		return sdkerrors.Wrapf(
+			channeltypes.ErrSequenceSendNotFound,
+			"source port: %s, source channel: %s", sourcePort, sourceChannel,
+		)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(sourcePort, sourceChannel))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	bz, err := k.cdc.MarshalBinaryBare(&data)
+	if err != nil {
+		return err
+	}
+
+	packet := channeltypes.NewPacket(
+		bz,
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	return k.channelKeeper.SendPacket(ctx, channelCap, packet)
+}
+
+// OnRecvPacket processes an interchain account packet on the host chain. A
+// REGISTER packet creates the interchain account of the channel owner, or
+// links the existing one to the channel, and returns its address. A RUNTX
+// packet executes the messages with the interchain account and returns the
+// proto encoded TxData of the results.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.InterchainAccountPacketData) ([]byte, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	switch data.Type {
+	case types.REGISTER:
+		address, err := k.registerAccount(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSourcePort())
+		if err != nil {
+			return nil, err
+		}
+
+		return []byte(address.String()), nil
+
+	default:
+		account, found := k.GetHostAccount(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		if !found {
+			return nil, sdkerrors.Wrapf(
+				types.ErrAccountNotRegistered, "port %s, channel %s", packet.GetDestPort(), packet.GetDestChannel(),
+			)
+		}
+
+		msgs, err := data.GetMessages()
+		if err != nil {
+			return nil, err
+		}
+
+		return k.executeTx(ctx, account, msgs)
+	}
+}
+
+// registerAccount creates the interchain account of the owner of a host
+// channel. The address is derived from the channel connection, counterparty
+// port and owner, so that no one holds a private key for it and the owner gets
+// back the same interchain account through a new channel once the previous one
+// is closed.
+func (k Keeper) registerAccount(ctx sdk.Context, portID, channelID, counterpartyPortID string) (sdk.AccAddress, error) {
+	if address, found := k.GetHostAccount(ctx, portID, channelID); found {
+		return nil, sdkerrors.Wrapf(
+			types.ErrAccountAlreadyRegistered, "port %s, channel %s, account %s", portID, channelID, address,
+		)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", portID, channelID)
+	}
+
+	owner, err := types.ParseChannelVersion(channel.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	address := types.GenerateAddress(channel.ConnectionHops[0], counterpartyPortID, owner)
+
+	// the account may already exist if it received coins before the
+	// registration or was registered through a previous channel
+	if acc := k.authKeeper.GetAccount(ctx, address); acc == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, address))
+	}
+
+	k.SetHostAccount(ctx, portID, channelID, address)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, address.String()),
+		),
+	)
+
+	return address, nil
+}
+
+// executeTx executes the messages with the interchain account. Each message
+// must be allowed by the host params and signed by the interchain account
+// only. The messages are executed atomically: the state changes are only
+// committed if all of them succeed.
+func (k Keeper) executeTx(ctx sdk.Context, account sdk.AccAddress, msgs []sdk.Msg) ([]byte, error) {
+	params := k.GetParams(ctx)
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	txData := &sdk.TxData{
+		Data: make([]*sdk.MsgData, 0, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		if msgTypeURL := types.MsgTypeURL(msg); !params.IsAllowed(msgTypeURL) {
+			return nil, sdkerrors.Wrapf(types.ErrMessageNotAllowed, "%s; message index: %d", msgTypeURL, i)
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, sdkerrors.Wrapf(err, "message index: %d", i)
+		}
+
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(account) {
+			return nil, sdkerrors.Wrapf(
+				sdkerrors.ErrUnauthorized, "message must only be signed by the interchain account %s; message index: %d", account, i,
+			)
+		}
+
+		msgRoute := msg.Route()
+		handler := k.router.Route(cacheCtx, msgRoute)
+		if handler == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s; message index: %d", msgRoute, i)
+		}
+
+		msgResult, err := handler(cacheCtx, msg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteMsg,
+				sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()),
+				sdk.NewAttribute(types.AttributeKeyAccount, account.String()),
+			),
+		)
+		cacheCtx.EventManager().EmitEvents(msgResult.GetEvents())
+
+		txData.Data = append(txData.Data, &sdk.MsgData{MsgType: msg.Type(), Data: msgResult.Data})
+	}
+
+	data, err := proto.Marshal(txData)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal executed messages data")
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return data, nil
+}
+
+// OnAcknowledgementPacket records the address of the interchain account once
+// its registration is acknowledged by the host chain. An acknowledged address
+// which is not a valid bech32 account address is rejected. A failed
+// registration can be retried by the owner.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context, packet channeltypes.Packet, data types.InterchainAccountPacketData, ack types.InterchainAccountPacketAcknowledgement,
+) error {
+	if data.Type != types.REGISTER || !ack.Success {
+		return nil
+	}
+
+	address := string(ack.Data)
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "acknowledged interchain account address %s: %s", address, err)
+	}
+
+	k.SetInterchainAccountAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), address)
+	return nil
+}
+
+// OnTimeoutPacket is a no-op: a timed out packet closes the ORDERED channel,
+// and the owner gets back its interchain account by registering it through a
+// new channel.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.InterchainAccountPacketData) error {
+	return nil
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccount() {
+	var (
+		owner     sdk.AccAddress
+		channelID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"sender is not the owner bound to the channel", func() {
+			owner = testAddr2
+		}, false},
+		{"account already registered", func() {
+			account := types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+			suite.app.IBCAccountKeeper.SetInterchainAccountAddress(suite.ctx, types.PortID, testChannel, account.String())
+		}, false},
+		{"no owner bound to the channel", func() {
+			channelID = "otherchannel"
+		}, false},
+		{"channel capability not found", func() {
+			cap, _ := suite.app.ScopedIBCAccountKeeper.GetCapability(suite.ctx, host.ChannelCapabilityPath(types.PortID, testChannel))
+			suite.Require().NoError(suite.app.ScopedIBCAccountKeeper.ReleaseCapability(suite.ctx, cap))
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.setupChannel()
+
+			owner = testAddr1
+			channelID = testChannel
+
+			tc.malleate()
+
+			err := suite.app.IBCAccountKeeper.RegisterInterchainAccount(suite.ctx, owner, types.PortID, channelID, 100, 0)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTx() {
+	var (
+		owner        sdk.AccAddress
+		msgs         []sdk.Msg
+		acknowledged bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"sender is not the owner", func() {
+			owner = testAddr2
+		}, false},
+		{"registration not acknowledged", func() {
+			acknowledged = false
+		}, false},
+		{"no messages", func() {
+			msgs = nil
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.setupChannel()
+
+			account := types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+
+			owner = testAddr1
+			msgs = []sdk.Msg{banktypes.NewMsgSend(account, testAddr2, testCoins)}
+			acknowledged = true
+
+			tc.malleate()
+
+			if acknowledged {
+				suite.app.IBCAccountKeeper.SetInterchainAccountAddress(suite.ctx, types.PortID, testChannel, account.String())
+			}
+
+			err := suite.app.IBCAccountKeeper.SendTx(suite.ctx, owner, types.PortID, testChannel, msgs, 100, 0)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvRegisterPacket() {
+	suite.setupChannel()
+	packet := suite.newPacket(types.NewRegisterPacketData())
+
+	res, err := suite.app.IBCAccountKeeper.OnRecvPacket(suite.ctx, packet, types.NewRegisterPacketData())
+	suite.Require().NoError(err)
+
+	expAccount := types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+	suite.Require().Equal(expAccount.String(), string(res))
+	suite.Require().NotNil(suite.app.AccountKeeper.GetAccount(suite.ctx, expAccount))
+
+	account, found := suite.app.IBCAccountKeeper.GetHostAccount(suite.ctx, types.PortID, testChannel)
+	suite.Require().True(found)
+	suite.Require().Equal(expAccount, account)
+
+	// a channel can only register a single interchain account
+	_, err = suite.app.IBCAccountKeeper.OnRecvPacket(suite.ctx, packet, types.NewRegisterPacketData())
+	suite.Require().True(types.ErrAccountAlreadyRegistered.Is(err))
+
+	// the owner gets back the same interchain account through a new channel,
+	// e.g. once the previous one was closed by a timed out packet
+	suite.openChannel("thirdchannel", "fourthchannel")
+	res, err = suite.app.IBCAccountKeeper.OnRecvPacket(
+		suite.ctx, suite.newChannelPacket("thirdchannel", "fourthchannel", types.NewRegisterPacketData()), types.NewRegisterPacketData(),
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expAccount.String(), string(res))
+
+	// the channel version must encode the owner
+	channel, _ := suite.app.IBCKeeper.ChannelKeeper.GetChannel(suite.ctx, types.PortID, "thirdchannel")
+	channel.Version = types.Version
+	suite.app.IBCKeeper.ChannelKeeper.SetChannel(suite.ctx, types.PortID, "fifthchannel", channel)
+	_, err = suite.app.IBCAccountKeeper.OnRecvPacket(
+		suite.ctx, suite.newChannelPacket("fifthchannel", "sixthchannel", types.NewRegisterPacketData()), types.NewRegisterPacketData(),
+	)
+	suite.Require().True(types.ErrInvalidVersion.Is(err))
+}
+
+func (suite *KeeperTestSuite) TestOnRecvRunTxPacket() {
+	var (
+		account sdk.AccAddress
+		msgs    []sdk.Msg
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"account not registered", func() {
+			suite.SetupTest()
+		}, false},
+		{"message not allowed", func() {
+			suite.app.IBCAccountKeeper.SetParams(suite.ctx, types.DefaultParams())
+		}, false},
+		{"message not signed by the interchain account", func() {
+			msgs = []sdk.Msg{banktypes.NewMsgSend(testAddr1, testAddr2, testCoins)}
+		}, false},
+		{"message signed by other accounts", func() {
+			msgs = []sdk.Msg{banktypes.NewMsgMultiSend(
+				[]banktypes.Input{banktypes.NewInput(account, testCoins), banktypes.NewInput(testAddr1, testCoins)},
+				[]banktypes.Output{banktypes.NewOutput(testAddr2, testCoins.Add(testCoins...))},
+			)}
+			suite.app.IBCAccountKeeper.SetParams(suite.ctx, types.NewParams([]string{
+				msgSendTypeURL, types.MsgTypeURL(&banktypes.MsgMultiSend{}),
+			}))
+		}, false},
+		{"message execution failed", func() {
+			msgs = []sdk.Msg{banktypes.NewMsgSend(account, testAddr2, testCoins.Add(testCoins...))}
+		}, false},
+		{"messages are executed atomically", func() {
+			msgs = []sdk.Msg{
+				banktypes.NewMsgSend(account, testAddr2, testCoins),
+				banktypes.NewMsgSend(account, testAddr2, testCoins),
+			}
+		}, false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.setupChannel()
+
+			_, err := suite.app.IBCAccountKeeper.OnRecvPacket(
+				suite.ctx, suite.newPacket(types.NewRegisterPacketData()), types.NewRegisterPacketData(),
+			)
+			suite.Require().NoError(err)
+
+			account = types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+			suite.Require().NoError(suite.app.BankKeeper.SetBalances(suite.ctx, account, testCoins))
+			suite.app.IBCAccountKeeper.SetParams(suite.ctx, types.NewParams([]string{msgSendTypeURL}))
+
+			msgs = []sdk.Msg{banktypes.NewMsgSend(account, testAddr2, testCoins)}
+
+			tc.malleate()
+
+			data, err := types.NewRunTxPacketData(msgs)
+			suite.Require().NoError(err)
+
+			res, err := suite.app.IBCAccountKeeper.OnRecvPacket(suite.ctx, suite.newPacket(data), data)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, account).IsZero())
+				suite.Require().Equal(testCoins, suite.app.BankKeeper.GetAllBalances(suite.ctx, testAddr2))
+
+				var txData sdk.TxData
+				suite.Require().NoError(proto.Unmarshal(res, &txData))
+				suite.Require().Len(txData.Data, len(msgs))
+				suite.Require().Equal(banktypes.TypeMsgSend, txData.Data[0].MsgType)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, testAddr2).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	account := types.GenerateAddress(testConnection, testCounterpartyPort, testAddr1.String())
+	data := types.NewRegisterPacketData()
+	packet := channeltypes.NewPacket(nil, 1, types.PortID, testChannel, testCounterpartyPort, testCounterpartyChannel, 100, 0)
+
+	testCases := []struct {
+		msg       string
+		ack       types.InterchainAccountPacketAcknowledgement
+		expPass   bool
+		expResult string
+	}{
+		{"successful registration", types.NewSuccessAcknowledgement(types.REGISTER, []byte(account.String())), true, account.String()},
+		{"failed registration", types.NewErrorAcknowledgement(types.REGISTER, types.ErrAccountAlreadyRegistered), true, ""},
+		{"invalid acknowledged address", types.NewSuccessAcknowledgement(types.REGISTER, []byte("invalid")), false, ""},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		i := i
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			suite.app.IBCAccountKeeper.SetOwner(suite.ctx, types.PortID, testChannel, testAddr1)
+
+			err := suite.app.IBCAccountKeeper.OnAcknowledgementPacket(suite.ctx, packet, data, tc.ack)
+			if tc.expPass {
+				suite.Require().NoError(err, "test case %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
+			}
+
+			// the owner stays bound to the channel, so that it can retry a
+			// failed registration
+			owner, found := suite.app.IBCAccountKeeper.GetOwner(suite.ctx, types.PortID, testChannel)
+			suite.Require().True(found)
+			suite.Require().Equal(testAddr1, owner)
+
+			address, _ := suite.app.IBCAccountKeeper.GetInterchainAccountAddress(suite.ctx, types.PortID, testChannel)
+			suite.Require().Equal(tc.expResult, address)
+		})
+	}
+}
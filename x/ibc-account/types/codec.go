@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the IBC interchain accounts types
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(&MsgRegisterAccount{}, "cosmos-sdk/MsgRegisterInterchainAccount", nil)
+	cdc.RegisterConcrete(&MsgSendTx{}, "cosmos-sdk/MsgSendInterchainAccountTx", nil)
+}
+
+// RegisterInterfaces register the ibc interchain accounts module interfaces to
+// protobuf Any.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterAccount{},
+		&MsgSendTx{},
+	)
+}
+
+var (
+	amino = codec.New()
+
+	// ModuleCdc references the global x/ibc-account module codec. Note, the codec
+	// should ONLY be used in certain instances of tests and for JSON encoding as Amino
+	// is still used for that purpose.
+	//
+	// The actual codec used for serialization should be provided to x/ibc-account and
+	// defined at the application level.
+	ModuleCdc = codec.NewHybridCodec(amino, cdctypes.NewInterfaceRegistry())
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IBC interchain accounts sentinel errors
+var (
+	ErrAccountAlreadyRegistered = sdkerrors.Register(ModuleName, 2, "interchain account already registered")
+	ErrAccountNotRegistered     = sdkerrors.Register(ModuleName, 3, "interchain account not registered")
+	ErrMessageNotAllowed        = sdkerrors.Register(ModuleName, 4, "message type not allowed on the host chain")
+	ErrInvalidPacketData        = sdkerrors.Register(ModuleName, 5, "invalid interchain account packet data")
+	ErrInvalidVersion           = sdkerrors.Register(ModuleName, 6, "invalid interchain accounts channel version")
+)
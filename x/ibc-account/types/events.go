@@ -0,0 +1,14 @@
+package types
+
+// IBC interchain accounts events
+const (
+	EventTypeTimeout         = "timeout"
+	EventTypePacket          = "interchain_account_packet"
+	EventTypeRegisterAccount = "register_interchain_account"
+	EventTypeExecuteMsg      = "execute_interchain_account_msg"
+
+	AttributeKeyAccount    = "account"
+	AttributeKeyPacketType = "packet_type"
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAckError   = "error"
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// GenesisState defines the ibc interchain accounts genesis state
+type GenesisState struct {
+	PortID string `json:"port_id" yaml:"port_id"`
+	Params Params `json:"params" yaml:"params"`
+}
+
+// NewGenesisState creates a new ibc interchain accounts GenesisState instance.
+func NewGenesisState(portID string, params Params) GenesisState {
+	return GenesisState{
+		PortID: portID,
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with "ibcaccount" as the default
+// PortID and the default params.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		PortID: PortID,
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortID); err != nil {
+		return err
+	}
+	return gs.Params.Validate()
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState types.GenesisState
+		expPass  bool
+	}{
+		{
+			name:     "default",
+			genState: types.DefaultGenesisState(),
+			expPass:  true,
+		},
+		{
+			"valid genesis with allowed messages",
+			types.NewGenesisState("portidone", types.NewParams([]string{"/cosmos.bank.MsgSend", "/cosmos.staking.MsgDelegate"})),
+			true,
+		},
+		{
+			"invalid port",
+			types.NewGenesisState("(INVALIDPORT)", types.DefaultParams()),
+			false,
+		},
+		{
+			"invalid message type URL",
+			types.NewGenesisState("portidone", types.NewParams([]string{"cosmos.bank.MsgSend"})),
+			false,
+		},
+		{
+			"empty message type URL",
+			types.NewGenesisState("portidone", types.NewParams([]string{"/"})),
+			false,
+		},
+		{
+			"duplicated message type URL",
+			types.NewGenesisState("portidone", types.NewParams([]string{"/cosmos.bank.MsgSend", "/cosmos.bank.MsgSend"})),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// ModuleName defines the IBC interchain accounts name
+	ModuleName = "ibcaccount"
+
+	// Version defines the current version the IBC interchain accounts
+	// module supports
+	Version = "ics27-1"
+
+	// PortID is the default port id that interchain accounts module binds to
+	PortID = "ibcaccount"
+
+	// StoreKey is the store key string for IBC interchain accounts
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC interchain accounts
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC interchain accounts
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default paramspace for IBC interchain accounts
+	DefaultParamspace = ModuleName
+
+	// VersionDelimiter separates the supported version from the owner in the
+	// version of an interchain accounts channel
+	VersionDelimiter = "|"
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// OwnerKeyPrefix defines the prefix to store the owner of the interchain
+	// account of a controller channel
+	OwnerKeyPrefix = []byte{0x02}
+	// InterchainAccountKeyPrefix defines the prefix to store the address of
+	// the interchain account of a controller channel, as acknowledged by the
+	// host chain
+	InterchainAccountKeyPrefix = []byte{0x03}
+	// HostAccountKeyPrefix defines the prefix to store the address of the
+	// interchain account registered through a host channel
+	HostAccountKeyPrefix = []byte{0x04}
+)
+
+// KeyChannel returns the store key suffix of a channel
+func KeyChannel(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", portID, channelID))
+}
+
+// OwnerKey returns the store key of the owner of the interchain account of
+// a controller channel
+func OwnerKey(portID, channelID string) []byte {
+	return append(OwnerKeyPrefix, KeyChannel(portID, channelID)...)
+}
+
+// InterchainAccountKey returns the store key of the address of the
+// interchain account of a controller channel
+func InterchainAccountKey(portID, channelID string) []byte {
+	return append(InterchainAccountKeyPrefix, KeyChannel(portID, channelID)...)
+}
+
+// HostAccountKey returns the store key of the address of the interchain
+// account registered through a host channel
+func HostAccountKey(portID, channelID string) []byte {
+	return append(HostAccountKeyPrefix, KeyChannel(portID, channelID)...)
+}
+
+// NewChannelVersion returns the version of an interchain accounts channel
+// opened for the owner. The owner is bound to the channel when it is opened,
+// so that no one else can register or use its interchain account.
+func NewChannelVersion(owner string) string {
+	return Version + VersionDelimiter + owner
+}
+
+// ParseChannelVersion returns the owner encoded in the version of an
+// interchain accounts channel.
+func ParseChannelVersion(version string) (string, error) {
+	parts := strings.SplitN(version, VersionDelimiter, 2)
+	if len(parts) != 2 || parts[0] != Version {
+		return "", sdkerrors.Wrapf(
+			ErrInvalidVersion, "expected %s, got %s", NewChannelVersion("{owner}"), version,
+		)
+	}
+
+	if strings.TrimSpace(parts[1]) == "" {
+		return "", sdkerrors.Wrap(ErrInvalidVersion, "owner cannot be blank")
+	}
+
+	return parts[1], nil
+}
+
+// GenerateAddress returns the address of the interchain account of an owner
+// on the host chain. The address is derived from the host connection, the
+// counterparty port and the owner rather than from the channel, so that the
+// owner keeps the same interchain account when it opens a new channel after
+// the previous one was closed.
+//
+// CONTRACT: this assumes that the counterparty chain only lets the owner
+// encoded in the channel version send packets through the channel.
+func GenerateAddress(connectionID, counterpartyPortID, owner string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(
+		ModuleName + "/" + connectionID + "/" + counterpartyPortID + "/" + owner,
+	)))
+}
@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// TestParseChannelVersion tests that the owner is parsed from the channel version
+func TestParseChannelVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		version  string
+		expOwner string
+		expPass  bool
+	}{
+		{"valid version", types.NewChannelVersion(addr1.String()), addr1.String(), true},
+		{"missing owner", types.Version, "", false},
+		{"blank owner", types.NewChannelVersion(" "), "", false},
+		{"invalid version", "ics20-1" + types.VersionDelimiter + addr1.String(), "", false},
+	}
+
+	for _, tc := range testCases {
+		owner, err := types.ParseChannelVersion(tc.version)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expOwner, owner, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// TestGenerateAddress tests that the address does not depend on the channel
+func TestGenerateAddress(t *testing.T) {
+	address := types.GenerateAddress("connection0", types.PortID, addr1.String())
+	require.Equal(t, address, types.GenerateAddress("connection0", types.PortID, addr1.String()))
+	require.NotEqual(t, address, types.GenerateAddress("connection0", types.PortID, addr2.String()))
+	require.NotEqual(t, address, types.GenerateAddress("connection1", types.PortID, addr1.String()))
+}
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"encoding/json"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterAccount = "register_account"
+	TypeMsgSendTx          = "send_tx"
+)
+
+var (
+	_ sdk.Msg                          = &MsgRegisterAccount{}
+	_ sdk.Msg                          = &MsgSendTx{}
+	_ cdctypes.UnpackInterfacesMessage = MsgSendTx{}
+)
+
+// NewMsgRegisterAccount creates a new MsgRegisterAccount instance
+func NewMsgRegisterAccount(
+	sourcePort, sourceChannel string, owner sdk.AccAddress, timeoutHeight, timeoutTimestamp uint64,
+) *MsgRegisterAccount {
+	return &MsgRegisterAccount{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Owner:            owner,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterAccount) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterAccount) Type() string {
+	return TypeMsgRegisterAccount
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterAccount fields.
+// NOTE: timeout height and timestamp values can be 0 to disable the timeout.
+func (msg MsgRegisterAccount) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgRegisterAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance
+func NewMsgSendTx(
+	sourcePort, sourceChannel string, owner sdk.AccAddress, msgs []sdk.Msg, timeoutHeight, timeoutTimestamp uint64,
+) (*MsgSendTx, error) {
+	msgsAny, err := PackMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgSendTx{
+		SourcePort:       sourcePort,
+		SourceChannel:    sourceChannel,
+		Owner:            owner,
+		Msgs:             msgsAny,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}, nil
+}
+
+// GetMessages returns the unpacked messages of the MsgSendTx.
+func (msg MsgSendTx) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMsgs(msg.Msgs)
+}
+
+// Route implements sdk.Msg
+func (MsgSendTx) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSendTx) Type() string {
+	return TypeMsgSendTx
+}
+
+// ValidateBasic performs a basic check of the MsgSendTx fields.
+// NOTE: timeout height and timestamp values can be 0 to disable the timeout.
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return sdkerrors.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return sdkerrors.Wrap(err, "invalid source channel ID")
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing owner address")
+	}
+	if len(msg.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages cannot be empty")
+	}
+
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		return err
+	}
+
+	// NOTE: the signers of the messages are checked against the interchain
+	// account on the host chain
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendTxSignDoc mirrors the amino JSON encoding of MsgSendTx with the wrapped
+// messages replaced by their sign bytes.
+type sendTxSignDoc struct {
+	Type  string             `json:"type"`
+	Value sendTxSignDocValue `json:"value"`
+}
+
+type sendTxSignDocValue struct {
+	SourcePort       string            `json:"source_port"`
+	SourceChannel    string            `json:"source_channel"`
+	Owner            sdk.AccAddress    `json:"owner"`
+	Msgs             []json.RawMessage `json:"msgs"`
+	TimeoutHeight    uint64            `json:"timeout_height,string"`
+	TimeoutTimestamp uint64            `json:"timeout_timestamp,string"`
+}
+
+// GetSignBytes implements sdk.Msg. The wrapped messages are included through
+// their own sign bytes, so that the module codec does not need to know about
+// every message type that may be executed on the host chain.
+func (msg MsgSendTx) GetSignBytes() []byte {
+	msgs, err := msg.GetMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	msgsBytes := make([]json.RawMessage, len(msgs))
+	for i, m := range msgs {
+		msgsBytes[i] = json.RawMessage(m.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(sendTxSignDoc{
+		Type: "cosmos-sdk/MsgSendInterchainAccountTx",
+		Value: sendTxSignDocValue{
+			SourcePort:       msg.SourcePort,
+			SourceChannel:    msg.SourceChannel,
+			Owner:            msg.Owner,
+			Msgs:             msgsBytes,
+			TimeoutHeight:    msg.TimeoutHeight,
+			TimeoutTimestamp: msg.TimeoutTimestamp,
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSendTx) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, msg.Msgs)
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+// define constants used for testing
+const (
+	validPort   = "testportid"
+	invalidPort = "(invalidport1)"
+
+	validChannel   = "testchannel"
+	invalidChannel = "(invalidchannel1)"
+)
+
+// TestMsgRegisterAccountValidation tests ValidateBasic for MsgRegisterAccount
+func TestMsgRegisterAccountValidation(t *testing.T) {
+	testMsgs := []*types.MsgRegisterAccount{
+		types.NewMsgRegisterAccount(validPort, validChannel, addr1, 10, 0),            // valid msg
+		types.NewMsgRegisterAccount(invalidPort, validChannel, addr1, 10, 0),          // invalid port id
+		types.NewMsgRegisterAccount(validPort, invalidChannel, addr1, 10, 0),          // invalid channel id
+		types.NewMsgRegisterAccount(validPort, validChannel, sdk.AccAddress{}, 10, 0), // missing owner
+	}
+
+	testCases := []struct {
+		msg     *types.MsgRegisterAccount
+		expPass bool
+		errMsg  string
+	}{
+		{testMsgs[0], true, ""},
+		{testMsgs[1], false, "invalid port id"},
+		{testMsgs[2], false, "invalid channel id"},
+		{testMsgs[3], false, "missing owner address"},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, []sdk.AccAddress{tc.msg.Owner}, tc.msg.GetSigners())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
+
+// TestMsgSendTxValidation tests ValidateBasic for MsgSendTx
+func TestMsgSendTxValidation(t *testing.T) {
+	validMsgs := []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins)}
+	invalidMsgs := []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, sdk.Coins{})}
+
+	testCases := []struct {
+		name          string
+		sourcePort    string
+		sourceChannel string
+		owner         sdk.AccAddress
+		msgs          []sdk.Msg
+		expPass       bool
+	}{
+		{"valid msg", validPort, validChannel, addr1, validMsgs, true},
+		{"invalid port id", invalidPort, validChannel, addr1, validMsgs, false},
+		{"invalid channel id", validPort, invalidChannel, addr1, validMsgs, false},
+		{"missing owner address", validPort, validChannel, sdk.AccAddress{}, validMsgs, false},
+		{"empty messages", validPort, validChannel, addr1, nil, false},
+		{"invalid message", validPort, validChannel, addr1, invalidMsgs, false},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgSendTx(tc.sourcePort, tc.sourceChannel, tc.owner, tc.msgs, 10, 0)
+		require.NoError(t, err, tc.name)
+
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.NotEmpty(t, msg.GetSignBytes(), tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ cdctypes.UnpackInterfacesMessage = InterchainAccountPacketData{}
+
+// NewRegisterPacketData constructs the packet data registering an interchain
+// account on the host chain.
+func NewRegisterPacketData() InterchainAccountPacketData {
+	return InterchainAccountPacketData{
+		Type: REGISTER,
+	}
+}
+
+// NewRunTxPacketData constructs the packet data executing the messages with
+// the interchain account on the host chain.
+func NewRunTxPacketData(msgs []sdk.Msg) (InterchainAccountPacketData, error) {
+	msgsAny, err := PackMsgs(msgs)
+	if err != nil {
+		return InterchainAccountPacketData{}, err
+	}
+
+	return InterchainAccountPacketData{
+		Type: RUNTX,
+		Msgs: msgsAny,
+	}, nil
+}
+
+// ValidateBasic is used for validating the interchain account packet data.
+func (pd InterchainAccountPacketData) ValidateBasic() error {
+	switch pd.Type {
+	case REGISTER:
+		if len(pd.Msgs) != 0 {
+			return sdkerrors.Wrap(ErrInvalidPacketData, "register packet cannot contain messages")
+		}
+	case RUNTX:
+		if len(pd.Msgs) == 0 {
+			return sdkerrors.Wrap(ErrInvalidPacketData, "messages cannot be empty")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPacketData, "unknown packet type %s", pd.Type)
+	}
+	return nil
+}
+
+// GetMessages returns the unpacked messages of the packet data.
+func (pd InterchainAccountPacketData) GetMessages() ([]sdk.Msg, error) {
+	return UnpackMsgs(pd.Msgs)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (pd InterchainAccountPacketData) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return unpackMsgs(unpacker, pd.Msgs)
+}
+
+// NewSuccessAcknowledgement returns a successful acknowledgement of a packet
+// of the given type with the result data.
+func NewSuccessAcknowledgement(packetType Type, data []byte) InterchainAccountPacketAcknowledgement {
+	return InterchainAccountPacketAcknowledgement{
+		Type:    packetType,
+		Success: true,
+		Data:    data,
+	}
+}
+
+// NewErrorAcknowledgement returns a failed acknowledgement of a packet of the
+// given type with the error msg.
+func NewErrorAcknowledgement(packetType Type, err error) InterchainAccountPacketAcknowledgement {
+	return InterchainAccountPacketAcknowledgement{
+		Type:    packetType,
+		Success: false,
+		Error:   err.Error(),
+	}
+}
+
+// GetBytes is a helper for serialising
+func (ack InterchainAccountPacketAcknowledgement) GetBytes() []byte {
+	return ModuleCdc.MustMarshalBinaryBare(&ack)
+}
+
+// PackMsgs packs the messages into protobuf Any.
+func PackMsgs(msgs []sdk.Msg) ([]*cdctypes.Any, error) {
+	msgsAny := make([]*cdctypes.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := cdctypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgsAny[i] = any
+	}
+
+	return msgsAny, nil
+}
+
+// UnpackMsgs returns the cached messages of the protobuf Any, which must have
+// been unpacked by the codec.
+func UnpackMsgs(msgsAny []*cdctypes.Any) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msgsAny))
+	for i, any := range msgsAny {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "message %d is not a sdk.Msg", i)
+		}
+
+		msgs[i] = m
+	}
+
+	return msgs, nil
+}
+
+func unpackMsgs(unpacker cdctypes.AnyUnpacker, msgsAny []*cdctypes.Any) error {
+	for _, any := range msgsAny {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MsgTypeURL returns the protobuf type URL of the message, which identifies
+// it in the host allow list, eg. "/cosmos.staking.MsgDelegate".
+func MsgTypeURL(msg sdk.Msg) string {
+	return "/" + proto.MessageName(msg)
+}
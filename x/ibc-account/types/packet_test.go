@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-account/types"
+)
+
+var (
+	addr1 = sdk.AccAddress("testaddr1")
+	addr2 = sdk.AccAddress("testaddr2")
+
+	coins, _ = sdk.ParseCoins("100atom")
+)
+
+// TestPacketDataValidateBasic tests ValidateBasic for InterchainAccountPacketData
+func TestPacketDataValidateBasic(t *testing.T) {
+	runTxData, err := types.NewRunTxPacketData([]sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins)})
+	require.NoError(t, err)
+
+	registerWithMsgs := runTxData
+	registerWithMsgs.Type = types.REGISTER
+
+	testCases := []struct {
+		name       string
+		packetData types.InterchainAccountPacketData
+		expPass    bool
+	}{
+		{"valid register packet", types.NewRegisterPacketData(), true},
+		{"valid run tx packet", runTxData, true},
+		{"register packet with messages", registerWithMsgs, false},
+		{"run tx packet without messages", types.InterchainAccountPacketData{Type: types.RUNTX}, false},
+		{"unspecified packet type", types.InterchainAccountPacketData{}, false},
+	}
+
+	for i, tc := range testCases {
+		err := tc.packetData.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %v", i, err)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestPackMsgs tests the round trip of the messages through PackMsgs and UnpackMsgs
+func TestPackMsgs(t *testing.T) {
+	msgs := []sdk.Msg{banktypes.NewMsgSend(addr1, addr2, coins), banktypes.NewMsgSend(addr2, addr1, coins)}
+
+	msgsAny, err := types.PackMsgs(msgs)
+	require.NoError(t, err)
+	require.Len(t, msgsAny, 2)
+	require.Equal(t, types.MsgTypeURL(msgs[0]), msgsAny[0].TypeUrl)
+
+	unpacked, err := types.UnpackMsgs(msgsAny)
+	require.NoError(t, err)
+	require.Equal(t, msgs, unpacked)
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyAllowMessages = []byte("AllowMessages")
+)
+
+// ParamKeyTable returns the parameter key table of the interchain accounts module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(allowMessages []string) Params {
+	return Params{
+		AllowMessages: allowMessages,
+	}
+}
+
+// DefaultParams returns the default interchain accounts module parameters. No
+// message is allowed to be executed on the host chain by default.
+func DefaultParams() Params {
+	return Params{
+		AllowMessages: []string{},
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	return validateAllowMessages(p.AllowMessages)
+}
+
+// IsAllowed returns true if the message type URL is in the allow list.
+func (p Params) IsAllowed(msgTypeURL string) bool {
+	for _, allowed := range p.AllowMessages {
+		if allowed == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowMessages, &p.AllowMessages, validateAllowMessages),
+	}
+}
+
+func validateAllowMessages(i interface{}) error {
+	allowMessages, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgTypeURL := range allowMessages {
+		if !strings.HasPrefix(msgTypeURL, "/") || strings.TrimSpace(msgTypeURL) == "/" {
+			return fmt.Errorf("invalid message type URL %q, expected the format '/{proto message name}'", msgTypeURL)
+		}
+		if seen[msgTypeURL] {
+			return fmt.Errorf("duplicated allowed message type URL %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = true
+	}
+
+	return nil
+}
@@ -19,6 +19,8 @@ func GetQueryCmd(clientCtx client.Context) *cobra.Command {
 
 	ics20TransferQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryNextSequence(clientCtx),
+		GetCmdQueryDenomTrace(clientCtx),
+		GetCmdQueryDenomTraces(clientCtx),
 	)...)
 
 	return ics20TransferQueryCmd
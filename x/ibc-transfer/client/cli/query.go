@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
 )
 
 // GetCmdQueryNextSequence defines the command to query a next receive sequence
@@ -47,3 +49,55 @@ $ %s query ibc-transfer next-recv [port-id] [channel-id]
 
 	return cmd
 }
+
+// GetCmdQueryDenomTrace defines the command to query a denomination trace from
+// a given hash.
+func GetCmdQueryDenomTrace(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-trace [hash]",
+		Short:   "Query the denom trace info from a given trace hash",
+		Long:    "Query the denom trace info from a given trace hash",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-trace [hash]", version.ClientName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			req := &types.QueryDenomTraceRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomTrace(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.DenomTrace)
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryDenomTraces defines the command to query all the denomination
+// traces.
+func GetCmdQueryDenomTraces(clientCtx client.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces",
+		Short:   "Query the trace info for all token denominations",
+		Long:    "Query the trace info for all token denominations",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces", version.ClientName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queryClient := types.NewQueryClient(clientCtx.Init())
+
+			res, err := queryClient.DenomTraces(context.Background(), &types.QueryDenomTracesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintOutput(res.DenomTraces)
+		},
+	}
+
+	return cmd
+}
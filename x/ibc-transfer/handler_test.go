@@ -1,7 +1,6 @@
 package transfer_test
 
 import (
-	"fmt"
 	"testing"
 	"time"
 
@@ -47,9 +46,9 @@ var (
 	testAddr1 = sdk.AccAddress([]byte("testaddr1"))
 	testAddr2 = sdk.AccAddress([]byte("testaddr2"))
 
-	testCoins, _          = sdk.ParseCoins("100atom")
-	testPrefixedCoins1, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort1, testChannel1)))
-	testPrefixedCoins2, _ = sdk.ParseCoins(fmt.Sprintf("100%satom", types.GetDenomPrefix(testPort2, testChannel2)))
+	testCoins, _     = sdk.ParseCoins("100atom")
+	testDenomTrace   = types.ParseDenomTrace(types.GetDenomPrefix(testPort1, testChannel1) + "atom")
+	testVoucherCoins = sdk.NewCoins(sdk.NewInt64Coin(testDenomTrace.IBCDenom(), 100))
 )
 
 type HandlerTestSuite struct {
@@ -79,7 +78,7 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Nil(err, "transfer module could not claim capability")
 
 	ctx := suite.chainA.GetContext()
-	msg := types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), 110, 0)
+	msg := types.NewMsgTransfer(testPort1, testChannel1, testCoins, testAddr1, testAddr2.String(), 110, 0)
 	res, err := handler(ctx, msg)
 	suite.Require().Error(err)
 	suite.Require().Nil(res, "%+v", res) // channel does not exist
@@ -105,16 +104,15 @@ func (suite *HandlerTestSuite) TestHandleMsgTransfer() {
 	suite.Require().NotNil(res, "%+v", res) // successfully executed
 
 	// test when the source is false
-	msg = types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins2, testAddr1, testAddr2.String(), 110, 0)
-	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins2)
+	msg = types.NewMsgTransfer(testPort1, testChannel1, testVoucherCoins, testAddr1, testAddr2.String(), 110, 0)
+	suite.chainA.App.BankKeeper.SetSupply(ctx, banktypes.NewSupply(testVoucherCoins))
+	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testVoucherCoins)
 
 	res, err = handler(ctx, msg)
 	suite.Require().Error(err)
-	suite.Require().Nil(res, "%+v", res) // incorrect denom prefix
+	suite.Require().Nil(res, "%+v", res) // denom trace not found
 
-	msg = types.NewMsgTransfer(testPort1, testChannel1, testPrefixedCoins1, testAddr1, testAddr2.String(), 110, 0)
-	suite.chainA.App.BankKeeper.SetSupply(ctx, banktypes.NewSupply(testPrefixedCoins1))
-	_ = suite.chainA.App.BankKeeper.SetBalances(ctx, testAddr1, testPrefixedCoins1)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, testDenomTrace)
 
 	res, err = handler(ctx, msg)
 	suite.Require().NoError(err)
@@ -190,7 +188,7 @@ func (chain *TestChain) CreateClient(client *TestChain) error {
 	ctxTarget := chain.GetContext()
 
 	// create client
-	clientState, err := ibctmtypes.Initialize(client.ClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, client.Header, commitmenttypes.GetSDKSpecs())
+	clientState, err := ibctmtypes.Initialize(client.ClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, client.Header, commitmenttypes.GetSDKSpecs(), false, false)
 	if err != nil {
 		return err
 	}
@@ -259,7 +257,7 @@ func (chain *TestChain) updateClient(client *TestChain) {
 		ctxTarget, client.ClientID, uint64(client.Header.SignedHeader.Header.Height), consensusState,
 	)
 	chain.App.IBCKeeper.ClientKeeper.SetClientState(
-		ctxTarget, ibctmtypes.NewClientState(client.ClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, client.Header, commitmenttypes.GetSDKSpecs()),
+		ctxTarget, ibctmtypes.NewClientState(client.ClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, client.Header, commitmenttypes.GetSDKSpecs(), false, false),
 	)
 
 	// _, _, err := simapp.SignCheckDeliver(
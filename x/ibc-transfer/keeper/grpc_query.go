@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// DenomTrace implements the Query/DenomTrace gRPC method
+func (k Keeper) DenomTrace(c context.Context, req *types.QueryDenomTraceRequest) (*types.QueryDenomTraceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash %s, %s", req.Hash, err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("denomination trace not found for hash %s", req.Hash))
+	}
+
+	return &types.QueryDenomTraceResponse{DenomTrace: &denomTrace}, nil
+}
+
+// DenomTraces implements the Query/DenomTraces gRPC method
+func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesRequest) (*types.QueryDenomTracesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDenomTracesResponse{DenomTraces: k.GetAllDenomTraces(ctx)}, nil
+}
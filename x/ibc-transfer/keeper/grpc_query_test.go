@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/x/ibc-transfer/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueryDenomTraces() {
+	ctx := suite.chainA.GetContext()
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx)
+	types.RegisterQueryServer(queryHelper, suite.chainA.App.TransferKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	traceA := types.DenomTrace{Path: "transfer/channelToB", BaseDenom: "uatom"}
+	traceB := types.DenomTrace{Path: "transfer/channelToA", BaseDenom: "uatom"}
+
+	_, err := queryClient.DenomTrace(gocontext.Background(), &types.QueryDenomTraceRequest{Hash: hex.EncodeToString(traceA.Hash())})
+	suite.Require().Error(err) // trace not found
+
+	_, err = queryClient.DenomTrace(gocontext.Background(), &types.QueryDenomTraceRequest{Hash: "invalidhash"})
+	suite.Require().Error(err)
+
+	tracesRes, err := queryClient.DenomTraces(gocontext.Background(), &types.QueryDenomTracesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(tracesRes.DenomTraces)
+
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, traceA)
+	suite.chainA.App.TransferKeeper.SetDenomTrace(ctx, traceB)
+
+	traceRes, err := queryClient.DenomTrace(gocontext.Background(), &types.QueryDenomTraceRequest{Hash: hex.EncodeToString(traceA.Hash())})
+	suite.Require().NoError(err)
+	suite.Require().Equal(traceA, *traceRes.DenomTrace)
+
+	// traces are returned sorted by their full denomination path
+	tracesRes, err = queryClient.DenomTraces(gocontext.Background(), &types.QueryDenomTracesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Traces{traceB, traceA}, tracesRes.DenomTraces)
+}
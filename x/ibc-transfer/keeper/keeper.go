@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -109,6 +112,80 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 	store.Set(types.PortKey, []byte(portID))
 }
 
+// GetDenomTrace retreives the full identifiers trace and base denomination from the store.
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (types.DenomTrace, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	bz := store.Get(denomTraceHash)
+	if bz == nil {
+		return types.DenomTrace{}, false
+	}
+
+	var denomTrace types.DenomTrace
+	k.cdc.MustUnmarshalBinaryBare(bz, &denomTrace)
+	return denomTrace, true
+}
+
+// HasDenomTrace checks if a the key with the given denomination trace hash exists on the store.
+func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	return store.Has(denomTraceHash)
+}
+
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+	store.Set(denomTrace.Hash(), k.cdc.MustMarshalBinaryBare(&denomTrace))
+}
+
+// IterateDenomTraces iterates over the denomination traces in the store
+// and performs a callback function. If true is returned from the callback,
+// iteration is halted.
+func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.DenomTrace) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var denomTrace types.DenomTrace
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &denomTrace)
+
+		if cb(denomTrace) {
+			break
+		}
+	}
+}
+
+// GetAllDenomTraces returns the trace information for all the denominations,
+// sorted by their full denomination path.
+func (k Keeper) GetAllDenomTraces(ctx sdk.Context) types.Traces {
+	traces := types.Traces{}
+	k.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		traces = append(traces, denomTrace)
+		return false
+	})
+
+	return traces.Sort()
+}
+
+// DenomPathFromHash returns the full denomination path prefix from an ibc denom
+// with a hash component, i.e 'ibc/{hash}'.
+func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
+	hexHash := strings.TrimPrefix(denom, types.DenomPrefix+"/")
+
+	hash, err := types.ParseHexHash(hexHash)
+	if err != nil {
+		return "", err
+	}
+
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return "", sdkerrors.Wrap(types.ErrTraceNotFound, hexHash)
+	}
+
+	return denomTrace.GetFullDenomPath(), nil
+}
+
 // ClaimCapability allows the transfer module that can claim a capability that IBC module
 // passes to it
 func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
@@ -12,42 +12,62 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestSendTransfer() {
-	testCoins2 := sdk.NewCoins(sdk.NewCoin("testportid/secondchannel/atom", sdk.NewInt(100)))
 	capName := host.ChannelCapabilityPath(testPort1, testChannel1)
 
 	testCases := []struct {
-		msg           string
-		amount        sdk.Coins
-		malleate      func()
-		isSourceChain bool
-		expPass       bool
+		msg      string
+		amount   sdk.Coins
+		malleate func()
+		expPass  bool
 	}{
-		{"successful transfer from source chain", testCoins2,
+		{"successful transfer from source chain", testCoins,
 			func() {
 				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, true, true},
-		{"successful transfer from external chain", prefixCoins,
+			}, true},
+		{"successful transfer from external chain", voucherCoins,
 			func() {
-				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), banktypes.NewSupply(prefixCoins))
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, prefixCoins)
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), voucherTrace)
+				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), banktypes.NewSupply(voucherCoins))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, voucherCoins)
 				suite.Require().NoError(err)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, false, true},
+			}, true},
+		{"send disabled for native denom", testCoins,
+			func() {
+				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), banktypes.DefaultParams().SetSendEnabledParam("atom", false))
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false},
+		{"send disabled for vouchers", voucherCoins,
+			func() {
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), voucherTrace)
+				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), banktypes.NewSupply(voucherCoins))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, voucherCoins)
+				suite.Require().NoError(err)
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), banktypes.DefaultParams().SetSendEnabledParam(voucherTrace.IBCDenom(), false))
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false},
 		{"source channel not found", testCoins,
-			func() {}, true, false},
+			func() {}, false},
 		{"next seq send not found", testCoins,
 			func() {
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
-			}, true, false},
+			}, false},
 		// createOutgoingPacket tests
 		// - source chain
 		{"send coins failed", testCoins,
@@ -56,15 +76,26 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, true, false},
+			}, false},
 		// - receiving chain
-		{"send from module account failed", testCoins,
+		{"send from module account failed", voucherCoins,
+			func() {
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), voucherTrace)
+				suite.chainA.CreateClient(suite.chainB)
+				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
+				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
+				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
+			}, false},
+		{"denom trace not found", voucherCoins,
 			func() {
+				suite.chainA.App.BankKeeper.SetSupply(suite.chainA.GetContext(), banktypes.NewSupply(voucherCoins))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, voucherCoins)
+				suite.Require().NoError(err)
 				suite.chainA.CreateClient(suite.chainB)
 				suite.chainA.createConnection(testConnection, testConnection, testClientIDB, testClientIDA, connection.OPEN)
 				suite.chainA.createChannel(testPort1, testChannel1, testPort2, testChannel2, channeltypes.OPEN, channeltypes.ORDERED, testConnection)
 				suite.chainA.App.IBCKeeper.ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), testPort1, testChannel1, 1)
-			}, false, false},
+			}, false},
 		{"channel capability not found", testCoins,
 			func() {
 				suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), testAddr1, testCoins)
@@ -75,7 +106,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				// Release channel capability
 				cap, _ := suite.chainA.App.ScopedTransferKeeper.GetCapability(suite.chainA.GetContext(), capName)
 				suite.chainA.App.ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
-			}, true, false},
+			}, false},
 	}
 
 	for i, tc := range testCases {
@@ -106,53 +137,85 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 }
 
 func (suite *KeeperTestSuite) TestOnRecvPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
+	var data types.FungibleTokenPacketData
+
+	// the trace of the vouchers minted for the atoms received through the second channel
+	recvTrace := types.ParseDenomTrace(types.GetDenomPrefix(testPort2, testChannel2) + "atom")
 
 	testCases := []struct {
-		msg      string
-		malleate func()
-		expPass  bool
+		msg       string
+		malleate  func()
+		recvDenom string
+		expPass   bool
 	}{
-		{"success receive from source chain",
-			func() {}, true},
-		// onRecvPacket
-		// - source chain
-		{"no dest prefix on coin denom",
-			func() {
-				data.Amount = testCoins
-			}, false},
-		{"mint failed",
-			func() {
-				data.Amount = prefixCoins2
-				data.Amount[0].Amount = sdk.ZeroInt()
-			}, false},
-		// - receiving chain
-		{"incorrect dest prefix on coin denom",
+		// - receiving chain is the source
+		{"success receive on source chain",
 			func() {
 				data.Amount = prefixCoins
-			}, false},
-		{"success receive from external chain",
+				escrow := types.GetEscrowAddress(testPort2, testChannel2)
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
+				suite.Require().NoError(err)
+			}, "atom", true},
+		{"success receive on source chain of vouchers from a third chain",
+			func() {
+				data.Amount = sdk.Coins{sdk.Coin{Denom: "bank/firstchannel/transfer/channelzero/atom", Amount: sdk.NewInt(100)}}
+				escrow := types.GetEscrowAddress(testPort2, testChannel2)
+				coins := sdk.NewCoins(sdk.NewCoin(types.ParseDenomTrace("transfer/channelzero/atom").IBCDenom(), sdk.NewInt(100)))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, coins)
+				suite.Require().NoError(err)
+			}, types.ParseDenomTrace("transfer/channelzero/atom").IBCDenom(), true},
+		{"success receive on source chain with send disabled",
 			func() {
 				data.Amount = prefixCoins
 				escrow := types.GetEscrowAddress(testPort2, testChannel2)
 				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true},
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), banktypes.DefaultParams().SetSendEnabledParam("atom", false))
+			}, "atom", true},
+		{"empty escrow on source chain",
+			func() {
+				data.Amount = prefixCoins
+			}, "", false},
+		// - sender chain is the source
+		{"success receive from external chain",
+			func() {}, recvTrace.IBCDenom(), true},
+		{"success receive from external chain with existing denom trace",
+			func() {
+				suite.chainA.App.TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), recvTrace)
+			}, recvTrace.IBCDenom(), true},
+		{"invalid receiver address",
+			func() {
+				data.Receiver = "invalidaddress"
+			}, "", false},
+		{"more than one denom",
+			func() {
+				data.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("muon", 100))
+			}, "", false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+
+			data = types.NewFungibleTokenPacketData(testCoins, testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
+
 			err := suite.chainA.App.TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
+
+				balance := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr2, tc.recvDenom)
+				suite.Require().Equal(sdk.NewInt(100), balance.Amount)
+
+				// the trace of the minted vouchers is recorded
+				if tc.recvDenom == recvTrace.IBCDenom() {
+					suite.Require().True(suite.chainA.App.TransferKeeper.HasDenomTrace(suite.chainA.GetContext(), recvTrace.Hash()))
+				}
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
 			}
@@ -163,7 +226,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund
 func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
+	var data types.FungibleTokenPacketData
 
 	successAck := types.FungibleTokenPacketAcknowledgement{
 		Success: true,
@@ -177,53 +240,46 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 		msg      string
 		ack      types.FungibleTokenPacketAcknowledgement
 		malleate func()
-		source   bool
+		denom    string
 		success  bool // success of ack
 	}{
 		{"success ack causes no-op", successAck,
-			func() {}, true, true},
+			func() {}, "atom", true},
 		{"successful refund from source chain", failedAck,
 			func() {
 				escrow := types.GetEscrowAddress(testPort1, testChannel1)
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true, false},
+			}, "atom", false},
 		{"successful refund from external chain", failedAck,
 			func() {
 				data.Amount = prefixCoins
-			}, false, false},
+			}, voucherTrace.IBCDenom(), false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			data = types.NewFungibleTokenPacketData(testCoins, testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
-			var denom string
-			if tc.source {
-				prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-				denom = prefixCoins2[0].Denom[len(prefix):]
-			} else {
-				denom = data.Amount[0].Denom
-			}
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
-			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.denom)
 
 			err := suite.chainA.App.TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
 			suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
 
-			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.denom)
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
 
 			if tc.success {
 				suite.Require().Equal(sdk.ZeroInt(), deltaAmount, "successful ack changed balance")
 			} else {
-				suite.Require().Equal(prefixCoins2[0].Amount, deltaAmount, "failed ack did not trigger refund")
+				suite.Require().Equal(testCoins[0].Amount, deltaAmount, "failed ack did not trigger refund")
 			}
 		})
 	}
@@ -231,67 +287,62 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 // TestOnTimeoutPacket test private refundPacket function since it is a simple wrapper over it
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
-	data := types.NewFungibleTokenPacketData(prefixCoins2, testAddr1.String(), testAddr2.String())
-	testCoins2 := sdk.NewCoins(sdk.NewCoin("bank/firstchannel/atom", sdk.NewInt(100)))
+	var data types.FungibleTokenPacketData
 
 	testCases := []struct {
 		msg      string
 		malleate func()
-		source   bool
+		denom    string
 		expPass  bool
 	}{
 		{"successful timeout from source chain",
 			func() {
 				escrow := types.GetEscrowAddress(testPort1, testChannel1)
-				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100))))
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
 				suite.Require().NoError(err)
-			}, true, true},
-		{"successful timeout from external chain",
+			}, "atom", true},
+		{"successful timeout from source chain with send disabled",
 			func() {
-				data.Amount = testCoins2
-			}, false, true},
-		{"no source prefix on coin denom",
+				escrow := types.GetEscrowAddress(testPort1, testChannel1)
+				_, err := suite.chainA.App.BankKeeper.AddCoins(suite.chainA.GetContext(), escrow, testCoins)
+				suite.Require().NoError(err)
+				suite.chainA.App.BankKeeper.SetParams(suite.chainA.GetContext(), banktypes.DefaultParams().SetSendEnabledParam("atom", false))
+			}, "atom", true},
+		{"successful timeout from external chain",
 			func() {
-				data.Amount = prefixCoins2
-			}, false, false},
+				data.Amount = prefixCoins
+			}, voucherTrace.IBCDenom(), true},
 		{"unescrow failed",
 			func() {
-			}, true, false},
-		{"mint failed",
+			}, "atom", false},
+		{"invalid sender address",
 			func() {
-				data.Amount[0].Denom = prefixCoins2[0].Denom
-				data.Amount[0].Amount = sdk.ZeroInt()
-			}, true, false},
+				data.Amount = prefixCoins
+				data.Sender = "invalidaddress"
+			}, voucherTrace.IBCDenom(), false},
 	}
 
-	packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
-
 	for i, tc := range testCases {
 		tc := tc
 		i := i
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			data = types.NewFungibleTokenPacketData(testCoins, testAddr1.String(), testAddr2.String())
 			tc.malleate()
 
-			var denom string
-			if tc.source {
-				prefix := types.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
-				denom = prefixCoins2[0].Denom[len(prefix):]
-			} else {
-				denom = data.Amount[0].Denom
-			}
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, testPort1, testChannel1, testPort2, testChannel2, 100, 0)
 
-			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			preCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.denom)
 
 			err := suite.chainA.App.TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
 
-			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, denom)
+			postCoin := suite.chainA.App.BankKeeper.GetBalance(suite.chainA.GetContext(), testAddr1, tc.denom)
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
 
 			if tc.expPass {
 				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.msg)
-				suite.Require().Equal(prefixCoins2[0].Amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+				suite.Require().Equal(testCoins[0].Amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
 			} else {
 				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.msg)
 			}
@@ -22,9 +22,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { portID = strings.ToLower(simtypes.RandStringOfLength(r, 20)) },
 	)
 
-	transferGenesis := types.GenesisState{
-		PortID: portID,
-	}
+	transferGenesis := types.NewGenesisState(portID, types.Traces{})
 
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, transferGenesis))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(transferGenesis)
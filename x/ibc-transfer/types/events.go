@@ -5,6 +5,7 @@ const (
 	EventTypeTimeout      = "timeout"
 	EventTypePacket       = "fungible_token_packet"
 	EventTypeChannelClose = "channel_closed"
+	EventTypeDenomTrace   = "denomination_trace"
 
 	AttributeKeyReceiver       = "receiver"
 	AttributeKeyValue          = "value"
@@ -12,4 +13,6 @@ const (
 	AttributeKeyRefundValue    = "refund_value"
 	AttributeKeyAckSuccess     = "success"
 	AttributeKeyAckError       = "error"
+	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeyDenom          = "denom"
 )
@@ -26,6 +26,14 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with denom traces",
+			types.NewGenesisState("portidone", types.Traces{
+				{BaseDenom: "uatom", Path: "transfer/channelToA"},
+				{BaseDenom: "uatom", Path: "transfer/channelToB"},
+			}),
+			true,
+		},
 		{
 			"invalid client",
 			types.GenesisState{
@@ -33,6 +41,13 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid denom trace",
+			types.NewGenesisState("portidone", types.Traces{
+				{BaseDenom: "uatom", Path: "transfer"},
+			}),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -30,6 +30,9 @@ var (
 	coins, _          = sdk.ParseCoins("100atom")
 	invalidDenomCoins = sdk.Coins{sdk.Coin{Denom: "ato-m", Amount: sdk.NewInt(100)}}
 	negativeCoins     = sdk.Coins{sdk.Coin{Denom: "atom", Amount: sdk.NewInt(100)}, sdk.Coin{Denom: "atoms", Amount: sdk.NewInt(-100)}}
+	ibcCoins          = sdk.NewCoins(sdk.NewInt64Coin(ParseDenomTrace("transfer/channelzero/atom").IBCDenom(), 100))
+	prefixedCoins     = sdk.NewCoins(sdk.NewInt64Coin("transfer/channelzero/atom", 100))
+	invalidIBCCoins   = sdk.NewCoins(sdk.NewInt64Coin("ibc/0123456789", 100))
 )
 
 // TestMsgTransferRoute tests Route for MsgTransfer
@@ -61,6 +64,9 @@ func TestMsgTransferValidation(t *testing.T) {
 		NewMsgTransfer(validPort, validChannel, coins, emptyAddr, addr2, 10, 0),         // missing sender address
 		NewMsgTransfer(validPort, validChannel, coins, addr1, "", 10, 0),                // missing recipient address
 		NewMsgTransfer(validPort, validChannel, sdk.Coins{}, addr1, addr2, 10, 0),       // not possitive coin
+		NewMsgTransfer(validPort, validChannel, ibcCoins, addr1, addr2, 10, 0),          // valid ibc denom
+		NewMsgTransfer(validPort, validChannel, prefixedCoins, addr1, addr2, 10, 0),     // denom trace path instead of ibc denom
+		NewMsgTransfer(validPort, validChannel, invalidIBCCoins, addr1, addr2, 10, 0),   // invalid ibc denom hash
 	}
 
 	testCases := []struct {
@@ -79,6 +85,9 @@ func TestMsgTransferValidation(t *testing.T) {
 		{testMsgs[8], false, "amount contains negative coin"},
 		{testMsgs[9], false, "missing sender address"},
 		{testMsgs[10], false, "missing recipient address"},
+		{testMsgs[12], true, ""},
+		{testMsgs[13], false, "denom trace path instead of ibc denom"},
+		{testMsgs[14], false, "invalid ibc denom hash"},
 	}
 
 	for i, tc := range testCases {
@@ -40,8 +40,12 @@ func (ftpd FungibleTokenPacketData) ValidateBasic() error {
 	if !ftpd.Amount.IsAllPositive() {
 		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, ftpd.Amount.String())
 	}
-	if !ftpd.Amount.IsValid() {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, ftpd.Amount.String())
+	// NOTE: the packet denominations are the full trace paths of the tokens,
+	// which are not necessarily valid SDK denominations
+	for _, coin := range ftpd.Amount {
+		if err := ValidatePrefixedDenom(coin.Denom); err != nil {
+			return err
+		}
 	}
 	if strings.TrimSpace(ftpd.Sender) == "" {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be blank")
@@ -4,16 +4,21 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // TestFungibleTokenPacketDataValidateBasic tests ValidateBasic for FungibleTokenPacketData
 func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	invalidPrefixCoins := sdk.Coins{sdk.Coin{Denom: "transfer/atom", Amount: sdk.NewInt(100)}}
+
 	testPacketDataTransfer := []FungibleTokenPacketData{
 		NewFungibleTokenPacketData(coins, addr1.String(), addr2),              // valid msg
-		NewFungibleTokenPacketData(invalidDenomCoins, addr1.String(), addr2),  // invalid amount
+		NewFungibleTokenPacketData(invalidPrefixCoins, addr1.String(), addr2), // invalid denom trace
 		NewFungibleTokenPacketData(negativeCoins, addr1.String(), addr2),      // amount contains negative coin
 		NewFungibleTokenPacketData(coins, emptyAddr.String(), addr2),          // missing sender address
 		NewFungibleTokenPacketData(coins, addr1.String(), emptyAddr.String()), // missing recipient address
+		NewFungibleTokenPacketData(prefixedCoins, addr1.String(), addr2),      // valid msg with prefixed denom
 	}
 
 	testCases := []struct {
@@ -22,10 +27,11 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		errMsg     string
 	}{
 		{testPacketDataTransfer[0], true, ""},
-		{testPacketDataTransfer[1], false, "invalid amount"},
+		{testPacketDataTransfer[1], false, "invalid denom trace"},
 		{testPacketDataTransfer[2], false, "amount contains negative coin"},
 		{testPacketDataTransfer[3], false, "missing sender address"},
 		{testPacketDataTransfer[4], false, "missing recipient address"},
+		{testPacketDataTransfer[5], true, ""},
 	}
 
 	for i, tc := range testCases {
@@ -248,47 +248,107 @@ func (m *FungibleTokenPacketAcknowledgement) GetError() string {
 	return ""
 }
 
+// DenomTrace contains the base denomination for ICS20 fungible tokens and the source tracing
+// information path.
+type DenomTrace struct {
+	// path defines the chain of port/channel identifiers used for tracing the source of the fungible
+	// token.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// base denomination of the relayed fungible token.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+}
+
+func (m *DenomTrace) Reset()         { *m = DenomTrace{} }
+func (m *DenomTrace) String() string { return proto.CompactTextString(m) }
+func (*DenomTrace) ProtoMessage()    {}
+func (*DenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08134a70fd29e656, []int{3}
+}
+func (m *DenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTrace.Merge(m, src)
+}
+func (m *DenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTrace proto.InternalMessageInfo
+
+func (m *DenomTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DenomTrace) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.transfer.MsgTransfer")
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.transfer.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketAcknowledgement)(nil), "ibc.transfer.FungibleTokenPacketAcknowledgement")
+	proto.RegisterType((*DenomTrace)(nil), "ibc.transfer.DenomTrace")
 }
 
 func init() { proto.RegisterFile("ibc/transfer/transfer.proto", fileDescriptor_08134a70fd29e656) }
 
 var fileDescriptor_08134a70fd29e656 = []byte{
-	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x49, 0x9b, 0x96, 0x4b, 0x8a, 0xe0, 0x28, 0xe5, 0x08, 0xc8, 0x8e, 0x3c, 0x79, 0x49,
-	0x4c, 0x61, 0x40, 0x62, 0x22, 0x29, 0x42, 0x54, 0x08, 0xa9, 0xb2, 0x22, 0x06, 0x96, 0xca, 0x3e,
-	0xff, 0x70, 0x4e, 0x89, 0xef, 0xa2, 0xbb, 0x33, 0xd0, 0x6f, 0xc1, 0x97, 0x60, 0xe1, 0x93, 0x74,
-	0xec, 0xc0, 0xc0, 0x64, 0x50, 0xf2, 0x0d, 0x32, 0x32, 0x21, 0xff, 0x89, 0x89, 0xa5, 0x08, 0x31,
-	0x74, 0xba, 0x7b, 0xef, 0xfd, 0x7e, 0xcf, 0xf7, 0x7b, 0xbe, 0x43, 0x0f, 0x59, 0x40, 0x5d, 0x2d,
-	0x7d, 0xae, 0x3e, 0x80, 0xac, 0x36, 0x83, 0xb9, 0x14, 0x5a, 0xe0, 0x0e, 0x0b, 0xe8, 0x60, 0xcd,
-	0x75, 0x0f, 0x23, 0x11, 0x89, 0x5c, 0x70, 0xb3, 0x5d, 0x51, 0xd3, 0xbd, 0x4b, 0x85, 0x8a, 0x85,
-	0x72, 0x8b, 0xa5, 0x20, 0xed, 0xef, 0x4d, 0xd4, 0x7e, 0xab, 0xa2, 0x71, 0xd9, 0x8a, 0x9f, 0xa1,
-	0xb6, 0x12, 0x89, 0xa4, 0x70, 0x3e, 0x17, 0x52, 0x13, 0xa3, 0x67, 0x38, 0x37, 0x47, 0x47, 0xab,
-	0xd4, 0xc2, 0x17, 0x7e, 0x3c, 0x7b, 0x6e, 0x6f, 0x88, 0xb6, 0x87, 0x0a, 0x74, 0x26, 0xa4, 0xc6,
-	0x2f, 0xd0, 0xad, 0x52, 0xa3, 0x13, 0x9f, 0x73, 0x98, 0x91, 0x1b, 0x79, 0xef, 0x83, 0x55, 0x6a,
-	0xdd, 0xab, 0xf5, 0x96, 0xba, 0xed, 0x1d, 0x14, 0xc4, 0x49, 0x81, 0xf1, 0x3b, 0xd4, 0xf2, 0x63,
-	0x91, 0x70, 0x4d, 0x9a, 0xbd, 0xa6, 0xd3, 0x7e, 0xd2, 0x19, 0x94, 0x27, 0x3d, 0x11, 0x8c, 0x8f,
-	0x1e, 0x5f, 0xa6, 0x56, 0xe3, 0xdb, 0x4f, 0xcb, 0x89, 0x98, 0x9e, 0x24, 0xc1, 0x80, 0x8a, 0xd8,
-	0xad, 0x0d, 0xd4, 0x57, 0xe1, 0xd4, 0xd5, 0x17, 0x73, 0x28, 0x1a, 0x94, 0x57, 0xba, 0xe1, 0x53,
-	0xd4, 0x52, 0xc0, 0x43, 0x90, 0x64, 0xa7, 0x67, 0x38, 0x9d, 0xd1, 0xf1, 0xef, 0xd4, 0xea, 0xff,
-	0x87, 0xcb, 0x90, 0xd2, 0x61, 0x18, 0x4a, 0x50, 0xca, 0x2b, 0x0d, 0x70, 0x17, 0xed, 0x4b, 0xa0,
-	0xc0, 0x3e, 0x82, 0x24, 0xbb, 0xd9, 0x78, 0x5e, 0x85, 0xb3, 0x00, 0x34, 0x8b, 0x41, 0x24, 0xfa,
-	0x7c, 0x02, 0x2c, 0x9a, 0x68, 0xd2, 0xea, 0x19, 0xce, 0xce, 0x66, 0x00, 0x75, 0xdd, 0xf6, 0x0e,
-	0x4a, 0xe2, 0x75, 0x8e, 0xf1, 0x29, 0xba, 0xb3, 0xae, 0xc8, 0x56, 0xa5, 0xfd, 0x78, 0x4e, 0xf6,
-	0x72, 0x93, 0x47, 0xab, 0xd4, 0x22, 0x75, 0x93, 0xaa, 0xc4, 0xf6, 0x6e, 0x97, 0xdc, 0xb8, 0xa2,
-	0xbe, 0x1a, 0xe8, 0xfe, 0xab, 0x84, 0x47, 0x2c, 0x98, 0xc1, 0x58, 0x4c, 0x81, 0x9f, 0xf9, 0x74,
-	0x0a, 0xfa, 0xa5, 0xaf, 0xfd, 0x8d, 0x9c, 0x8d, 0x6b, 0xcd, 0xf9, 0xa8, 0xca, 0x39, 0xff, 0xf3,
-	0x5b, 0x43, 0x6b, 0xd6, 0x43, 0xb3, 0xc7, 0xc8, 0xde, 0x72, 0xcc, 0x21, 0x9d, 0x72, 0xf1, 0x69,
-	0x06, 0x61, 0x04, 0x31, 0x70, 0x8d, 0x09, 0xda, 0x53, 0x09, 0xa5, 0xa0, 0x54, 0x7e, 0x21, 0xf7,
-	0xbd, 0x35, 0xc4, 0x87, 0x68, 0x17, 0xa4, 0x14, 0xeb, 0x4f, 0x16, 0x60, 0xf4, 0xe6, 0x72, 0x61,
-	0x1a, 0x57, 0x0b, 0xd3, 0xf8, 0xb5, 0x30, 0x8d, 0x2f, 0x4b, 0xb3, 0x71, 0xb5, 0x34, 0x1b, 0x3f,
-	0x96, 0x66, 0xe3, 0xfd, 0xf1, 0x3f, 0xa7, 0xfa, 0xec, 0xb2, 0x80, 0xf6, 0xff, 0xbe, 0xb1, 0x6c,
-	0xc8, 0xa0, 0x95, 0x3f, 0x94, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x95, 0x43, 0xad,
-	0x80, 0x03, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0x68, 0xd7, 0x6d, 0x6e, 0x87, 0x98, 0xd9, 0x46, 0x28, 0x28, 0xa9, 0x72, 0xea, 0xa5,
+	0x0d, 0x03, 0x24, 0x24, 0x4e, 0xb4, 0x9b, 0x10, 0x13, 0x42, 0x9a, 0xa2, 0x6a, 0x07, 0x2e, 0x95,
+	0xe3, 0x7c, 0xa4, 0x51, 0x1b, 0xbb, 0xb2, 0x1d, 0x60, 0xff, 0x82, 0x3f, 0xc1, 0x85, 0x5f, 0xb2,
+	0xe3, 0x0e, 0x1c, 0x38, 0x05, 0xd4, 0xfe, 0x83, 0x1e, 0x39, 0xa1, 0xc4, 0x69, 0xd7, 0x48, 0x13,
+	0xe2, 0xc0, 0xc9, 0x7e, 0xef, 0x7d, 0xdf, 0x8b, 0xfd, 0xf2, 0x19, 0x3d, 0x8a, 0x7c, 0xea, 0x2a,
+	0x41, 0x98, 0xfc, 0x00, 0x62, 0xbd, 0xe9, 0xcd, 0x04, 0x57, 0x1c, 0x37, 0x23, 0x9f, 0xf6, 0x56,
+	0x5c, 0xeb, 0x20, 0xe4, 0x21, 0xcf, 0x05, 0x37, 0xdb, 0xe9, 0x9a, 0xd6, 0x7d, 0xca, 0x65, 0xcc,
+	0xa5, 0xab, 0x17, 0x4d, 0x3a, 0xdf, 0xab, 0xa8, 0xf1, 0x4e, 0x86, 0xc3, 0xa2, 0x15, 0xbf, 0x40,
+	0x0d, 0xc9, 0x13, 0x41, 0x61, 0x34, 0xe3, 0x42, 0x99, 0x46, 0xdb, 0xe8, 0xec, 0x0e, 0x8e, 0x96,
+	0xa9, 0x8d, 0x2f, 0x49, 0x3c, 0x7d, 0xe9, 0x6c, 0x88, 0x8e, 0x87, 0x34, 0x3a, 0xe7, 0x42, 0xe1,
+	0x57, 0xe8, 0x6e, 0xa1, 0xd1, 0x31, 0x61, 0x0c, 0xa6, 0xe6, 0x9d, 0xbc, 0xf7, 0xe1, 0x32, 0xb5,
+	0x0f, 0x4b, 0xbd, 0x85, 0xee, 0x78, 0x7b, 0x9a, 0x38, 0xd1, 0x18, 0x5f, 0xa0, 0x3a, 0x89, 0x79,
+	0xc2, 0x94, 0x59, 0x6d, 0x57, 0x3b, 0x8d, 0xa7, 0xcd, 0x5e, 0x71, 0xd2, 0x13, 0x1e, 0xb1, 0xc1,
+	0x93, 0xab, 0xd4, 0xae, 0x7c, 0xfb, 0x69, 0x77, 0xc2, 0x48, 0x8d, 0x13, 0xbf, 0x47, 0x79, 0xec,
+	0x96, 0x2e, 0xd4, 0x95, 0xc1, 0xc4, 0x55, 0x97, 0x33, 0xd0, 0x0d, 0xd2, 0x2b, 0xdc, 0xf0, 0x19,
+	0xaa, 0x4b, 0x60, 0x01, 0x08, 0xb3, 0xd6, 0x36, 0x3a, 0xcd, 0xc1, 0xf1, 0xef, 0xd4, 0xee, 0xfe,
+	0x83, 0x4b, 0x9f, 0xd2, 0x7e, 0x10, 0x08, 0x90, 0xd2, 0x2b, 0x0c, 0x70, 0x0b, 0xed, 0x08, 0xa0,
+	0x10, 0x7d, 0x04, 0x61, 0x6e, 0x65, 0xd7, 0xf3, 0xd6, 0x38, 0x0b, 0x40, 0x45, 0x31, 0xf0, 0x44,
+	0x8d, 0xc6, 0x10, 0x85, 0x63, 0x65, 0xd6, 0xdb, 0x46, 0xa7, 0xb6, 0x19, 0x40, 0x59, 0x77, 0xbc,
+	0xbd, 0x82, 0x78, 0x93, 0x63, 0x7c, 0x86, 0xf6, 0x57, 0x15, 0xd9, 0x2a, 0x15, 0x89, 0x67, 0xe6,
+	0x76, 0x6e, 0xf2, 0x78, 0x99, 0xda, 0x66, 0xd9, 0x64, 0x5d, 0xe2, 0x78, 0xf7, 0x0a, 0x6e, 0xb8,
+	0xa6, 0xbe, 0x1a, 0xe8, 0xc1, 0xeb, 0x84, 0x85, 0x91, 0x3f, 0x85, 0x21, 0x9f, 0x00, 0x3b, 0x27,
+	0x74, 0x02, 0xea, 0x94, 0x28, 0xb2, 0x91, 0xb3, 0xf1, 0x5f, 0x73, 0x3e, 0x5a, 0xe7, 0x9c, 0xff,
+	0xf9, 0x5b, 0x43, 0xab, 0x96, 0x43, 0x73, 0x86, 0xc8, 0xb9, 0xe5, 0x98, 0x7d, 0x3a, 0x61, 0xfc,
+	0xd3, 0x14, 0x82, 0x10, 0x62, 0x60, 0x0a, 0x9b, 0x68, 0x5b, 0x26, 0x94, 0x82, 0x94, 0xf9, 0x40,
+	0xee, 0x78, 0x2b, 0x88, 0x0f, 0xd0, 0x16, 0x08, 0xc1, 0x57, 0x9f, 0xd4, 0xc0, 0xb9, 0x40, 0xe8,
+	0x14, 0x18, 0x8f, 0x87, 0x82, 0x50, 0xc0, 0x18, 0xd5, 0x66, 0x44, 0x8d, 0xf5, 0x2c, 0x7b, 0xf9,
+	0x1e, 0x3f, 0x47, 0xc8, 0x27, 0x12, 0x46, 0x41, 0x56, 0x56, 0x4c, 0xea, 0xe1, 0x32, 0xb5, 0xf7,
+	0x75, 0xc6, 0x37, 0x9a, 0xe3, 0xed, 0x66, 0x20, 0xb7, 0x1b, 0xbc, 0xbd, 0x9a, 0x5b, 0xc6, 0xf5,
+	0xdc, 0x32, 0x7e, 0xcd, 0x2d, 0xe3, 0xcb, 0xc2, 0xaa, 0x5c, 0x2f, 0xac, 0xca, 0x8f, 0x85, 0x55,
+	0x79, 0x7f, 0xfc, 0xd7, 0xb4, 0x3e, 0xbb, 0x91, 0x4f, 0xbb, 0x37, 0x6f, 0x37, 0x0b, 0xcf, 0xaf,
+	0xe7, 0x0f, 0xf0, 0xd9, 0x9f, 0x01, 0x00, 0xa8, 0x17, 0x47, 0xf9, 0xd8, 0x03, 0x00, 0x00,
 }
 
 func (m *MsgTransfer) Marshal() (dAtA []byte, err error) {
@@ -457,6 +517,43 @@ func (m *FungibleTokenPacketAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -544,6 +641,23 @@ func (m *FungibleTokenPacketAcknowledgement) Size() (n int) {
 	return n
 }
 
+func (m *DenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	return n
+}
+
 func sovTransfer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1061,6 +1175,123 @@ func (m *FungibleTokenPacketAcknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTransfer(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
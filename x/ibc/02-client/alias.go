@@ -11,19 +11,22 @@ import (
 )
 
 const (
-	AttributeKeyClientID   = types.AttributeKeyClientID
-	AttributeKeyClientType = types.AttributeKeyClientType
-	SubModuleName          = types.SubModuleName
-	RouterKey              = types.RouterKey
-	QuerierRoute           = types.QuerierRoute
-	QueryAllClients        = types.QueryAllClients
-	QueryClientState       = types.QueryClientState
-	QueryConsensusState    = types.QueryConsensusState
+	AttributeKeyClientID     = types.AttributeKeyClientID
+	AttributeKeyClientType   = types.AttributeKeyClientType
+	SubModuleName            = types.SubModuleName
+	TypeMsgUpgradeClient     = types.TypeMsgUpgradeClient
+	ProposalTypeClientUpdate = types.ProposalTypeClientUpdate
+	RouterKey                = types.RouterKey
+	QuerierRoute             = types.QuerierRoute
+	QueryAllClients          = types.QueryAllClients
+	QueryClientState         = types.QueryClientState
+	QueryConsensusState      = types.QueryConsensusState
 )
 
 var (
 	// functions aliases
 	RegisterCodec             = types.RegisterCodec
+	RegisterInterfaces        = types.RegisterInterfaces
 	SetSubModuleCodec         = types.SetSubModuleCodec
 	NewClientConsensusStates  = types.NewClientConsensusStates
 	NewGenesisState           = types.NewGenesisState
@@ -31,6 +34,8 @@ var (
 	NewQueryAllClientsParams  = types.NewQueryAllClientsParams
 	NewClientStateResponse    = types.NewClientStateResponse
 	NewConsensusStateResponse = types.NewConsensusStateResponse
+	NewMsgUpgradeClient       = types.NewMsgUpgradeClient
+	NewClientUpdateProposal   = types.NewClientUpdateProposal
 	NewKeeper                 = keeper.NewKeeper
 	QuerierClients            = keeper.QuerierClients
 
@@ -54,8 +59,12 @@ var (
 	ErrFailedPacketAckAbsenceVerification     = types.ErrFailedPacketAckAbsenceVerification
 	ErrFailedNextSeqRecvVerification          = types.ErrFailedNextSeqRecvVerification
 	ErrSelfConsensusStateNotFound             = types.ErrSelfConsensusStateNotFound
+	ErrInvalidUpgradeClient                   = types.ErrInvalidUpgradeClient
+	ErrInvalidSubstitute                      = types.ErrInvalidSubstitute
 	EventTypeCreateClient                     = types.EventTypeCreateClient
 	EventTypeUpdateClient                     = types.EventTypeUpdateClient
+	EventTypeUpgradeClient                    = types.EventTypeUpgradeClient
+	EventTypeUpdateClientProposal             = types.EventTypeUpdateClientProposal
 	EventTypeSubmitMisbehaviour               = types.EventTypeSubmitMisbehaviour
 	AttributeValueCategory                    = types.AttributeValueCategory
 )
@@ -67,5 +76,7 @@ type (
 	QueryAllClientsParams  = types.QueryAllClientsParams
 	StateResponse          = types.StateResponse
 	ConsensusStateResponse = types.ConsensusStateResponse
+	MsgUpgradeClient       = types.MsgUpgradeClient
+	ClientUpdateProposal   = types.ClientUpdateProposal
 	Keeper                 = keeper.Keeper
 )
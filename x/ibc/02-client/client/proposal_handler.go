@@ -0,0 +1,10 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/client/rest"
+)
+
+// UpdateClientProposalHandler is the update client proposal handler.
+var UpdateClientProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateClientProposal, rest.ProposalRESTHandler)
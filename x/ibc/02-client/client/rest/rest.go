@@ -4,6 +4,8 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 )
 
 // REST client flags
@@ -16,3 +18,13 @@ const (
 func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
 	registerQueryRoutes(clientCtx, r)
 }
+
+// UpdateClientProposalReq defines the properties of a client update proposal request's body.
+type UpdateClientProposalReq struct {
+	BaseReq            rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Title              string       `json:"title" yaml:"title"`
+	Description        string       `json:"description" yaml:"description"`
+	ClientID           string       `json:"client_id" yaml:"client_id"`
+	SubstituteClientID string       `json:"substitute_client_id" yaml:"substitute_client_id"`
+	Deposit            sdk.Coins    `json:"deposit" yaml:"deposit"`
+}
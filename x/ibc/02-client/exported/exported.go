@@ -106,6 +106,33 @@ type ClientState interface {
 		nextSequenceRecv uint64,
 		consensusState ConsensusState,
 	) error
+
+	// Upgrade functions
+
+	// VerifyUpgrade verifies a proof of the upgraded client state committed by
+	// the counterparty chain at the given upgrade height against the provided
+	// trusted consensus state. It returns the upgraded client state, which keeps
+	// the client identifier, and the consensus state to store with it.
+	VerifyUpgrade(
+		store sdk.KVStore,
+		cdc codec.Marshaler,
+		aminoCdc *codec.Codec,
+		upgradedClient ClientState,
+		upgradeHeight uint64,
+		proofUpgrade []byte,
+		consensusState ConsensusState,
+	) (ClientState, ConsensusState, error)
+
+	// Governance recovery functions
+
+	// CheckSubstituteAndUpdateState checks that the client allows to be recovered
+	// by governance and that the substitute client state is active and has the
+	// same parameters. It returns the substitute client state set into the
+	// client identifier.
+	CheckSubstituteAndUpdateState(
+		ctx sdk.Context,
+		substituteClient ClientState,
+	) (ClientState, error)
 }
 
 // ConsensusState is the state of the consensus process
@@ -162,12 +189,14 @@ type ClientType byte
 const (
 	Tendermint ClientType = iota + 1 // 1
 	Localhost
+	SoloMachine
 )
 
 // string representation of the client types
 const (
-	ClientTypeTendermint string = "tendermint"
-	ClientTypeLocalHost  string = "localhost"
+	ClientTypeTendermint  string = "tendermint"
+	ClientTypeLocalHost   string = "localhost"
+	ClientTypeSoloMachine string = "solomachine"
 )
 
 func (ct ClientType) String() string {
@@ -176,6 +205,8 @@ func (ct ClientType) String() string {
 		return ClientTypeTendermint
 	case Localhost:
 		return ClientTypeLocalHost
+	case SoloMachine:
+		return ClientTypeSoloMachine
 	default:
 		return ""
 	}
@@ -211,6 +242,8 @@ func ClientTypeFromString(clientType string) ClientType {
 		return Tendermint
 	case ClientTypeLocalHost:
 		return Localhost
+	case ClientTypeSoloMachine:
+		return SoloMachine
 	default:
 		return 0
 	}
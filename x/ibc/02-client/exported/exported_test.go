@@ -13,6 +13,7 @@ func TestClientTypeString(t *testing.T) {
 		clientType ClientType
 	}{
 		{"tendermint client", ClientTypeTendermint, Tendermint},
+		{"solo machine client", ClientTypeSoloMachine, SoloMachine},
 		{"empty type", "", 0},
 	}
 
@@ -31,6 +32,7 @@ func TestClientTypeMarshalJSON(t *testing.T) {
 		expectPass bool
 	}{
 		{"tendermint client should have passed", ClientTypeTendermint, Tendermint, true},
+		{"solo machine client should have passed", ClientTypeSoloMachine, SoloMachine, true},
 		{"empty type should have failed", "", 0, false},
 	}
 
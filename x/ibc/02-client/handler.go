@@ -9,6 +9,7 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 )
@@ -35,6 +36,18 @@ func HandleMsgCreateClient(ctx sdk.Context, k Keeper, msg exported.MsgCreateClie
 			return nil, err
 		}
 		consensusHeight = msg.GetConsensusState().GetHeight()
+	case exported.SoloMachine:
+		smMsg, ok := msg.(solomachinetypes.MsgCreateClient)
+		if !ok {
+			return nil, sdkerrors.Wrap(ErrInvalidClientType, "Msg is not a SoloMachine CreateClient msg")
+		}
+		var err error
+
+		clientState, err = solomachinetypes.InitializeFromMsg(smMsg)
+		if err != nil {
+			return nil, err
+		}
+		consensusHeight = msg.GetConsensusState().GetHeight()
 	case exported.Localhost:
 		// msg client id is always "localhost"
 		clientState = localhosttypes.NewClientState(ctx.ChainID(), ctx.BlockHeight())
@@ -80,6 +93,18 @@ func HandleMsgUpdateClient(ctx sdk.Context, k Keeper, msg exported.MsgUpdateClie
 	}, nil
 }
 
+// HandleMsgUpgradeClient defines the sdk.Handler for MsgUpgradeClient
+func HandleMsgUpgradeClient(ctx sdk.Context, k Keeper, msg *types.MsgUpgradeClient) (*sdk.Result, error) {
+	_, err := k.UpgradeClient(ctx, msg.ClientID, msg.ClientState, msg.UpgradeHeight, msg.ProofUpgrade)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events().ToABCIEvents(),
+	}, nil
+}
+
 // HandlerClientMisbehaviour defines the Evidence module handler for submitting a
 // light client misbehaviour.
 func HandlerClientMisbehaviour(k Keeper) evidencetypes.Handler {
@@ -7,6 +7,8 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	tendermint "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
@@ -75,6 +77,10 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 		clientState, consensusState, err = tendermint.CheckValidityAndUpdateState(
 			clientState, header, ctx.BlockTime(),
 		)
+	case exported.SoloMachine:
+		clientState, consensusState, err = solomachine.CheckValidityAndUpdateState(
+			clientState, header,
+		)
 	case exported.Localhost:
 		// override client state and update the block height
 		clientState = localhosttypes.NewClientState(
@@ -94,8 +100,8 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 
 	// we don't set consensus state for localhost client
 	if header != nil && clientType != exported.Localhost {
-		k.SetClientConsensusState(ctx, clientID, header.GetHeight(), consensusState)
 		consensusHeight = consensusState.GetHeight()
+		k.SetClientConsensusState(ctx, clientID, consensusHeight, consensusState)
 	}
 
 	k.Logger(ctx).Info(fmt.Sprintf("client %s updated to height %d", clientID, clientState.GetLatestHeight()))
@@ -123,6 +129,67 @@ func (k Keeper) UpdateClient(ctx sdk.Context, clientID string, header exported.H
 	return clientState, nil
 }
 
+// UpgradeClient upgrades the client to the client state committed by the
+// counterparty chain in its upgrade plan. The proof of the upgraded client state
+// is verified against the latest consensus state of the client. The client keeps
+// its identifier and thus all of its connections and channels.
+func (k Keeper) UpgradeClient(
+	ctx sdk.Context, clientID string, upgradedClient exported.ClientState, upgradeHeight uint64, proofUpgrade []byte,
+) (exported.ClientState, error) {
+	clientType, found := k.GetClientType(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClientTypeNotFound, "cannot upgrade client with ID %s", clientID)
+	}
+
+	if upgradedClient.ClientType() != clientType {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidClientType, "cannot upgrade client with ID %s to a %s client", clientID, upgradedClient.ClientType(),
+		)
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrClientNotFound, "cannot upgrade client with ID %s", clientID)
+	}
+
+	// prevent upgrade if the client is frozen
+	if clientState.IsFrozen() {
+		return nil, sdkerrors.Wrapf(types.ErrClientFrozen, "cannot upgrade client with ID %s", clientID)
+	}
+
+	consensusState, found := k.GetClientConsensusState(ctx, clientID, clientState.GetLatestHeight())
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrConsensusStateNotFound, "cannot upgrade client with ID %s", clientID)
+	}
+
+	updatedClientState, updatedConsState, err := clientState.VerifyUpgrade(
+		k.ClientStore(ctx, clientID), k.cdc, k.aminoCdc, upgradedClient, upgradeHeight, proofUpgrade, consensusState,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "cannot upgrade client with ID %s", clientID)
+	}
+
+	k.SetClientState(ctx, updatedClientState)
+	k.SetClientConsensusState(ctx, clientID, updatedConsState.GetHeight(), updatedConsState)
+
+	k.Logger(ctx).Info(fmt.Sprintf("client %s upgraded to chain %s at height %d", clientID, updatedClientState.GetChainID(), updatedClientState.GetLatestHeight()))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpgradeClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType.String()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, fmt.Sprintf("%d", updatedConsState.GetHeight())),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return updatedClientState, nil
+}
+
 // CheckMisbehaviourAndUpdateState checks for client misbehaviour and freezes the
 // client if so.
 func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour exported.Misbehaviour) error {
@@ -143,6 +210,11 @@ func (k Keeper) CheckMisbehaviourAndUpdateState(ctx sdk.Context, misbehaviour ex
 			clientState, consensusState, misbehaviour, consensusState.GetHeight(), ctx.BlockTime(), ctx.ConsensusParams(),
 		)
 
+	case solomachinetypes.Evidence:
+		clientState, err = solomachine.CheckMisbehaviourAndUpdateState(
+			clientState, consensusState, misbehaviour,
+		)
+
 	default:
 		err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized IBC client evidence type: %T", e)
 	}
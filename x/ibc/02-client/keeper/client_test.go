@@ -13,6 +13,8 @@ import (
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 const (
@@ -38,12 +40,12 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 		i := i
 		if tc.expPanic {
 			suite.Require().Panics(func() {
-				clientState, err := ibctmtypes.Initialize(tc.clientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+				clientState, err := ibctmtypes.Initialize(tc.clientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 				suite.Require().NoError(err, "err on client state initialization")
 				suite.keeper.CreateClient(suite.ctx, clientState, suite.consensusState)
 			}, "Msg %d didn't panic: %s", i, tc.msg)
 		} else {
-			clientState, err := ibctmtypes.Initialize(tc.clientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+			clientState, err := ibctmtypes.Initialize(tc.clientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 			if tc.expPass {
 				suite.Require().NoError(err, "errored on initialization")
 				suite.Require().NotNil(clientState, "valid test case %d failed: %s", i, tc.msg)
@@ -80,7 +82,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 		expPass  bool
 	}{
 		{"valid update", func() error {
-			clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+			clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 			if err != nil {
 				return err
 			}
@@ -114,7 +116,7 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			return nil
 		}, false},
 		{"invalid header", func() error {
-			clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+			clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 			if err != nil {
 				return err
 			}
@@ -189,6 +191,102 @@ func (suite *KeeperTestSuite) TestUpdateClientLocalhost() {
 	suite.Require().Equal(localhostClient.GetLatestHeight()+1, updatedClientState.GetLatestHeight())
 }
 
+func (suite *KeeperTestSuite) TestUpgradeClient() {
+	var (
+		clientID       string
+		upgradedClient exported.ClientState
+		proofUpgrade   []byte
+		chainA         *ibctesting.TestChain
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"successful upgrade", func() {}, true},
+		{"client type not found", func() {
+			clientID = testClientID
+		}, false},
+		{"upgraded client type does not match", func() {
+			upgradedClient = localhosttypes.NewClientState("upgraded-chain", 1)
+		}, false},
+		{"client is frozen", func() {
+			clientState, _ := chainA.App.IBCKeeper.ClientKeeper.GetClientState(chainA.GetContext(), clientID)
+			tmClient := clientState.(ibctmtypes.ClientState)
+			tmClient.FrozenHeight = 1
+			chainA.App.IBCKeeper.ClientKeeper.SetClientState(chainA.GetContext(), tmClient)
+		}, false},
+		{"invalid proof", func() {
+			proofUpgrade = []byte("invalid proof")
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			coordinator := ibctesting.NewCoordinator(suite.T(), 2)
+			chainA = coordinator.GetChain(ibctesting.GetChainID(0))
+			chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+			var err error
+			clientID, err = coordinator.CreateClient(chainA.ChainID, chainB.ChainID, exported.Tendermint)
+			suite.Require().NoError(err)
+
+			// commit the upgraded client state on the counterparty chain
+			upgradeHeight := uint64(chainB.CurrentHeader.Height + 10)
+			upgradedHeader := ibctmtypes.CreateTestHeader("upgraded-chain", 1, chainB.CurrentHeader.Time, chainB.Vals, chainB.Signers)
+			tmUpgradedClient := ibctmtypes.NewClientState(
+				"", ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod,
+				ibctesting.MaxClockDrift, upgradedHeader, commitmenttypes.GetSDKSpecs(), false, false,
+			)
+			upgradedClient = tmUpgradedClient
+
+			plan := upgradetypes.Plan{
+				Name:                "upgrade",
+				Height:              int64(upgradeHeight),
+				UpgradedClientState: chainB.App.Codec().MustMarshalBinaryBare(tmUpgradedClient.ZeroCustomFields()),
+			}
+			suite.Require().NoError(chainB.App.UpgradeKeeper.ScheduleUpgrade(chainB.GetContext(), plan))
+			coordinator.CommitBlock(chainB.ChainID)
+
+			proofUpgrade, _ = chainB.QueryUpgradeProof(upgradetypes.UpgradedClientKey(int64(upgradeHeight)))
+
+			// the test headers do not commit to the app hash, so set the root of the latest
+			// consensus state to the counterparty chain commitment
+			clientState, _ := chainA.App.IBCKeeper.ClientKeeper.GetClientState(chainA.GetContext(), clientID)
+			consensusState, _ := chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(chainA.GetContext(), clientID, clientState.GetLatestHeight())
+			tmConsState := consensusState.(ibctmtypes.ConsensusState)
+			tmConsState.Root = commitmenttypes.NewMerkleRoot(chainB.App.LastCommitID().Hash)
+			chainA.App.IBCKeeper.ClientKeeper.SetClientConsensusState(chainA.GetContext(), clientID, clientState.GetLatestHeight(), tmConsState)
+
+			tc.malleate()
+
+			ctx := chainA.GetContext()
+			newClient, err := chainA.App.IBCKeeper.ClientKeeper.UpgradeClient(ctx, clientID, upgradedClient, upgradeHeight, proofUpgrade)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clientID, newClient.GetID())
+				suite.Require().Equal("upgraded-chain", newClient.GetChainID())
+
+				storedClient, found := chainA.App.IBCKeeper.ClientKeeper.GetClientState(ctx, clientID)
+				suite.Require().True(found)
+				suite.Require().Equal(newClient.GetChainID(), storedClient.GetChainID())
+				suite.Require().Equal(newClient.GetLatestHeight(), storedClient.GetLatestHeight())
+
+				storedConsState, found := chainA.App.IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, clientID, newClient.GetLatestHeight())
+				suite.Require().True(found)
+				suite.Require().Equal(upgradedHeader.ConsensusState().Root, storedConsState.GetRoot())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(newClient)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 	altPrivVal := tmtypes.NewMockPV()
 	altPubKey, err := altPrivVal.GetPubKey()
@@ -229,7 +327,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 			},
 			func() error {
 				suite.consensusState.ValidatorSet = bothValSet
-				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 				if err != nil {
 					return err
 				}
@@ -249,7 +347,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 			},
 			func() error {
 				suite.consensusState.ValidatorSet = bothValSet
-				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 				if err != nil {
 					return err
 				}
@@ -304,7 +402,7 @@ func (suite *KeeperTestSuite) TestCheckMisbehaviourAndUpdateState() {
 				ClientID: testClientID,
 			},
 			func() error {
-				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs())
+				clientState, err := ibctmtypes.Initialize(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, suite.header, commitmenttypes.GetSDKSpecs(), false, false)
 				if err != nil {
 					return err
 				}
@@ -22,14 +22,16 @@ import (
 // state information
 type Keeper struct {
 	storeKey      sdk.StoreKey
-	cdc           *codec.Codec
+	aminoCdc      *codec.Codec    // amino codec. TODO: remove after clients have been migrated to proto
+	cdc           codec.Marshaler // hybrid codec
 	stakingKeeper types.StakingKeeper
 }
 
 // NewKeeper creates a new NewKeeper instance
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, sk types.StakingKeeper) Keeper {
+func NewKeeper(aminoCdc *codec.Codec, cdc codec.Marshaler, key sdk.StoreKey, sk types.StakingKeeper) Keeper {
 	return Keeper{
 		storeKey:      key,
+		aminoCdc:      aminoCdc,
 		cdc:           cdc,
 		stakingKeeper: sk,
 	}
@@ -49,14 +51,14 @@ func (k Keeper) GetClientState(ctx sdk.Context, clientID string) (exported.Clien
 	}
 
 	var clientState exported.ClientState
-	k.cdc.MustUnmarshalBinaryBare(bz, &clientState)
+	k.aminoCdc.MustUnmarshalBinaryBare(bz, &clientState)
 	return clientState, true
 }
 
 // SetClientState sets a particular Client to the store
 func (k Keeper) SetClientState(ctx sdk.Context, clientState exported.ClientState) {
 	store := k.ClientStore(ctx, clientState.GetID())
-	bz := k.cdc.MustMarshalBinaryBare(clientState)
+	bz := k.aminoCdc.MustMarshalBinaryBare(clientState)
 	store.Set(host.KeyClientState(), bz)
 }
 
@@ -86,7 +88,7 @@ func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height
 	}
 
 	var consensusState exported.ConsensusState
-	k.cdc.MustUnmarshalBinaryBare(bz, &consensusState)
+	k.aminoCdc.MustUnmarshalBinaryBare(bz, &consensusState)
 	return consensusState, true
 }
 
@@ -94,7 +96,7 @@ func (k Keeper) GetClientConsensusState(ctx sdk.Context, clientID string, height
 // height
 func (k Keeper) SetClientConsensusState(ctx sdk.Context, clientID string, height uint64, consensusState exported.ConsensusState) {
 	store := k.ClientStore(ctx, clientID)
-	bz := k.cdc.MustMarshalBinaryBare(consensusState)
+	bz := k.aminoCdc.MustMarshalBinaryBare(consensusState)
 	store.Set(host.KeyConsensusState(height), bz)
 }
 
@@ -114,7 +116,7 @@ func (k Keeper) IterateConsensusStates(ctx sdk.Context, cb func(clientID string,
 		}
 		clientID := keySplit[1]
 		var consensusState exported.ConsensusState
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &consensusState)
+		k.aminoCdc.MustUnmarshalBinaryBare(iterator.Value(), &consensusState)
 
 		if cb(clientID, consensusState) {
 			break
@@ -220,7 +222,7 @@ func (k Keeper) IterateClients(ctx sdk.Context, cb func(exported.ClientState) bo
 			continue
 		}
 		var clientState exported.ClientState
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &clientState)
+		k.aminoCdc.MustUnmarshalBinaryBare(iterator.Value(), &clientState)
 
 		if cb(clientState) {
 			break
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// ClientUpdateProposal sets the client state of the substitute client into the
+// subject client, along with the latest consensus state of the substitute. The
+// light client implementation checks that the subject client allows to be
+// recovered and that the substitute is an active client with the same parameters.
+func (k Keeper) ClientUpdateProposal(ctx sdk.Context, p *types.ClientUpdateProposal) error {
+	subjectClientState, found := k.GetClientState(ctx, p.ClientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "subject client with ID %s", p.ClientID)
+	}
+
+	substituteClientState, found := k.GetClientState(ctx, p.SubstituteClientID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClientNotFound, "substitute client with ID %s", p.SubstituteClientID)
+	}
+
+	if subjectClientState.ClientType() != substituteClientState.ClientType() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidClientType, "subject client type %s does not match substitute client type %s",
+			subjectClientState.ClientType(), substituteClientState.ClientType(),
+		)
+	}
+
+	consensusState, found := k.GetClientConsensusState(ctx, p.SubstituteClientID, substituteClientState.GetLatestHeight())
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrConsensusStateNotFound, "substitute client with ID %s at height %d",
+			p.SubstituteClientID, substituteClientState.GetLatestHeight(),
+		)
+	}
+
+	clientState, err := subjectClientState.CheckSubstituteAndUpdateState(ctx, substituteClientState)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot update client with ID %s", p.ClientID)
+	}
+
+	k.SetClientState(ctx, clientState)
+	k.SetClientConsensusState(ctx, p.ClientID, clientState.GetLatestHeight(), consensusState)
+
+	k.Logger(ctx).Info(fmt.Sprintf("client %s updated by governance to height %d", p.ClientID, clientState.GetLatestHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateClientProposal,
+			sdk.NewAttribute(types.AttributeKeyClientID, p.ClientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientState.ClientType().String()),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeight, fmt.Sprintf("%d", clientState.GetLatestHeight())),
+		),
+	)
+
+	return nil
+}
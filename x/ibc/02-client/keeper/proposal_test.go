@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	lite "github.com/tendermint/tendermint/lite2"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+func (suite *KeeperTestSuite) TestClientUpdateProposal() {
+	const substituteHeight = testClientHeight + 5
+
+	var (
+		subject, substitute ibctmtypes.ClientState
+		content             *types.ClientUpdateProposal
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"frozen subject client allowed to be unfrozen", func() {
+				subject.FrozenHeight = testClientHeight
+				subject.AllowUpdateAfterMisbehaviour = true
+				substitute.AllowUpdateAfterMisbehaviour = true
+			}, true,
+		},
+		{
+			"frozen subject client not allowed to be unfrozen", func() {
+				subject.FrozenHeight = testClientHeight
+			}, false,
+		},
+		{
+			"expired subject client allowed to be unexpired", func() {
+				subject.LastHeader.Time = suite.now.Add(-trustingPeriod)
+				subject.AllowUpdateAfterExpiry = true
+				substitute.AllowUpdateAfterExpiry = true
+			}, true,
+		},
+		{
+			"expired subject client not allowed to be unexpired", func() {
+				subject.LastHeader.Time = suite.now.Add(-trustingPeriod)
+			}, false,
+		},
+		{
+			"active subject client", func() {
+				subject.AllowUpdateAfterExpiry = true
+				substitute.AllowUpdateAfterExpiry = true
+			}, false,
+		},
+		{
+			"substitute client with different parameters", func() {
+				subject.FrozenHeight = testClientHeight
+				subject.AllowUpdateAfterMisbehaviour = true
+				substitute.AllowUpdateAfterMisbehaviour = true
+				substitute.UnbondingPeriod = ubdPeriod * 2
+			}, false,
+		},
+		{
+			"substitute client with a different trusting period", func() {
+				subject.FrozenHeight = testClientHeight
+				subject.AllowUpdateAfterMisbehaviour = true
+				substitute.AllowUpdateAfterMisbehaviour = true
+				substitute.TrustingPeriod = trustingPeriod * 2
+			}, true,
+		},
+		{
+			"frozen substitute client", func() {
+				subject.FrozenHeight = testClientHeight
+				subject.AllowUpdateAfterMisbehaviour = true
+				substitute.AllowUpdateAfterMisbehaviour = true
+				substitute.FrozenHeight = substituteHeight
+			}, false,
+		},
+		{
+			"substitute client not ahead of the subject client", func() {
+				subject.FrozenHeight = testClientHeight
+				subject.AllowUpdateAfterMisbehaviour = true
+				substitute.AllowUpdateAfterMisbehaviour = true
+				subject.LastHeader = ibctmtypes.CreateTestHeader(testClientID, substituteHeight, suite.now, suite.valSet, []tmtypes.PrivValidator{suite.privVal})
+			}, false,
+		},
+		{
+			"subject client not found", func() {
+				content = types.NewClientUpdateProposal("title", "description", testClientID3, testClientID2)
+			}, false,
+		},
+		{
+			"substitute client not found", func() {
+				content = types.NewClientUpdateProposal("title", "description", testClientID, testClientID3)
+			}, false,
+		},
+		{
+			"substitute client of a different type", func() {
+				suite.keeper.SetClientState(suite.ctx, localhosttypes.NewClientState(testClientID3, testClientHeight))
+				content = types.NewClientUpdateProposal("title", "description", testClientID, testClientID3)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectHeader := ibctmtypes.CreateTestHeader(testClientID, testClientHeight, suite.now, suite.valSet, []tmtypes.PrivValidator{suite.privVal})
+			substituteHeader := ibctmtypes.CreateTestHeader(testClientID, substituteHeight, suite.now, suite.valSet, []tmtypes.PrivValidator{suite.privVal})
+
+			subject = ibctmtypes.NewClientState(testClientID, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, subjectHeader, commitmenttypes.GetSDKSpecs(), false, false)
+			substitute = ibctmtypes.NewClientState(testClientID2, lite.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, substituteHeader, commitmenttypes.GetSDKSpecs(), false, false)
+			content = types.NewClientUpdateProposal("title", "description", testClientID, testClientID2)
+
+			tc.malleate()
+
+			suite.keeper.SetClientState(suite.ctx, subject)
+			suite.keeper.SetClientState(suite.ctx, substitute)
+			suite.keeper.SetClientConsensusState(suite.ctx, testClientID2, substituteHeight, substituteHeader.ConsensusState())
+
+			err := suite.keeper.ClientUpdateProposal(suite.ctx, content)
+
+			clientState, found := suite.keeper.GetClientState(suite.ctx, testClientID)
+			suite.Require().True(found)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().False(clientState.IsFrozen())
+				suite.Require().Equal(testClientID, clientState.GetID())
+				suite.Require().Equal(uint64(substituteHeight), clientState.GetLatestHeight())
+
+				consState, found := suite.keeper.GetClientConsensusState(suite.ctx, testClientID, substituteHeight)
+				suite.Require().True(found)
+				suite.Require().Equal(substituteHeader.ConsensusState().GetRoot(), consState.GetRoot())
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(subject.GetLatestHeight(), clientState.GetLatestHeight())
+				suite.Require().Equal(subject.IsFrozen(), clientState.IsFrozen())
+			}
+		})
+	}
+}
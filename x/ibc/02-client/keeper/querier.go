@@ -15,7 +15,7 @@ import (
 func QuerierClients(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryAllClientsParams
 
-	if err := k.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+	if err := k.aminoCdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
@@ -28,7 +28,7 @@ func QuerierClients(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 		clients = clients[start:end]
 	}
 
-	res, err := codec.MarshalJSONIndent(k.cdc, clients)
+	res, err := codec.MarshalJSONIndent(k.aminoCdc, clients)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
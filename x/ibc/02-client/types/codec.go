@@ -2,6 +2,8 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
 )
 
@@ -16,10 +18,20 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.ConsensusState)(nil), nil)
 	cdc.RegisterInterface((*exported.Header)(nil), nil)
 	cdc.RegisterInterface((*exported.Misbehaviour)(nil), nil)
+	cdc.RegisterConcrete(&MsgUpgradeClient{}, "ibc/client/MsgUpgradeClient", nil)
+	cdc.RegisterConcrete(&ClientUpdateProposal{}, "cosmos-sdk/ClientUpdateProposal", nil)
 
 	SetSubModuleCodec(cdc)
 }
 
+// RegisterInterfaces registers the client proposal types into protobuf Any.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ClientUpdateProposal{},
+	)
+}
+
 func SetSubModuleCodec(cdc *codec.Codec) {
 	SubModuleCdc = cdc
 }
@@ -24,4 +24,6 @@ var (
 	ErrFailedPacketAckAbsenceVerification     = sdkerrors.Register(SubModuleName, 17, "packet acknowledgement absence verification failed")
 	ErrFailedNextSeqRecvVerification          = sdkerrors.Register(SubModuleName, 18, "next sequence receive verification failed")
 	ErrSelfConsensusStateNotFound             = sdkerrors.Register(SubModuleName, 19, "self consensus state not found")
+	ErrInvalidUpgradeClient                   = sdkerrors.Register(SubModuleName, 20, "invalid client upgrade")
+	ErrInvalidSubstitute                      = sdkerrors.Register(SubModuleName, 21, "invalid client state substitute")
 )
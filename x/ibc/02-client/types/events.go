@@ -15,9 +15,11 @@ const (
 
 // IBC client events vars
 var (
-	EventTypeCreateClient       = "create_client"
-	EventTypeUpdateClient       = "update_client"
-	EventTypeSubmitMisbehaviour = "client_misbehaviour"
+	EventTypeCreateClient         = "create_client"
+	EventTypeUpdateClient         = "update_client"
+	EventTypeUpgradeClient        = "upgrade_client"
+	EventTypeUpdateClientProposal = "update_client_proposal"
+	EventTypeSubmitMisbehaviour   = "client_misbehaviour"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+// Message types for the IBC client
+const (
+	TypeMsgUpgradeClient string = "upgrade_client"
+)
+
+var _ sdk.Msg = &MsgUpgradeClient{}
+
+// MsgUpgradeClient defines a message to upgrade an IBC client to the client
+// state committed by the counterparty chain in its upgrade plan.
+type MsgUpgradeClient struct {
+	ClientID      string               `json:"client_id" yaml:"client_id"`
+	ClientState   exported.ClientState `json:"client_state" yaml:"client_state"`
+	UpgradeHeight uint64               `json:"upgrade_height" yaml:"upgrade_height"`
+	ProofUpgrade  []byte               `json:"proof_upgrade" yaml:"proof_upgrade"`
+	Signer        sdk.AccAddress       `json:"address" yaml:"address"`
+}
+
+// this is a constant to satisfy the linter
+const TODO = "TODO"
+
+// dummy implementation of proto.Message
+func (msg MsgUpgradeClient) Reset()         {}
+func (msg MsgUpgradeClient) String() string { return TODO }
+func (msg MsgUpgradeClient) ProtoMessage()  {}
+
+// NewMsgUpgradeClient creates a new MsgUpgradeClient instance
+func NewMsgUpgradeClient(
+	clientID string, clientState exported.ClientState, upgradeHeight uint64,
+	proofUpgrade []byte, signer sdk.AccAddress,
+) *MsgUpgradeClient {
+	return &MsgUpgradeClient{
+		ClientID:      clientID,
+		ClientState:   clientState,
+		UpgradeHeight: upgradeHeight,
+		ProofUpgrade:  proofUpgrade,
+		Signer:        signer,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgUpgradeClient) Route() string {
+	return host.RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgUpgradeClient) Type() string {
+	return TypeMsgUpgradeClient
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpgradeClient) ValidateBasic() error {
+	if msg.ClientState == nil {
+		return sdkerrors.Wrap(ErrInvalidUpgradeClient, "upgraded client state cannot be nil")
+	}
+	if msg.UpgradeHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeClient, "upgrade height cannot be zero")
+	}
+	if len(msg.ProofUpgrade) == 0 {
+		return sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof of upgrade cannot be empty")
+	}
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return host.ClientIdentifierValidator(msg.ClientID)
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgUpgradeClient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(SubModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgUpgradeClient) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+)
+
+func TestMsgUpgradeClientValidateBasic(t *testing.T) {
+	signer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	clientState := localhosttypes.NewClientState("chainID", 10)
+	proof := []byte("proof")
+
+	cases := []struct {
+		msg     *types.MsgUpgradeClient
+		expPass bool
+		errMsg  string
+	}{
+		{types.NewMsgUpgradeClient(clientID, clientState, 10, proof, signer), true, "success msg should pass"},
+		{types.NewMsgUpgradeClient("(badClient)", clientState, 10, proof, signer), false, "invalid client id passed"},
+		{types.NewMsgUpgradeClient(clientID, nil, 10, proof, signer), false, "nil client state passed"},
+		{types.NewMsgUpgradeClient(clientID, clientState, 0, proof, signer), false, "zero upgrade height passed"},
+		{types.NewMsgUpgradeClient(clientID, clientState, 10, nil, signer), false, "empty proof passed"},
+		{types.NewMsgUpgradeClient(clientID, clientState, 10, proof, nil), false, "empty address passed"},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Msg %d failed: %v", i, tc.errMsg)
+		} else {
+			require.Error(t, err, "Invalid Msg %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
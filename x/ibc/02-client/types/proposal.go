@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+const (
+	// ProposalTypeClientUpdate defines the type for a ClientUpdateProposal
+	ProposalTypeClientUpdate = "ClientUpdate"
+)
+
+var _ govtypes.Content = &ClientUpdateProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClientUpdate)
+	govtypes.RegisterProposalTypeCodec(&ClientUpdateProposal{}, "cosmos-sdk/ClientUpdateProposal")
+}
+
+// NewClientUpdateProposal creates a new client update proposal.
+func NewClientUpdateProposal(title, description, clientID, substituteClientID string) *ClientUpdateProposal {
+	return &ClientUpdateProposal{
+		Title:              title,
+		Description:        description,
+		ClientID:           clientID,
+		SubstituteClientID: substituteClientID,
+	}
+}
+
+// GetTitle returns the title of a client update proposal.
+func (cup *ClientUpdateProposal) GetTitle() string { return cup.Title }
+
+// GetDescription returns the description of a client update proposal.
+func (cup *ClientUpdateProposal) GetDescription() string { return cup.Description }
+
+// ProposalRoute returns the routing key of a client update proposal.
+func (cup *ClientUpdateProposal) ProposalRoute() string { return host.RouterKey }
+
+// ProposalType returns the type of a client update proposal.
+func (cup *ClientUpdateProposal) ProposalType() string { return ProposalTypeClientUpdate }
+
+// ValidateBasic runs basic stateless validity checks
+func (cup *ClientUpdateProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(cup); err != nil {
+		return err
+	}
+
+	if err := host.ClientIdentifierValidator(cup.ClientID); err != nil {
+		return err
+	}
+
+	if err := host.ClientIdentifierValidator(cup.SubstituteClientID); err != nil {
+		return err
+	}
+
+	if cup.ClientID == cup.SubstituteClientID {
+		return sdkerrors.Wrap(ErrInvalidSubstitute, "subject and substitute client identifiers are equal")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (cup ClientUpdateProposal) String() string {
+	return fmt.Sprintf(`Client Update Proposal:
+  Title:                %s
+  Description:          %s
+  Client ID:            %s
+  Substitute Client ID: %s
+`, cup.Title, cup.Description, cup.ClientID, cup.SubstituteClientID)
+}
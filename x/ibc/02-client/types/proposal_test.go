@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+func TestClientUpdateProposalValidateBasic(t *testing.T) {
+	cases := []struct {
+		proposal *types.ClientUpdateProposal
+		expPass  bool
+		errMsg   string
+	}{
+		{types.NewClientUpdateProposal("title", "description", clientID, "substitute"), true, "valid proposal should pass"},
+		{types.NewClientUpdateProposal("", "description", clientID, "substitute"), false, "empty title passed"},
+		{types.NewClientUpdateProposal("title", "", clientID, "substitute"), false, "empty description passed"},
+		{types.NewClientUpdateProposal("title", "description", "(badClient)", "substitute"), false, "invalid subject client id passed"},
+		{types.NewClientUpdateProposal("title", "description", clientID, "(badClient)"), false, "invalid substitute client id passed"},
+		{types.NewClientUpdateProposal("title", "description", clientID, clientID), false, "equal subject and substitute client ids passed"},
+	}
+
+	for i, tc := range cases {
+		err := tc.proposal.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "Proposal %d failed: %s", i, tc.errMsg)
+		} else {
+			require.Error(t, err, "Invalid proposal %d passed: %s", i, tc.errMsg)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+/*
+Package solomachine implements a concrete `ConsensusState`, `Header`,
+`Misbehaviour` and `Evidence` types for the Solo Machine light client.
+
+A solo machine is a standalone process, such as a phone or a hardware wallet,
+that holds a (possibly multisig) key and signs the headers and the state it
+wants to prove to a counterparty. Every signature is bound to a sequence, which
+is incremented after each successful verification, and to a diversifier that
+prevents signatures for one client from being replayed on another.
+*/
+package solomachine
@@ -0,0 +1,80 @@
+package solomachine
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+// CheckMisbehaviourAndUpdateState determines whether or not the currently
+// registered public key signed over two different messages at the same
+// sequence. If this is true the client state is updated to a frozen status.
+func CheckMisbehaviourAndUpdateState(
+	clientState clientexported.ClientState,
+	consensusState clientexported.ConsensusState,
+	misbehaviour clientexported.Misbehaviour,
+) (clientexported.ClientState, error) {
+
+	// cast the interface to specific types before checking for misbehaviour
+	smClientState, ok := clientState.(types.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "client state type %T is not solo machine", clientState,
+		)
+	}
+
+	if smClientState.IsFrozen() {
+		return nil, sdkerrors.Wrapf(clienttypes.ErrClientFrozen, "client is already frozen")
+	}
+
+	smConsensusState, ok := consensusState.(types.ConsensusState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidConsensus, "consensus state type %T is not solo machine", consensusState,
+		)
+	}
+
+	evidence, ok := misbehaviour.(types.Evidence)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidEvidence, "evidence type %T is not solo machine", misbehaviour,
+		)
+	}
+
+	if err := checkMisbehaviour(smConsensusState, evidence); err != nil {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidEvidence, err.Error())
+	}
+
+	smClientState.FrozenSequence = evidence.Sequence
+	return smClientState, nil
+}
+
+// checkMisbehaviour checks if the currently registered public key has signed
+// over two different messages at the same sequence.
+func checkMisbehaviour(consensusState types.ConsensusState, evidence types.Evidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// ensure the evidence was not produced for a sequence the solo machine hasn't reached yet
+	if evidence.Sequence > consensusState.Sequence {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidSequence,
+			"evidence sequence is greater than the consensus state sequence (%d > %d)", evidence.Sequence, consensusState.Sequence,
+		)
+	}
+
+	for i, sd := range []types.SignatureAndData{evidence.SignatureOne, evidence.SignatureTwo} {
+		signBytes, err := types.EvidenceSignBytes(evidence.Sequence, consensusState.Diversifier, sd)
+		if err != nil {
+			return err
+		}
+
+		if err := types.VerifySignature(consensusState.PubKey, signBytes, sd.Signature); err != nil {
+			return sdkerrors.Wrapf(err, "failed to verify signature %d", i+1)
+		}
+	}
+
+	return nil
+}
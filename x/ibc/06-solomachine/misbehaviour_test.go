@@ -0,0 +1,184 @@
+package solomachine_test
+
+import (
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckMisbehaviourAndUpdateState() {
+	var (
+		clientState    clientexported.ClientState
+		consensusState clientexported.ConsensusState
+		evidence       clientexported.Misbehaviour
+	)
+
+	testCases := []struct {
+		name    string
+		setup   func()
+		expPass bool
+	}{
+		{
+			"valid misbehaviour evidence",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			true,
+		},
+		{
+			"valid misbehaviour evidence with a multisig public key",
+			func() {
+				suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.solomachine.ClientID, 4)
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			true,
+		},
+		{
+			"client is frozen",
+			func() {
+				cs := suite.solomachine.ClientState()
+				cs.FrozenSequence = 1
+				clientState = cs
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			false,
+		},
+		{
+			"wrong client state type",
+			func() {
+				clientState = ibctmtypes.ClientState{}
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			false,
+		},
+		{
+			"wrong consensus state type",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = ibctmtypes.ConsensusState{}
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			false,
+		},
+		{
+			"invalid evidence type",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = ibctmtypes.Evidence{}
+			},
+			false,
+		},
+		{
+			"invalid evidence",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				ev := suite.solomachine.CreateMisbehaviour()
+				ev.SignatureTwo = ev.SignatureOne
+				evidence = ev
+			},
+			false,
+		},
+		{
+			"evidence sequence is greater than the consensus state sequence",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				suite.solomachine.Sequence++
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			false,
+		},
+		{
+			"invalid first signature",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				ev := suite.solomachine.CreateMisbehaviour()
+				ev.SignatureOne.Data = []byte("DATA THREE")
+				evidence = ev
+			},
+			false,
+		},
+		{
+			"invalid second signature",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				ev := suite.solomachine.CreateMisbehaviour()
+				ev.SignatureTwo.Timestamp++
+				evidence = ev
+			},
+			false,
+		},
+		{
+			"signatures from a different diversifier",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				suite.solomachine.Diversifier = "otherdiversifier"
+				evidence = suite.solomachine.CreateMisbehaviour()
+			},
+			false,
+		},
+		{
+			"signatures from a different key",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				consensusState = suite.solomachine.ConsensusState()
+				evidence = ibctesting.NewSolomachine(suite.T(), suite.solomachine.ClientID, 1).CreateMisbehaviour()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup test
+			tc.setup()
+
+			clientState, err := solomachine.CheckMisbehaviourAndUpdateState(clientState, consensusState, evidence)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(clientState.IsFrozen(), "client not frozen")
+				suite.Require().Equal(uint64(evidence.GetHeight()), clientState.(types.ClientState).FrozenSequence)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(clientState)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestSubmitMisbehaviour() {
+	solomachine, err := suite.coordinator.CreateSolomachineClient(suite.chainID, 1)
+	suite.Require().NoError(err)
+
+	chain := suite.coordinator.GetChain(suite.chainID)
+	clientKeeper := chain.App.IBCKeeper.ClientKeeper
+
+	err = clientKeeper.CheckMisbehaviourAndUpdateState(chain.GetContext(), solomachine.CreateMisbehaviour())
+	suite.Require().NoError(err)
+
+	clientState, found := clientKeeper.GetClientState(chain.GetContext(), solomachine.ClientID)
+	suite.Require().True(found)
+	suite.Require().True(clientState.IsFrozen())
+
+	// a frozen client cannot be updated
+	_, err = clientKeeper.UpdateClient(chain.GetContext(), solomachine.ClientID, solomachine.CreateHeader())
+	suite.Require().Error(err)
+}
@@ -0,0 +1,28 @@
+package solomachine_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+type SoloMachineTestSuite struct {
+	suite.Suite
+
+	solomachine *ibctesting.Solomachine
+	coordinator *ibctesting.Coordinator
+	chainID     string
+}
+
+func (suite *SoloMachineTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chainID = ibctesting.GetChainID(0)
+
+	suite.solomachine = ibctesting.NewSolomachine(suite.T(), "testingsolomachine", 1)
+}
+
+func TestSoloMachineTestSuite(t *testing.T) {
+	suite.Run(t, new(SoloMachineTestSuite))
+}
@@ -0,0 +1,434 @@
+package types
+
+import (
+	ics23 "github.com/confio/ics23/go"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	connectionexported "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channelexported "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/exported"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+)
+
+var _ clientexported.ClientState = ClientState{}
+
+// ClientState of a solo machine client tracks its latest consensus state and a
+// possible frozen sequence.
+type ClientState struct {
+	// Client ID
+	ID string `json:"id" yaml:"id"`
+
+	// Sequence at which the client was frozen due to a misbehaviour
+	FrozenSequence uint64 `json:"frozen_sequence" yaml:"frozen_sequence"`
+
+	// Latest consensus state of the solo machine
+	ConsensusState ConsensusState `json:"consensus_state" yaml:"consensus_state"`
+}
+
+// InitializeFromMsg creates a solo machine client state from a CreateClientMsg
+func InitializeFromMsg(msg MsgCreateClient) (ClientState, error) {
+	clientState := NewClientState(msg.ClientID, msg.ConsensusState)
+	if err := clientState.Validate(); err != nil {
+		return ClientState{}, err
+	}
+
+	return clientState, nil
+}
+
+// NewClientState creates a new ClientState instance.
+func NewClientState(id string, consensusState ConsensusState) ClientState {
+	return ClientState{
+		ID:             id,
+		FrozenSequence: 0,
+		ConsensusState: consensusState,
+	}
+}
+
+// GetID returns the solo machine client state identifier.
+func (cs ClientState) GetID() string {
+	return cs.ID
+}
+
+// GetChainID returns an empty string since solo machines are not chains.
+func (cs ClientState) GetChainID() string {
+	return ""
+}
+
+// ClientType is SoloMachine.
+func (cs ClientState) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetLatestHeight returns the latest sequence number.
+func (cs ClientState) GetLatestHeight() uint64 {
+	return cs.ConsensusState.Sequence
+}
+
+// IsFrozen returns true if the client is frozen.
+func (cs ClientState) IsFrozen() bool {
+	return cs.FrozenSequence != 0
+}
+
+// Validate performs basic validation of the client state fields.
+func (cs ClientState) Validate() error {
+	if err := host.ClientIdentifierValidator(cs.ID); err != nil {
+		return err
+	}
+	return cs.ConsensusState.ValidateBasic()
+}
+
+// GetProofSpecs returns nil since solo machines do not use merkle proofs.
+func (cs ClientState) GetProofSpecs() []*ics23.ProofSpec {
+	return nil
+}
+
+// VerifyClientConsensusState verifies a proof of the consensus state of the
+// running chain stored on the solo machine.
+func (cs ClientState) VerifyClientConsensusState(
+	store sdk.KVStore,
+	cdc codec.Marshaler,
+	aminoCdc *codec.Codec,
+	_ commitmentexported.Root,
+	height uint64,
+	counterpartyClientIdentifier string,
+	consensusHeight uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	consensusState clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	clientPrefixedPath := "clients/" + counterpartyClientIdentifier + "/" + host.ConsensusStatePath(consensusHeight)
+	path, err := commitmenttypes.ApplyPrefix(prefix, clientPrefixedPath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := aminoCdc.MarshalBinaryBare(consensusState)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedClientConsensusStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyConnectionState verifies a proof of the connection state of the
+// specified connection end stored on the solo machine.
+func (cs ClientState) VerifyConnectionState(
+	store sdk.KVStore,
+	cdc codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	connectionID string,
+	connectionEnd connectionexported.ConnectionI,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ConnectionPath(connectionID))
+	if err != nil {
+		return err
+	}
+
+	connection, ok := connectionEnd.(connectiontypes.ConnectionEnd)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid connection type %T", connectionEnd)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&connection)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedConnectionStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyChannelState verifies a proof of the channel state of the specified
+// channel end, under the specified port, stored on the solo machine.
+func (cs ClientState) VerifyChannelState(
+	store sdk.KVStore,
+	cdc codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	channel channelexported.ChannelI,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.ChannelPath(portID, channelID))
+	if err != nil {
+		return err
+	}
+
+	channelEnd, ok := channel.(channeltypes.Channel)
+	if !ok {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "invalid channel type %T", channel)
+	}
+
+	bz, err := cdc.MarshalBinaryBare(&channelEnd)
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedChannelStateVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
+// the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketCommitment(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	commitmentBytes []byte,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketCommitmentPath(portID, channelID, packetSequence))
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, commitmentBytes); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketCommitmentVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgement verifies a proof of an incoming packet
+// acknowledgement at the specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketAcknowledgement(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	acknowledgement []byte,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(portID, channelID, packetSequence))
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, channeltypes.CommitAcknowledgement(acknowledgement)); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketAckVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyPacketAcknowledgementAbsence verifies a proof of the absence of an
+// incoming packet acknowledgement at the specified port, specified channel, and
+// specified sequence.
+func (cs ClientState) VerifyPacketAcknowledgementAbsence(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	packetSequence uint64,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.PacketAcknowledgementPath(portID, channelID, packetSequence))
+	if err != nil {
+		return err
+	}
+
+	if err := verifySignedState(store, cs, signature, path, nil); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedPacketAckAbsenceVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyNextSequenceRecv verifies a proof of the next sequence number to be
+// received of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceRecv(
+	store sdk.KVStore,
+	_ codec.Marshaler,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+	_ clientexported.ConsensusState,
+) error {
+	signature, err := sanitizeVerificationArgs(cs, height, prefix, proof)
+	if err != nil {
+		return err
+	}
+
+	path, err := commitmenttypes.ApplyPrefix(prefix, host.NextSequenceRecvPath(portID, channelID))
+	if err != nil {
+		return err
+	}
+
+	bz := sdk.Uint64ToBigEndian(nextSequenceRecv)
+
+	if err := verifySignedState(store, cs, signature, path, bz); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrFailedNextSeqRecvVerification, err.Error())
+	}
+
+	return nil
+}
+
+// VerifyUpgrade returns an error since a solo machine has no upgrade plan to
+// commit to. The public key and diversifier of the client are rotated through
+// header updates instead.
+func (cs ClientState) VerifyUpgrade(
+	_ sdk.KVStore,
+	_ codec.Marshaler,
+	_ *codec.Codec,
+	_ clientexported.ClientState,
+	_ uint64,
+	_ []byte,
+	_ clientexported.ConsensusState,
+) (clientexported.ClientState, clientexported.ConsensusState, error) {
+	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade solo machine client")
+}
+
+// CheckSubstituteAndUpdateState returns an error since a solo machine client
+// cannot expire and its public key is rotated through header updates instead.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	_ sdk.Context, _ clientexported.ClientState,
+) (clientexported.ClientState, error) {
+	return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "cannot substitute solo machine client")
+}
+
+// sanitizeVerificationArgs perfoms the basic checks on the arguments that are
+// shared between the verification functions and returns the unmarshalled
+// timestamped signature and an error if one occurred.
+func sanitizeVerificationArgs(
+	cs ClientState,
+	height uint64,
+	prefix commitmentexported.Prefix,
+	proof []byte,
+) (signature TimestampedSignature, err error) {
+	if cs.GetLatestHeight() < height {
+		return TimestampedSignature{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"client state (%s) sequence < proof height (%d < %d)", cs.ID, cs.GetLatestHeight(), height,
+		)
+	}
+
+	if cs.IsFrozen() {
+		return TimestampedSignature{}, clienttypes.ErrClientFrozen
+	}
+
+	if prefix == nil {
+		return TimestampedSignature{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidPrefix, "prefix cannot be empty")
+	}
+
+	_, ok := prefix.(*commitmenttypes.MerklePrefix)
+	if !ok {
+		return TimestampedSignature{}, sdkerrors.Wrapf(commitmenttypes.ErrInvalidPrefix, "invalid prefix type %T, expected *MerklePrefix", prefix)
+	}
+
+	if len(proof) == 0 {
+		return TimestampedSignature{}, sdkerrors.Wrap(ErrInvalidProof, "proof cannot be empty")
+	}
+
+	if err = SubModuleCdc.UnmarshalBinaryBare(proof, &signature); err != nil {
+		return TimestampedSignature{}, sdkerrors.Wrap(ErrInvalidProof, "failed to unmarshal proof into timestamped signature")
+	}
+
+	if signature.Timestamp < cs.ConsensusState.Timestamp {
+		return TimestampedSignature{}, sdkerrors.Wrapf(
+			ErrInvalidProof,
+			"proof timestamp cannot be less than the consensus state timestamp (%d < %d)", signature.Timestamp, cs.ConsensusState.Timestamp,
+		)
+	}
+
+	return signature, nil
+}
+
+// verifySignedState verifies the solo machine signature over the value stored
+// under the given path at the current sequence. On success, the sequence is
+// incremented and the updated client state and consensus state are set in the
+// client store so that a signature can't be replayed.
+func verifySignedState(
+	store sdk.KVStore,
+	cs ClientState,
+	signature TimestampedSignature,
+	path commitmenttypes.MerklePath,
+	value []byte,
+) error {
+	consensusState := cs.ConsensusState
+
+	signBytes, err := StateSignBytes(
+		consensusState.Sequence, signature.Timestamp, consensusState.Diversifier, path, value,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := VerifySignature(consensusState.PubKey, signBytes, signature.Signature); err != nil {
+		return err
+	}
+
+	consensusState.Sequence++
+	consensusState.Timestamp = signature.Timestamp
+	cs.ConsensusState = consensusState
+
+	store.Set(host.KeyClientState(), SubModuleCdc.MustMarshalBinaryBare(cs))
+	store.Set(host.KeyConsensusState(consensusState.Sequence), SubModuleCdc.MustMarshalBinaryBare(consensusState))
+	return nil
+}
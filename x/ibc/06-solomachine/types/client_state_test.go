@@ -0,0 +1,323 @@
+package types_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
+	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+const (
+	testConnectionID = "connectionid"
+	testPortID       = "testportid"
+	testChannelID    = "testchannelid"
+	testSequence     = 5
+)
+
+var prefix = commitmenttypes.NewMerklePrefix([]byte("ibc"))
+
+func (suite *SoloMachineTestSuite) TestClientStateValidateBasic() {
+	testCases := []struct {
+		name        string
+		clientState types.ClientState
+		expPass     bool
+	}{
+		{
+			"valid client state",
+			suite.solomachine.ClientState(),
+			true,
+		},
+		{
+			"invalid client id",
+			types.NewClientState("(testClientID)", suite.solomachine.ConsensusState()),
+			false,
+		},
+		{
+			"invalid consensus state with zero sequence",
+			types.NewClientState(suite.solomachine.ClientID, types.ConsensusState{
+				Sequence:    0,
+				PubKey:      suite.solomachine.PublicKey,
+				Diversifier: suite.solomachine.Diversifier,
+				Timestamp:   suite.solomachine.Time,
+			}),
+			false,
+		},
+		{
+			"invalid consensus state with nil pubkey",
+			types.NewClientState(suite.solomachine.ClientID, types.ConsensusState{
+				Sequence:    suite.solomachine.Sequence,
+				PubKey:      nil,
+				Diversifier: suite.solomachine.Diversifier,
+				Timestamp:   suite.solomachine.Time,
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.clientState.Validate()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestClientState() {
+	clientState := suite.solomachine.ClientState()
+
+	suite.Require().Equal(suite.solomachine.ClientID, clientState.GetID())
+	suite.Require().Equal(clientexported.SoloMachine, clientState.ClientType())
+	suite.Require().Equal(suite.solomachine.Sequence, clientState.GetLatestHeight())
+	suite.Require().Empty(clientState.GetChainID())
+	suite.Require().Nil(clientState.GetProofSpecs())
+	suite.Require().False(clientState.IsFrozen())
+
+	clientState.FrozenSequence = 1
+	suite.Require().True(clientState.IsFrozen())
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyConnectionState() {
+	counterparty := connectiontypes.NewCounterparty("clientB", testConnectionID, prefix)
+	conn := connectiontypes.NewConnectionEnd(connectiontypes.OPEN, testConnectionID, "clientA", counterparty, []string{"1.0.0"})
+
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.ConnectionPath(testConnectionID))
+	suite.Require().NoError(err)
+
+	value, err := suite.cdc.MarshalBinaryBare(&conn)
+	suite.Require().NoError(err)
+
+	var (
+		clientState types.ClientState
+		proof       []byte
+		height      uint64
+		proofPrefix commitmentexported.Prefix
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful verification",
+			func() {},
+			true,
+		},
+		{
+			"successful verification with a multisig public key",
+			func() {
+				suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.solomachine.ClientID, 4)
+				clientState = suite.solomachine.ClientState()
+				height = suite.solomachine.Sequence
+				proof = suite.solomachine.GenerateProof(path, value)
+			},
+			true,
+		},
+		{
+			"client is frozen",
+			func() {
+				clientState.FrozenSequence = 1
+			},
+			false,
+		},
+		{
+			"proof height is greater than the client sequence",
+			func() {
+				height = clientState.GetLatestHeight() + 1
+			},
+			false,
+		},
+		{
+			"prefix is nil",
+			func() {
+				proofPrefix = nil
+			},
+			false,
+		},
+		{
+			"proof is empty",
+			func() {
+				proof = []byte{}
+			},
+			false,
+		},
+		{
+			"proof cannot be decoded",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			false,
+		},
+		{
+			"proof signed over a different value",
+			func() {
+				suite.solomachine.Sequence--
+				proof = suite.solomachine.GenerateProof(path, []byte("invalid value"))
+			},
+			false,
+		},
+		{
+			"proof signed with a different key",
+			func() {
+				solomachine := ibctesting.NewSolomachine(suite.T(), suite.solomachine.ClientID, 1)
+				proof = solomachine.GenerateProof(path, value)
+			},
+			false,
+		},
+		{
+			"proof timestamp is less than the consensus state timestamp",
+			func() {
+				clientState.ConsensusState.Timestamp = suite.solomachine.Time + 1
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			clientState = suite.solomachine.ClientState()
+			height = suite.solomachine.Sequence
+			proofPrefix = &prefix
+			proof = suite.solomachine.GenerateProof(path, value)
+
+			tc.malleate()
+
+			err := clientState.VerifyConnectionState(
+				suite.store, suite.cdc, height, proofPrefix, proof, testConnectionID, conn, nil,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				// the sequence must be incremented to prevent the proof from being replayed
+				updatedClientState, found := suite.chain.App.IBCKeeper.ClientKeeper.GetClientState(suite.chain.GetContext(), suite.solomachine.ClientID)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.solomachine.Sequence, updatedClientState.GetLatestHeight())
+
+				consensusState, found := suite.chain.App.IBCKeeper.ClientKeeper.GetClientConsensusState(suite.chain.GetContext(), suite.solomachine.ClientID, suite.solomachine.Sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(suite.solomachine.ConsensusState(), consensusState)
+
+				err = updatedClientState.VerifyConnectionState(
+					suite.store, suite.cdc, height, proofPrefix, proof, testConnectionID, conn, nil,
+				)
+				suite.Require().Error(err, "proof must not be replayable")
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyChannelState() {
+	counterparty := channeltypes.NewCounterparty(testPortID, testChannelID)
+	ch := channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, []string{testConnectionID}, "1.0.0")
+
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.ChannelPath(testPortID, testChannelID))
+	suite.Require().NoError(err)
+
+	value, err := suite.cdc.MarshalBinaryBare(&ch)
+	suite.Require().NoError(err)
+
+	clientState := suite.solomachine.ClientState()
+	proof := suite.solomachine.GenerateProof(path, value)
+
+	err = clientState.VerifyChannelState(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, ch, nil,
+	)
+	suite.Require().NoError(err)
+
+	// the proof cannot be used for a different channel
+	err = clientState.VerifyChannelState(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, "otherchannel", ch, nil,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketCommitment() {
+	commitmentBytes := []byte("COMMITMENT BYTES")
+
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.PacketCommitmentPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	clientState := suite.solomachine.ClientState()
+	proof := suite.solomachine.GenerateProof(path, commitmentBytes)
+
+	err = clientState.VerifyPacketCommitment(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence, commitmentBytes, nil,
+	)
+	suite.Require().NoError(err)
+
+	err = clientState.VerifyPacketCommitment(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence+1, commitmentBytes, nil,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgement() {
+	ack := []byte("ACK")
+
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.PacketAcknowledgementPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	clientState := suite.solomachine.ClientState()
+	proof := suite.solomachine.GenerateProof(path, channeltypes.CommitAcknowledgement(ack))
+
+	err = clientState.VerifyPacketAcknowledgement(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence, ack, nil,
+	)
+	suite.Require().NoError(err)
+
+	// a proof of the acknowledgement is not a proof of its absence
+	err = clientState.VerifyPacketAcknowledgementAbsence(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence, nil,
+	)
+	suite.Require().Error(err)
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyPacketAcknowledgementAbsence() {
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.PacketAcknowledgementPath(testPortID, testChannelID, testSequence))
+	suite.Require().NoError(err)
+
+	clientState := suite.solomachine.ClientState()
+	proof := suite.solomachine.GenerateProof(path, nil)
+
+	err = clientState.VerifyPacketAcknowledgementAbsence(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence, nil,
+	)
+	suite.Require().NoError(err)
+}
+
+func (suite *SoloMachineTestSuite) TestVerifyNextSequenceRecv() {
+	path, err := commitmenttypes.ApplyPrefix(&prefix, host.NextSequenceRecvPath(testPortID, testChannelID))
+	suite.Require().NoError(err)
+
+	clientState := suite.solomachine.ClientState()
+	proof := suite.solomachine.GenerateProof(path, sdk.Uint64ToBigEndian(testSequence))
+
+	err = clientState.VerifyNextSequenceRecv(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence+1, nil,
+	)
+	suite.Require().Error(err)
+
+	err = clientState.VerifyNextSequenceRecv(
+		suite.store, suite.cdc, clientState.GetLatestHeight(), &prefix, proof, testPortID, testChannelID, testSequence, nil,
+	)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	commitmentexported "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
+)
+
+var _ clientexported.ConsensusState = ConsensusState{}
+
+// ConsensusState defines a solo machine consensus state. The sequence of the
+// solo machine is incremented every time a new signature is verified, and the
+// public key (possibly a multisig threshold key) can be rotated through a header.
+type ConsensusState struct {
+	// Sequence of the next signature expected from the solo machine
+	Sequence uint64 `json:"sequence" yaml:"sequence"`
+	// PubKey used to verify the solo machine signatures
+	PubKey crypto.PubKey `json:"pubkey" yaml:"pubkey"`
+	// Diversifier allows the same public key to be re-used across different
+	// solo machine clients (potentially on different chains) without being
+	// considered misbehaviour.
+	Diversifier string `json:"diversifier" yaml:"diversifier"`
+	// Timestamp of the latest signature verified, in nanoseconds
+	Timestamp uint64 `json:"timestamp" yaml:"timestamp"`
+}
+
+// NewConsensusState creates a new ConsensusState instance.
+func NewConsensusState(sequence uint64, pubKey crypto.PubKey, diversifier string, timestamp uint64) ConsensusState {
+	return ConsensusState{
+		Sequence:    sequence,
+		PubKey:      pubKey,
+		Diversifier: diversifier,
+		Timestamp:   timestamp,
+	}
+}
+
+// ClientType returns SoloMachine
+func (ConsensusState) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetHeight returns the sequence of the consensus state.
+//
+// NOTE: the sequence is used as the height of the solo machine client.
+func (cs ConsensusState) GetHeight() uint64 {
+	return cs.Sequence
+}
+
+// GetRoot returns nil since solo machines do not have roots.
+func (cs ConsensusState) GetRoot() commitmentexported.Root {
+	return nil
+}
+
+// GetTimestamp returns the timestamp of the latest signature verified.
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return cs.Timestamp
+}
+
+// ValidateBasic defines a basic validation for the solo machine consensus state.
+func (cs ConsensusState) ValidateBasic() error {
+	if cs.Sequence == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "sequence cannot be 0")
+	}
+	if cs.Timestamp == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "timestamp cannot be 0")
+	}
+	if cs.Diversifier != "" && strings.TrimSpace(cs.Diversifier) == "" {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "diversifier cannot contain only spaces")
+	}
+	if cs.PubKey == nil || len(cs.PubKey.Bytes()) == 0 {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "public key cannot be empty")
+	}
+	return nil
+}
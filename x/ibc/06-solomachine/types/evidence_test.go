@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+func (suite *SoloMachineTestSuite) TestEvidence() {
+	evidence := suite.solomachine.CreateMisbehaviour()
+
+	suite.Require().Equal(clientexported.SoloMachine, evidence.ClientType())
+	suite.Require().Equal(suite.solomachine.ClientID, evidence.GetClientID())
+	suite.Require().Equal(clienttypes.SubModuleName, evidence.Route())
+	suite.Require().Equal("client_misbehaviour", evidence.Type())
+	suite.Require().Equal(int64(suite.solomachine.Sequence), evidence.GetHeight())
+	suite.Require().NotEmpty(evidence.String())
+	suite.Require().NotEmpty(evidence.Hash())
+}
+
+func (suite *SoloMachineTestSuite) TestEvidenceValidateBasic() {
+	testCases := []struct {
+		name             string
+		malleateEvidence func(evidence *types.Evidence)
+		expPass          bool
+	}{
+		{
+			"valid evidence",
+			func(*types.Evidence) {},
+			true,
+		},
+		{
+			"invalid client ID",
+			func(evidence *types.Evidence) {
+				evidence.ClientID = "(badclientid)"
+			},
+			false,
+		},
+		{
+			"sequence is zero",
+			func(evidence *types.Evidence) {
+				evidence.Sequence = 0
+			},
+			false,
+		},
+		{
+			"signature one sig is empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureOne.Signature = []byte{}
+			},
+			false,
+		},
+		{
+			"signature two sig is empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Signature = []byte{}
+			},
+			false,
+		},
+		{
+			"signature one data is empty",
+			func(evidence *types.Evidence) {
+				evidence.SignatureOne.Data = nil
+			},
+			false,
+		},
+		{
+			"signature two timestamp is zero",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Timestamp = 0
+			},
+			false,
+		},
+		{
+			"signatures are identical",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Signature = evidence.SignatureOne.Signature
+			},
+			false,
+		},
+		{
+			"data and timestamps signed are identical",
+			func(evidence *types.Evidence) {
+				evidence.SignatureTwo.Data = evidence.SignatureOne.Data
+				evidence.SignatureTwo.Timestamp = evidence.SignatureOne.Timestamp
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			evidence := suite.solomachine.CreateMisbehaviour()
+			tc.malleateEvidence(&evidence)
+
+			err := evidence.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+)
+
+var _ clientexported.Header = Header{}
+
+// Header defines a solo machine consensus header. It is signed by the current
+// public key of the solo machine and rotates its public key and diversifier.
+type Header struct {
+	// Sequence to update the solo machine public key at
+	Sequence       uint64        `json:"sequence" yaml:"sequence"`
+	Timestamp      uint64        `json:"timestamp" yaml:"timestamp"`
+	Signature      []byte        `json:"signature" yaml:"signature"`
+	NewPubKey      crypto.PubKey `json:"new_pubkey" yaml:"new_pubkey"`
+	NewDiversifier string        `json:"new_diversifier" yaml:"new_diversifier"`
+}
+
+// ClientType defines that the Header is a Solo Machine.
+func (Header) ClientType() clientexported.ClientType {
+	return clientexported.SoloMachine
+}
+
+// GetHeight returns the current sequence number as the height.
+func (h Header) GetHeight() uint64 {
+	return h.Sequence
+}
+
+// GetPubKey returns the new public key of the solo machine.
+func (h Header) GetPubKey() crypto.PubKey {
+	return h.NewPubKey
+}
+
+// ValidateBasic ensures that the sequence, timestamp, signature and public key
+// have all been initialized.
+func (h Header) ValidateBasic() error {
+	if h.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "sequence number cannot be zero")
+	}
+	if h.Timestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "timestamp cannot be zero")
+	}
+	if h.NewDiversifier != "" && strings.TrimSpace(h.NewDiversifier) == "" {
+		return sdkerrors.Wrap(ErrInvalidHeader, "diversifier cannot contain only spaces")
+	}
+	if len(h.Signature) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "signature cannot be empty")
+	}
+	if h.NewPubKey == nil || len(h.NewPubKey.Bytes()) == 0 {
+		return sdkerrors.Wrap(ErrInvalidHeader, "new public key cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+// SignBytes defines the bytes signed by the solo machine for headers and state
+// proofs. The sequence and the diversifier bind a signature to a single
+// verification on a single client.
+type SignBytes struct {
+	Sequence    uint64 `json:"sequence" yaml:"sequence"`
+	Timestamp   uint64 `json:"timestamp" yaml:"timestamp"`
+	Diversifier string `json:"diversifier" yaml:"diversifier"`
+	Data        []byte `json:"data" yaml:"data"`
+}
+
+// HeaderData defines the data signed by the solo machine in order to rotate its
+// public key and diversifier.
+type HeaderData struct {
+	NewPubKey      crypto.PubKey `json:"new_pubkey" yaml:"new_pubkey"`
+	NewDiversifier string        `json:"new_diversifier" yaml:"new_diversifier"`
+}
+
+// StateData defines the data signed by the solo machine in order to prove the
+// value stored under a path. An empty value proves the absence of the path.
+type StateData struct {
+	Path  []byte `json:"path" yaml:"path"`
+	Value []byte `json:"value" yaml:"value"`
+}
+
+// TimestampedSignature defines the proof format expected by the solo machine
+// state verification functions. It contains the signature over the state sign
+// bytes and the timestamp used to construct them.
+type TimestampedSignature struct {
+	Signature []byte `json:"signature" yaml:"signature"`
+	Timestamp uint64 `json:"timestamp" yaml:"timestamp"`
+}
+
+// NewTimestampedSignature creates a new TimestampedSignature instance.
+func NewTimestampedSignature(signature []byte, timestamp uint64) TimestampedSignature {
+	return TimestampedSignature{
+		Signature: signature,
+		Timestamp: timestamp,
+	}
+}
+
+// HeaderSignBytes returns the sign bytes of the given header for a solo
+// machine using the provided diversifier.
+func HeaderSignBytes(header Header, diversifier string) ([]byte, error) {
+	data, err := SubModuleCdc.MarshalBinaryBare(HeaderData{
+		NewPubKey:      header.NewPubKey,
+		NewDiversifier: header.NewDiversifier,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return SubModuleCdc.MarshalBinaryBare(SignBytes{
+		Sequence:    header.Sequence,
+		Timestamp:   header.Timestamp,
+		Diversifier: diversifier,
+		Data:        data,
+	})
+}
+
+// StateDataBytes returns the data a solo machine signs in order to prove the value
+// stored under the given path.
+func StateDataBytes(path commitmenttypes.MerklePath, value []byte) ([]byte, error) {
+	return SubModuleCdc.MarshalBinaryBare(StateData{
+		Path:  []byte(path.String()),
+		Value: value,
+	})
+}
+
+// StateSignBytes returns the sign bytes of a state proof for the value stored
+// under the given path at the provided sequence.
+func StateSignBytes(
+	sequence, timestamp uint64, diversifier string,
+	path commitmenttypes.MerklePath, value []byte,
+) ([]byte, error) {
+	data, err := StateDataBytes(path, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return SubModuleCdc.MarshalBinaryBare(SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		Data:        data,
+	})
+}
+
+// VerifySignature verifies the signature against the provided sign bytes using
+// the given public key. Multisig threshold public keys expect an amino encoded
+// multisignature.
+func VerifySignature(pubKey crypto.PubKey, signBytes, signature []byte) error {
+	if pubKey == nil {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "public key cannot be empty")
+	}
+	if len(signature) == 0 {
+		return sdkerrors.Wrap(ErrSignatureVerificationFailed, "signature cannot be empty")
+	}
+	if !pubKey.VerifyBytes(signBytes, signature) {
+		return ErrSignatureVerificationFailed
+	}
+	return nil
+}
+
+// EvidenceSignBytes returns the sign bytes of a signature submitted as part of
+// solo machine evidence at the given sequence.
+func EvidenceSignBytes(sequence uint64, diversifier string, sd SignatureAndData) ([]byte, error) {
+	return SubModuleCdc.MarshalBinaryBare(SignBytes{
+		Sequence:    sequence,
+		Timestamp:   sd.Timestamp,
+		Diversifier: diversifier,
+		Data:        sd.Data,
+	})
+}
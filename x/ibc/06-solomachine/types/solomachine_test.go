@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+type SoloMachineTestSuite struct {
+	suite.Suite
+
+	solomachine *ibctesting.Solomachine
+	coordinator *ibctesting.Coordinator
+	chain       *ibctesting.TestChain
+
+	aminoCdc *codec.Codec
+	cdc      codec.Marshaler
+	store    sdk.KVStore
+}
+
+func (suite *SoloMachineTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1)
+	suite.chain = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+
+	suite.aminoCdc = suite.chain.App.Codec()
+	suite.cdc = suite.chain.App.AppCodec()
+
+	suite.solomachine = ibctesting.NewSolomachine(suite.T(), "testingsolomachine", 1)
+	suite.store = suite.chain.App.IBCKeeper.ClientKeeper.ClientStore(suite.chain.GetContext(), suite.solomachine.ClientID)
+}
+
+func TestSoloMachineTestSuite(t *testing.T) {
+	suite.Run(t, new(SoloMachineTestSuite))
+}
@@ -0,0 +1,89 @@
+package solomachine
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+)
+
+// CheckValidityAndUpdateState checks if the provided header is valid and updates
+// the consensus state if appropriate. It returns an error if:
+// - the client or header provided are not parseable to solo machine types
+// - the header sequence does not match the current sequence
+// - the header timestamp is less than the consensus state timestamp
+// - the currently registered public key did not provide the update signature
+func CheckValidityAndUpdateState(
+	clientState clientexported.ClientState, header clientexported.Header,
+) (clientexported.ClientState, clientexported.ConsensusState, error) {
+	smClientState, ok := clientState.(types.ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "client state type %T is not solo machine", clientState,
+		)
+	}
+
+	smHeader, ok := header.(types.Header)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader, "header type %T is not solo machine", header,
+		)
+	}
+
+	if err := checkValidity(smClientState, smHeader); err != nil {
+		return nil, nil, err
+	}
+
+	smClientState, consensusState := update(smClientState, smHeader)
+	return smClientState, consensusState, nil
+}
+
+// checkValidity checks if the Solo Machine update signature is valid.
+func checkValidity(clientState types.ClientState, header types.Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// assert update sequence is current sequence
+	if header.Sequence != clientState.ConsensusState.Sequence {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"sequence provided in the header does not match the client state sequence (%d != %d)", header.Sequence, clientState.ConsensusState.Sequence,
+		)
+	}
+
+	// assert update timestamp is not less than current consensus state timestamp
+	if header.Timestamp < clientState.ConsensusState.Timestamp {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrInvalidHeader,
+			"header timestamp is less than the consensus state timestamp (%d < %d)", header.Timestamp, clientState.ConsensusState.Timestamp,
+		)
+	}
+
+	// assert currently registered public key signed over the new public key with correct sequence
+	signBytes, err := types.HeaderSignBytes(header, clientState.ConsensusState.Diversifier)
+	if err != nil {
+		return err
+	}
+
+	if err := types.VerifySignature(clientState.ConsensusState.PubKey, signBytes, header.Signature); err != nil {
+		return sdkerrors.Wrap(clienttypes.ErrInvalidHeader, err.Error())
+	}
+
+	return nil
+}
+
+// update the consensus state to the new public key, diversifier and an
+// incremented sequence
+func update(clientState types.ClientState, header types.Header) (types.ClientState, types.ConsensusState) {
+	consensusState := types.ConsensusState{
+		// increment sequence number
+		Sequence:    clientState.ConsensusState.Sequence + 1,
+		PubKey:      header.NewPubKey,
+		Diversifier: header.NewDiversifier,
+		Timestamp:   header.Timestamp,
+	}
+
+	clientState.ConsensusState = consensusState
+	return clientState, consensusState
+}
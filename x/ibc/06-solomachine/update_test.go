@@ -0,0 +1,167 @@
+package solomachine_test
+
+import (
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	solomachine "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine"
+	"github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+)
+
+func (suite *SoloMachineTestSuite) TestCheckValidity() {
+	var (
+		clientState clientexported.ClientState
+		header      clientexported.Header
+	)
+
+	testCases := []struct {
+		name    string
+		setup   func()
+		expPass bool
+	}{
+		{
+			"successful update",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				header = suite.solomachine.CreateHeader()
+			},
+			true,
+		},
+		{
+			"successful update with a multisig public key",
+			func() {
+				suite.solomachine = ibctesting.NewSolomachine(suite.T(), suite.solomachine.ClientID, 4)
+				clientState = suite.solomachine.ClientState()
+				header = suite.solomachine.CreateHeader()
+			},
+			true,
+		},
+		{
+			"wrong client state type",
+			func() {
+				clientState = ibctmtypes.ClientState{}
+				header = suite.solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"invalid header type",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				header = ibctmtypes.Header{}
+			},
+			false,
+		},
+		{
+			"wrong sequence in header",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				// store in temp before assigning to interface type
+				h := suite.solomachine.CreateHeader()
+				h.Sequence++
+				header = h
+			},
+			false,
+		},
+		{
+			"header timestamp is less than the consensus state timestamp",
+			func() {
+				cs := suite.solomachine.ClientState()
+				cs.ConsensusState.Timestamp = suite.solomachine.Time + 1
+				clientState = cs
+				header = suite.solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"signature uses wrong sequence",
+			func() {
+				clientState = suite.solomachine.ClientState()
+				suite.solomachine.Sequence++
+				header = suite.solomachine.CreateHeader()
+			},
+			false,
+		},
+		{
+			"signature uses new public key",
+			func() {
+				// store in temp before assinging to interface type
+				cs := suite.solomachine.ClientState()
+				h := suite.solomachine.CreateHeader()
+				cs.ConsensusState.PubKey = suite.solomachine.PublicKey
+				clientState = cs
+				header = h
+			},
+			false,
+		},
+		{
+			"signature uses a different diversifier",
+			func() {
+				cs := suite.solomachine.ClientState()
+				cs.ConsensusState.Diversifier = "otherdiversifier"
+				clientState = cs
+				header = suite.solomachine.CreateHeader()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// setup test
+			tc.setup()
+
+			clientState, consensusState, err := solomachine.CheckValidityAndUpdateState(clientState, header)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(header.(types.Header).NewPubKey, clientState.(types.ClientState).ConsensusState.PubKey)
+				suite.Require().Equal(uint64(0), clientState.(types.ClientState).FrozenSequence)
+				suite.Require().Equal(header.(types.Header).Sequence+1, clientState.GetLatestHeight())
+				suite.Require().Equal(suite.solomachine.ConsensusState(), consensusState)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(clientState)
+				suite.Require().Nil(consensusState)
+			}
+		})
+	}
+}
+
+func (suite *SoloMachineTestSuite) TestCreateAndUpdateClient() {
+	solomachine, err := suite.coordinator.CreateSolomachineClient(suite.chainID, 1)
+	suite.Require().NoError(err)
+
+	chain := suite.coordinator.GetChain(suite.chainID)
+	clientKeeper := chain.App.IBCKeeper.ClientKeeper
+
+	clientState, found := clientKeeper.GetClientState(chain.GetContext(), solomachine.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(solomachine.ClientState(), clientState)
+
+	clientType, found := clientKeeper.GetClientType(chain.GetContext(), solomachine.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(clientexported.SoloMachine, clientType)
+
+	err = suite.coordinator.UpdateSolomachineClient(suite.chainID, solomachine)
+	suite.Require().NoError(err)
+
+	clientState, found = clientKeeper.GetClientState(chain.GetContext(), solomachine.ClientID)
+	suite.Require().True(found)
+	suite.Require().Equal(solomachine.ClientState(), clientState)
+
+	consensusState, found := clientKeeper.GetClientConsensusState(chain.GetContext(), solomachine.ClientID, solomachine.Sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(solomachine.ConsensusState(), consensusState)
+
+	// a header signed with the rotated key cannot be replayed
+	header := solomachine.CreateHeader()
+	_, err = clientKeeper.UpdateClient(chain.GetContext(), solomachine.ClientID, header)
+	suite.Require().NoError(err)
+	_, err = clientKeeper.UpdateClient(chain.GetContext(), solomachine.ClientID, header)
+	suite.Require().Error(err)
+}
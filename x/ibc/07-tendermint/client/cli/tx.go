@@ -30,8 +30,10 @@ import (
 )
 
 const (
-	flagTrustLevel = "trust-level"
-	flagProofSpecs = "proof-specs"
+	flagTrustLevel                   = "trust-level"
+	flagProofSpecs                   = "proof-specs"
+	flagAllowUpdateAfterExpiry       = "allow-update-after-expiry"
+	flagAllowUpdateAfterMisbehaviour = "allow-update-after-misbehaviour"
 )
 
 // GetCmdCreateClient defines the command to create a new IBC Client as defined
@@ -108,8 +110,12 @@ func GetCmdCreateClient(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("proof spec: %s isn't supported", spc)
 			}
 
+			allowUpdateAfterExpiry := viper.GetBool(flagAllowUpdateAfterExpiry)
+			allowUpdateAfterMisbehaviour := viper.GetBool(flagAllowUpdateAfterMisbehaviour)
+
 			msg := ibctmtypes.NewMsgCreateClient(
-				clientID, header, trustLevel, trustingPeriod, ubdPeriod, maxClockDrift, specs, clientCtx.GetFromAddress(),
+				clientID, header, trustLevel, trustingPeriod, ubdPeriod, maxClockDrift, specs,
+				allowUpdateAfterExpiry, allowUpdateAfterMisbehaviour, clientCtx.GetFromAddress(),
 			)
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -121,6 +127,8 @@ func GetCmdCreateClient(cdc *codec.Codec) *cobra.Command {
 	}
 	cmd.Flags().String(flagTrustLevel, "default", "light client trust level fraction for header updates")
 	cmd.Flags().String(flagProofSpecs, "default", "proof specs format to be used for verification")
+	cmd.Flags().Bool(flagAllowUpdateAfterExpiry, false, "allow governance proposal to update client after expiry")
+	cmd.Flags().Bool(flagAllowUpdateAfterMisbehaviour, false, "allow governance proposal to update client after misbehaviour")
 	return cmd
 }
 
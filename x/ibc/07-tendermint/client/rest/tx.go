@@ -52,7 +52,8 @@ func createClientHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		msg := ibctmtypes.NewMsgCreateClient(
 			req.ClientID, req.Header, req.TrustLevel,
 			req.TrustingPeriod, req.UnbondingPeriod, req.MaxClockDrift,
-			req.ProofSpecs, fromAddr,
+			req.ProofSpecs, req.AllowUpdateAfterExpiry, req.AllowUpdateAfterMisbehaviour,
+			fromAddr,
 		)
 
 		if err := msg.ValidateBasic(); err != nil {
@@ -49,6 +49,14 @@ type ClientState struct {
 	LastHeader Header `json:"last_header" yaml:"last_header"`
 
 	ProofSpecs []*ics23.ProofSpec `json:"proof_specs" yaml:"proof_specs"`
+
+	// This flag, when set to true, will allow governance to recover a client
+	// which has expired
+	AllowUpdateAfterExpiry bool `json:"allow_update_after_expiry" yaml:"allow_update_after_expiry"`
+
+	// This flag, when set to true, will allow governance to unfreeze a client
+	// whose chain has experienced a misbehaviour event
+	AllowUpdateAfterMisbehaviour bool `json:"allow_update_after_misbehaviour" yaml:"allow_update_after_misbehaviour"`
 }
 
 // InitializeFromMsg creates a tendermint client state from a CreateClientMsg
@@ -56,7 +64,7 @@ func InitializeFromMsg(msg MsgCreateClient) (ClientState, error) {
 	return Initialize(
 		msg.GetClientID(), msg.TrustLevel,
 		msg.TrustingPeriod, msg.UnbondingPeriod, msg.MaxClockDrift,
-		msg.Header, msg.ProofSpecs,
+		msg.Header, msg.ProofSpecs, msg.AllowUpdateAfterExpiry, msg.AllowUpdateAfterMisbehaviour,
 	)
 }
 
@@ -65,9 +73,12 @@ func InitializeFromMsg(msg MsgCreateClient) (ClientState, error) {
 func Initialize(
 	id string, trustLevel tmmath.Fraction,
 	trustingPeriod, ubdPeriod, maxClockDrift time.Duration,
-	header Header, specs []*ics23.ProofSpec,
+	header Header, specs []*ics23.ProofSpec, allowUpdateAfterExpiry, allowUpdateAfterMisbehaviour bool,
 ) (ClientState, error) {
-	clientState := NewClientState(id, trustLevel, trustingPeriod, ubdPeriod, maxClockDrift, header, specs)
+	clientState := NewClientState(
+		id, trustLevel, trustingPeriod, ubdPeriod, maxClockDrift, header, specs,
+		allowUpdateAfterExpiry, allowUpdateAfterMisbehaviour,
+	)
 
 	return clientState, nil
 }
@@ -76,17 +87,19 @@ func Initialize(
 func NewClientState(
 	id string, trustLevel tmmath.Fraction,
 	trustingPeriod, ubdPeriod, maxClockDrift time.Duration,
-	header Header, specs []*ics23.ProofSpec,
+	header Header, specs []*ics23.ProofSpec, allowUpdateAfterExpiry, allowUpdateAfterMisbehaviour bool,
 ) ClientState {
 	return ClientState{
-		ID:              id,
-		TrustLevel:      trustLevel,
-		TrustingPeriod:  trustingPeriod,
-		UnbondingPeriod: ubdPeriod,
-		MaxClockDrift:   maxClockDrift,
-		LastHeader:      header,
-		FrozenHeight:    0,
-		ProofSpecs:      specs,
+		ID:                           id,
+		TrustLevel:                   trustLevel,
+		TrustingPeriod:               trustingPeriod,
+		UnbondingPeriod:              ubdPeriod,
+		MaxClockDrift:                maxClockDrift,
+		LastHeader:                   header,
+		FrozenHeight:                 0,
+		ProofSpecs:                   specs,
+		AllowUpdateAfterExpiry:       allowUpdateAfterExpiry,
+		AllowUpdateAfterMisbehaviour: allowUpdateAfterMisbehaviour,
 	}
 }
 
@@ -38,7 +38,11 @@ type MsgCreateClient struct {
 	UnbondingPeriod time.Duration      `json:"unbonding_period" yaml:"unbonding_period"`
 	MaxClockDrift   time.Duration      `json:"max_clock_drift" yaml:"max_clock_drift"`
 	ProofSpecs      []*ics23.ProofSpec `json:"proof_specs" yaml:"proof_specs"`
-	Signer          sdk.AccAddress     `json:"address" yaml:"address"`
+
+	AllowUpdateAfterExpiry       bool `json:"allow_update_after_expiry" yaml:"allow_update_after_expiry"`
+	AllowUpdateAfterMisbehaviour bool `json:"allow_update_after_misbehaviour" yaml:"allow_update_after_misbehaviour"`
+
+	Signer sdk.AccAddress `json:"address" yaml:"address"`
 }
 
 // this is a constant to satisfy the linter
@@ -53,18 +57,21 @@ func (msg MsgCreateClient) ProtoMessage()  {}
 func NewMsgCreateClient(
 	id string, header Header, trustLevel tmmath.Fraction,
 	trustingPeriod, unbondingPeriod, maxClockDrift time.Duration,
-	specs []*ics23.ProofSpec, signer sdk.AccAddress,
+	specs []*ics23.ProofSpec, allowUpdateAfterExpiry, allowUpdateAfterMisbehaviour bool,
+	signer sdk.AccAddress,
 ) MsgCreateClient {
 
 	return MsgCreateClient{
-		ClientID:        id,
-		Header:          header,
-		TrustLevel:      trustLevel,
-		TrustingPeriod:  trustingPeriod,
-		UnbondingPeriod: unbondingPeriod,
-		MaxClockDrift:   maxClockDrift,
-		ProofSpecs:      specs,
-		Signer:          signer,
+		ClientID:                     id,
+		Header:                       header,
+		TrustLevel:                   trustLevel,
+		TrustingPeriod:               trustingPeriod,
+		UnbondingPeriod:              unbondingPeriod,
+		MaxClockDrift:                maxClockDrift,
+		ProofSpecs:                   specs,
+		AllowUpdateAfterExpiry:       allowUpdateAfterExpiry,
+		AllowUpdateAfterMisbehaviour: allowUpdateAfterMisbehaviour,
+		Signer:                       signer,
 	}
 }
 
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"reflect"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+)
+
+// CheckSubstituteAndUpdateState verifies that the subject client is allowed to
+// be recovered by governance, i.e it is frozen and AllowUpdateAfterMisbehaviour
+// is set or it is expired and AllowUpdateAfterExpiry is set. The substitute
+// client must be active, ahead of the subject client and have the same client
+// parameters, except for the trusting period. The substitute client state is
+// returned with the identifier of the subject client.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	ctx sdk.Context, substituteClient clientexported.ClientState,
+) (clientexported.ClientState, error) {
+	substituteClientState, ok := substituteClient.(ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "invalid substitute client type %T, expected %T", substituteClient, ClientState{},
+		)
+	}
+
+	if !IsMatchingClientState(cs, substituteClientState) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
+	}
+
+	switch {
+	case cs.IsFrozen():
+		if !cs.AllowUpdateAfterMisbehaviour {
+			return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "client is not allowed to be unfrozen")
+		}
+
+	case cs.IsExpired(ctx.BlockTime()):
+		if !cs.AllowUpdateAfterExpiry {
+			return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "client is not allowed to be unexpired")
+		}
+
+	default:
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "cannot substitute a client that is neither frozen nor expired")
+	}
+
+	if substituteClientState.IsFrozen() {
+		return nil, sdkerrors.Wrap(clienttypes.ErrClientFrozen, "substitute client is frozen")
+	}
+
+	if substituteClientState.IsExpired(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "substitute client is expired")
+	}
+
+	if substituteClientState.GetLatestHeight() <= cs.GetLatestHeight() {
+		return nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidSubstitute,
+			"substitute client height must be greater than the subject client height (%d <= %d)",
+			substituteClientState.GetLatestHeight(), cs.GetLatestHeight(),
+		)
+	}
+
+	substituteClientState.ID = cs.ID
+
+	return substituteClientState, nil
+}
+
+// IsExpired returns true if the trusting period has elapsed since the latest
+// timestamp of the client.
+func (cs ClientState) IsExpired(now time.Time) bool {
+	return !cs.GetLatestTimestamp().Add(cs.TrustingPeriod).After(now)
+}
+
+// IsMatchingClientState returns true if the subject and substitute client states
+// track the same chain with the same client parameters. The identifier, frozen
+// height, trusting period and latest header are allowed to differ.
+func IsMatchingClientState(subject, substitute ClientState) bool {
+	if subject.GetChainID() != substitute.GetChainID() {
+		return false
+	}
+
+	// zero out the fields which do not need to match
+	subject.ID, substitute.ID = "", ""
+	subject.FrozenHeight, substitute.FrozenHeight = 0, 0
+	subject.TrustingPeriod, substitute.TrustingPeriod = 0, 0
+	subject.LastHeader, substitute.LastHeader = Header{}, Header{}
+
+	return reflect.DeepEqual(subject, substitute)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	clienttypes "github.com/cosmos/cosmos-sdk/x/ibc/02-client/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// ZeroCustomFields returns a copy of the client state with all the client
+// chosen fields zeroed out. Only the fields chosen by the chain are kept: the
+// chain-id, the unbonding period and the proof specs. An upgrading chain commits
+// the amino encoding of this client state in its upgrade plan.
+func (cs ClientState) ZeroCustomFields() ClientState {
+	return ClientState{
+		UnbondingPeriod: cs.UnbondingPeriod,
+		LastHeader: Header{
+			SignedHeader: tmtypes.SignedHeader{
+				Header: &tmtypes.Header{ChainID: cs.GetChainID()},
+			},
+		},
+		ProofSpecs: cs.ProofSpecs,
+	}
+}
+
+// VerifyUpgrade verifies a proof of the upgraded client state committed by the
+// counterparty chain under the upgrade module UpgradedClientKey of the upgrade
+// height, against the root of the last trusted consensus state. The latest
+// header of the upgraded client must be signed by the trusted validator set,
+// which is carried over by the chain through the upgrade.
+//
+// The returned client state keeps the identifier, trust level, trusting period,
+// max clock drift and governance recovery flags of the current client and takes
+// the chain chosen fields from the upgraded one.
+func (cs ClientState) VerifyUpgrade(
+	_ sdk.KVStore,
+	cdc codec.Marshaler,
+	aminoCdc *codec.Codec,
+	upgradedClient clientexported.ClientState,
+	upgradeHeight uint64,
+	proofUpgrade []byte,
+	consensusState clientexported.ConsensusState,
+) (clientexported.ClientState, clientexported.ConsensusState, error) {
+	if cs.IsFrozen() {
+		return nil, nil, clienttypes.ErrClientFrozen
+	}
+
+	tmUpgradedClient, ok := upgradedClient.(ClientState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidClientType, "invalid upgraded client type %T, expected %T", upgradedClient, ClientState{},
+		)
+	}
+
+	tmConsState, ok := consensusState.(ConsensusState)
+	if !ok {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidConsensus, "invalid consensus type %T, expected %T", consensusState, ConsensusState{},
+		)
+	}
+
+	if tmConsState.ValidatorSet == nil {
+		return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidConsensus, "trusted validator set cannot be nil")
+	}
+
+	if cs.GetLatestHeight() >= upgradeHeight {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidUpgradeClient,
+			"client state height must be less than the upgrade height (%d >= %d)", cs.GetLatestHeight(), upgradeHeight,
+		)
+	}
+
+	if len(proofUpgrade) == 0 {
+		return nil, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "proof of upgrade cannot be empty")
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := cdc.UnmarshalBinaryBare(proofUpgrade, &merkleProof); err != nil {
+		return nil, nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	bz, err := aminoCdc.MarshalBinaryBare(tmUpgradedClient.ZeroCustomFields())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := commitmenttypes.NewMerklePath(
+		[]string{upgradetypes.StoreKey, string(upgradetypes.UpgradedClientKey(int64(upgradeHeight)))},
+	)
+
+	if err := merkleProof.VerifyMembership(cs.ProofSpecs, tmConsState.GetRoot(), path, bz); err != nil {
+		return nil, nil, sdkerrors.Wrapf(clienttypes.ErrInvalidUpgradeClient, "upgraded client state verification failed: %s", err)
+	}
+
+	header := tmUpgradedClient.LastHeader
+	newClientState := NewClientState(
+		cs.ID, cs.TrustLevel, cs.TrustingPeriod, tmUpgradedClient.UnbondingPeriod, cs.MaxClockDrift,
+		header, tmUpgradedClient.ProofSpecs, cs.AllowUpdateAfterExpiry, cs.AllowUpdateAfterMisbehaviour,
+	)
+
+	if err := newClientState.Validate(); err != nil {
+		return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, err.Error())
+	}
+
+	if !header.Time.After(tmConsState.Timestamp) {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidUpgradeClient,
+			"upgraded header time must be after the trusted consensus state time (%s <= %s)", header.Time, tmConsState.Timestamp,
+		)
+	}
+
+	if err := tmConsState.ValidatorSet.VerifyCommitTrusting(
+		header.ChainID, header.Commit.BlockID, header.Height, header.Commit, cs.TrustLevel,
+	); err != nil {
+		return nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrInvalidUpgradeClient, "upgraded header is not signed by the trusted validator set: %s", err,
+		)
+	}
+
+	return newClientState, header.ConsensusState(), nil
+}
@@ -0,0 +1,162 @@
+package types_test
+
+import (
+	"time"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	clientexported "github.com/cosmos/cosmos-sdk/x/ibc/02-client/exported"
+	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
+	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+	ibctesting "github.com/cosmos/cosmos-sdk/x/ibc/testing"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const upgradedChainID = "upgraded-chain"
+
+func (suite *TendermintTestSuite) TestVerifyUpgrade() {
+	var (
+		clientState    ibctmtypes.ClientState
+		consensusState clientexported.ConsensusState
+		upgradedClient clientexported.ClientState
+		upgradeHeight  uint64
+		proofUpgrade   []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"successful upgrade", func() {}, true,
+		},
+		{
+			"client is frozen", func() {
+				clientState.FrozenHeight = 1
+			}, false,
+		},
+		{
+			"upgraded client is not a tendermint client", func() {
+				upgradedClient = localhosttypes.NewClientState(upgradedChainID, 1)
+			}, false,
+		},
+		{
+			"consensus state is not a tendermint consensus state", func() {
+				consensusState = nil
+			}, false,
+		},
+		{
+			"client height is not less than the upgrade height", func() {
+				upgradeHeight = clientState.GetLatestHeight()
+			}, false,
+		},
+		{
+			"empty proof", func() {
+				proofUpgrade = nil
+			}, false,
+		},
+		{
+			"invalid proof", func() {
+				proofUpgrade = []byte("invalid proof")
+			}, false,
+		},
+		{
+			"upgraded client does not match the committed client", func() {
+				tmClient := upgradedClient.(ibctmtypes.ClientState)
+				tmClient.UnbondingPeriod = ibctesting.UnbondingPeriod * 2
+				upgradedClient = tmClient
+			}, false,
+		},
+		{
+			"proof verified against a different root", func() {
+				tmConsState := consensusState.(ibctmtypes.ConsensusState)
+				tmConsState.Root = commitmenttypes.NewMerkleRoot([]byte("root"))
+				consensusState = tmConsState
+			}, false,
+		},
+		{
+			"upgraded header is not after the trusted consensus state", func() {
+				tmConsState := consensusState.(ibctmtypes.ConsensusState)
+				tmConsState.Timestamp = upgradedClient.(ibctmtypes.ClientState).GetLatestTimestamp()
+				consensusState = tmConsState
+			}, false,
+		},
+		{
+			"upgraded header is not signed by the trusted validator set", func() {
+				privVal := tmtypes.NewMockPV()
+				pubKey, err := privVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				tmConsState := consensusState.(ibctmtypes.ConsensusState)
+				tmConsState.ValidatorSet = tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+				consensusState = tmConsState
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			coordinator := ibctesting.NewCoordinator(suite.T(), 2)
+			chainA := coordinator.GetChain(ibctesting.GetChainID(0))
+			chainB := coordinator.GetChain(ibctesting.GetChainID(1))
+
+			clientID, err := coordinator.CreateClient(chainA.ChainID, chainB.ChainID, clientexported.Tendermint)
+			suite.Require().NoError(err)
+
+			cs, found := chainA.App.IBCKeeper.ClientKeeper.GetClientState(chainA.GetContext(), clientID)
+			suite.Require().True(found)
+			clientState = cs.(ibctmtypes.ClientState)
+
+			// commit the upgraded client state on the counterparty chain
+			upgradeHeight = uint64(chainB.CurrentHeader.Height + 10)
+			upgradedHeader := ibctmtypes.CreateTestHeader(upgradedChainID, 1, chainB.CurrentHeader.Time, chainB.Vals, chainB.Signers)
+			tmUpgradedClient := ibctmtypes.NewClientState(
+				"", ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod+time.Hour,
+				ibctesting.MaxClockDrift, upgradedHeader, commitmenttypes.GetSDKSpecs(), false, false,
+			)
+			upgradedClient = tmUpgradedClient
+
+			plan := upgradetypes.Plan{
+				Name:                "upgrade",
+				Height:              int64(upgradeHeight),
+				UpgradedClientState: chainB.App.Codec().MustMarshalBinaryBare(tmUpgradedClient.ZeroCustomFields()),
+			}
+			suite.Require().NoError(chainB.App.UpgradeKeeper.ScheduleUpgrade(chainB.GetContext(), plan))
+			coordinator.CommitBlock(chainB.ChainID)
+
+			proofUpgrade, _ = chainB.QueryUpgradeProof(upgradetypes.UpgradedClientKey(int64(upgradeHeight)))
+
+			// trusted consensus state with the root of the counterparty chain commitment
+			consensusState = ibctmtypes.ConsensusState{
+				Height:       clientState.GetLatestHeight(),
+				Timestamp:    clientState.GetLatestTimestamp(),
+				Root:         commitmenttypes.NewMerkleRoot(chainB.App.LastCommitID().Hash),
+				ValidatorSet: chainB.Vals,
+			}
+
+			tc.malleate()
+
+			newClient, newConsState, err := clientState.VerifyUpgrade(
+				nil, suite.cdc, suite.aminoCdc, upgradedClient, upgradeHeight, proofUpgrade, consensusState,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(clientID, newClient.GetID())
+				suite.Require().Equal(upgradedChainID, newClient.GetChainID())
+				suite.Require().Equal(uint64(1), newClient.GetLatestHeight())
+				suite.Require().Equal(ibctesting.UnbondingPeriod+time.Hour, newClient.(ibctmtypes.ClientState).UnbondingPeriod)
+				suite.Require().Equal(clientState.TrustingPeriod, newClient.(ibctmtypes.ClientState).TrustingPeriod)
+				suite.Require().Equal(upgradedHeader.ConsensusState(), newConsState)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(newClient)
+				suite.Require().Nil(newConsState)
+			}
+		})
+	}
+}
@@ -333,3 +333,25 @@ func (cs ClientState) VerifyNextSequenceRecv(
 func consensusStatePath(clientID string) string {
 	return fmt.Sprintf("consensusState/%s", clientID)
 }
+
+// VerifyUpgrade returns an error since the localhost client cannot be upgraded.
+// The client is updated with the running chain on every block instead.
+func (cs ClientState) VerifyUpgrade(
+	_ sdk.KVStore,
+	_ codec.Marshaler,
+	_ *codec.Codec,
+	_ clientexported.ClientState,
+	_ uint64,
+	_ []byte,
+	_ clientexported.ConsensusState,
+) (clientexported.ClientState, clientexported.ConsensusState, error) {
+	return nil, nil, sdkerrors.Wrap(clienttypes.ErrInvalidUpgradeClient, "cannot upgrade localhost client")
+}
+
+// CheckSubstituteAndUpdateState returns an error since the localhost client is
+// always active and cannot be substituted.
+func (cs ClientState) CheckSubstituteAndUpdateState(
+	_ sdk.Context, _ clientexported.ClientState,
+) (clientexported.ClientState, error) {
+	return nil, sdkerrors.Wrap(clienttypes.ErrInvalidSubstitute, "cannot substitute localhost client")
+}
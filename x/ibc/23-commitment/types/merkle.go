@@ -6,6 +6,7 @@ import (
 
 	ics23 "github.com/confio/ics23/go"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/exported"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
@@ -14,7 +15,7 @@ import (
 )
 
 // var representing the proofspecs for a SDK chain
-var sdkSpecs = []*ics23.ProofSpec{ics23.IavlSpec, ics23.TendermintSpec}
+var sdkSpecs = storetypes.ProofSpecs()
 
 // ICS 023 Merkle Types Implementation
 //
@@ -24,6 +24,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case clientexported.MsgUpdateClient:
 			return &sdk.Result{}, nil
 
+		case *client.MsgUpgradeClient:
+			return client.HandleMsgUpgradeClient(ctx, k.ClientKeeper, msg)
+
 		// IBC connection  msgs
 		case *connection.MsgConnectionOpenInit:
 			return connection.HandleMsgConnectionOpenInit(ctx, k.ConnectionKeeper, msg)
@@ -26,7 +26,7 @@ type Keeper struct {
 func NewKeeper(
 	aminoCdc *codec.Codec, cdc codec.Marshaler, key sdk.StoreKey, stakingKeeper client.StakingKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
 ) *Keeper {
-	clientKeeper := client.NewKeeper(aminoCdc, key, stakingKeeper)
+	clientKeeper := client.NewKeeper(aminoCdc, cdc, key, stakingKeeper)
 	connectionKeeper := connection.NewKeeper(aminoCdc, cdc, key, clientKeeper)
 	portKeeper := port.NewKeeper(scopedKeeper)
 	channelKeeper := channel.NewKeeper(cdc, key, clientKeeper, connectionKeeper, portKeeper, scopedKeeper)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gogo/protobuf/grpc"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -68,6 +69,9 @@ func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Rout
 	rest.RegisterRoutes(clientCtx, rtr, host.StoreKey)
 }
 
+// RegisterGRPCGatewayRoutes registers no gRPC Gateway routes for the ibc module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+
 // GetTxCmd returns the root tx command for the ibc module.
 func (AppModuleBasic) GetTxCmd(clientCtx client.Context) *cobra.Command {
 	return cli.GetTxCmd(clientCtx)
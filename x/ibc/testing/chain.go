@@ -2,6 +2,7 @@ package testing
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -18,10 +19,12 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	connectiontypes "github.com/cosmos/cosmos-sdk/x/ibc/03-connection/types"
 	channeltypes "github.com/cosmos/cosmos-sdk/x/ibc/04-channel/types"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
 	host "github.com/cosmos/cosmos-sdk/x/ibc/24-host"
 	"github.com/cosmos/cosmos-sdk/x/ibc/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 const (
@@ -152,8 +155,30 @@ func (chain *TestChain) QueryProof(key []byte) ([]byte, uint64) {
 	return proof, uint64(res.Height)
 }
 
+// QueryUpgradeProof performs an abci query with the given key on the upgrade module store
+// and returns the proto encoded merkle proof for the query and the height at which the query
+// was performed.
+func (chain *TestChain) QueryUpgradeProof(key []byte) ([]byte, uint64) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", upgradetypes.StoreKey),
+		Height: chain.App.LastBlockHeight(),
+		Data:   key,
+		Prove:  true,
+	})
+
+	merkleProof := commitmenttypes.MerkleProof{
+		Proof: res.Proof,
+	}
+
+	proof, err := chain.App.AppCodec().MarshalBinaryBare(&merkleProof)
+	require.NoError(chain.t, err)
+
+	return proof, uint64(res.Height)
+}
+
 // NextBlock sets the last header to the current header and increments the current header to be
 // at the next block height. It does not update the time as that is handled by the Coordinator.
+// BeginBlock is called on the new block so that the current context can be used.
 //
 // CONTRACT: this function must only be called after app.Commit() occurs
 func (chain *TestChain) NextBlock() {
@@ -170,6 +195,8 @@ func (chain *TestChain) NextBlock() {
 		Height: chain.CurrentHeader.Height + 1,
 		Time:   chain.CurrentHeader.Time,
 	}
+
+	chain.App.BeginBlock(abci.RequestBeginBlock{Header: chain.CurrentHeader})
 }
 
 // SendMsg delivers a transaction through the application. It updates the senders sequence
@@ -201,7 +228,7 @@ func (chain *TestChain) SendMsg(msg sdk.Msg) error {
 // NewClientID appends a new clientID string in the format:
 // ClientFor<counterparty-chain-id><index>
 func (chain *TestChain) NewClientID(counterpartyChainID string) string {
-	clientID := ClientIDPrefix + counterpartyChainID + string(len(chain.ClientIDs))
+	clientID := ClientIDPrefix + counterpartyChainID + strconv.Itoa(len(chain.ClientIDs))
 
 	chain.ClientIDs = append(chain.ClientIDs, clientID)
 	return clientID
@@ -211,7 +238,7 @@ func (chain *TestChain) NewClientID(counterpartyChainID string) string {
 // client id and counterparty client id. The connection id format:
 // connectionid<index>
 func (chain *TestChain) NewTestConnection(clientID, counterpartyClientID string) TestConnection {
-	connectionID := ConnectionIDPrefix + string(len(chain.Connections))
+	connectionID := ConnectionIDPrefix + strconv.Itoa(len(chain.Connections))
 	conn := TestConnection{
 		ID:                   connectionID,
 		ClientID:             clientID,
@@ -227,8 +254,8 @@ func (chain *TestChain) NewTestConnection(clientID, counterpartyClientID string)
 // channelid<index>
 // portid<index>
 func (chain *TestChain) NewTestChannel() TestChannel {
-	portID := PortIDPrefix + string(len(chain.Channels))
-	channelID := ChannelIDPrefix + string(len(chain.Channels))
+	portID := PortIDPrefix + strconv.Itoa(len(chain.Channels))
+	channelID := ChannelIDPrefix + strconv.Itoa(len(chain.Channels))
 	channel := TestChannel{
 		PortID:    portID,
 		ChannelID: channelID,
@@ -246,7 +273,8 @@ func (chain *TestChain) CreateTMClient(counterparty *TestChain, clientID string)
 	msg := ibctmtypes.NewMsgCreateClient(
 		clientID, counterparty.LastHeader,
 		DefaultTrustLevel, TrustingPeriod, UnbondingPeriod, MaxClockDrift,
-		commitmenttypes.GetSDKSpecs(), chain.SenderAccount.GetAddress(),
+		commitmenttypes.GetSDKSpecs(), false, false,
+		chain.SenderAccount.GetAddress(),
 	)
 
 	return chain.SendMsg(msg)
@@ -263,6 +291,29 @@ func (chain *TestChain) UpdateTMClient(counterparty *TestChain, clientID string)
 	return chain.SendMsg(msg)
 }
 
+// CreateSolomachineClient will construct and execute a 06-solomachine MsgCreateClient. A
+// solo machine client will be created on the (target) chain.
+func (chain *TestChain) CreateSolomachineClient(solomachine *Solomachine) error {
+	msg := solomachinetypes.NewMsgCreateClient(
+		solomachine.ClientID, solomachine.ConsensusState(),
+		chain.SenderAccount.GetAddress(),
+	)
+
+	return chain.SendMsg(msg)
+}
+
+// UpdateSolomachineClient will construct and execute a 06-solomachine MsgUpdateClient
+// which rotates the keys of the solo machine. The solo machine client will be updated
+// on the (target) chain.
+func (chain *TestChain) UpdateSolomachineClient(solomachine *Solomachine) error {
+	msg := solomachinetypes.NewMsgUpdateClient(
+		solomachine.ClientID, solomachine.CreateHeader(),
+		chain.SenderAccount.GetAddress(),
+	)
+
+	return chain.SendMsg(msg)
+}
+
 // ConnectionOpenInit will construct and execute a MsgConnectionOpenInit.
 func (chain *TestChain) ConnectionOpenInit(
 	counterparty *TestChain,
@@ -2,6 +2,7 @@ package testing
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func NewCoordinator(t *testing.T, n int) *Coordinator {
 	chains := make(map[string]*TestChain)
 
 	for i := 0; i < n; i++ {
-		chainID := ChainIDPrefix + string(i)
+		chainID := GetChainID(i)
 		chains[chainID] = NewTestChain(t, chainID)
 	}
 	return &Coordinator{
@@ -41,6 +42,11 @@ func NewCoordinator(t *testing.T, n int) *Coordinator {
 	}
 }
 
+// GetChainID returns the chainID used for the provided index.
+func GetChainID(index int) string {
+	return ChainIDPrefix + strconv.Itoa(index)
+}
+
 // IncrementTime iterates through all the TestChain's and increments their current header time
 // by 5 seconds.
 //
@@ -143,6 +149,45 @@ func (coord *Coordinator) UpdateClient(
 	return nil
 }
 
+// CreateSolomachineClient creates a solo machine client on the source chain using
+// an nKeys amount of keys and returns the solo machine used to sign for it.
+func (coord *Coordinator) CreateSolomachineClient(
+	sourceID string, nKeys uint64,
+) (*Solomachine, error) {
+	coord.CommitBlock(sourceID)
+
+	source := coord.GetChain(sourceID)
+
+	clientID := source.NewClientID(SolomachineCounterpartyID)
+	solomachine := NewSolomachine(coord.t, clientID, nKeys)
+
+	if err := source.CreateSolomachineClient(solomachine); err != nil {
+		return nil, err
+	}
+
+	coord.IncrementTime()
+
+	return solomachine, nil
+}
+
+// UpdateSolomachineClient rotates the keys of the solo machine and updates its
+// client on the source chain.
+func (coord *Coordinator) UpdateSolomachineClient(
+	sourceID string, solomachine *Solomachine,
+) error {
+	coord.CommitBlock(sourceID)
+
+	source := coord.GetChain(sourceID)
+
+	if err := source.UpdateSolomachineClient(solomachine); err != nil {
+		return err
+	}
+
+	coord.IncrementTime()
+
+	return nil
+}
+
 // CreateConnection constructs and executes connection handshake messages in order to create
 // OPEN channels on source and counterparty chains. The connection information of the source
 // and counterparty's are returned within a TestConnection struct. If there is a fault in
@@ -0,0 +1,200 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
+	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
+)
+
+const (
+	// Default diversifier used by a testing solo machine
+	SolomachineDiversifier = "testing"
+
+	// Counterparty identifier used to generate solo machine client ID's
+	SolomachineCounterpartyID = "solo"
+)
+
+// Solomachine is a testing helper used to simulate a counterparty
+// solo machine client. If more than one key is used, the public key of the
+// solo machine is a multisig threshold key which requires all the keys to sign.
+type Solomachine struct {
+	t *testing.T
+
+	ClientID    string
+	PrivateKeys []crypto.PrivKey // keys used for signing
+	PublicKeys  []crypto.PubKey  // keys used for generating the solo machine public key
+	PublicKey   crypto.PubKey    // key used for signature verification
+	Sequence    uint64
+	Time        uint64
+	Diversifier string
+}
+
+// NewSolomachine returns a new solo machine instance with an nKeys amount of
+// generated private/public key pairs and a sequence starting at 1.
+func NewSolomachine(t *testing.T, clientID string, nKeys uint64) *Solomachine {
+	privKeys, pubKeys, pk := GenerateKeys(t, nKeys)
+
+	return &Solomachine{
+		t:           t,
+		ClientID:    clientID,
+		PrivateKeys: privKeys,
+		PublicKeys:  pubKeys,
+		PublicKey:   pk,
+		Sequence:    1,
+		Time:        10,
+		Diversifier: SolomachineDiversifier,
+	}
+}
+
+// GenerateKeys generates a new set of secp256k1 private keys and public keys.
+// If the number of keys is greater than one then the public key returned
+// represents a multisig public key. The private keys are used for signing, the
+// public keys are used for generating the public key and the public key is
+// used for solo machine verification. The usage of secp256k1 is entirely
+// arbitrary.
+func GenerateKeys(t *testing.T, n uint64) ([]crypto.PrivKey, []crypto.PubKey, crypto.PubKey) {
+	require.NotEqual(t, uint64(0), n, "generation of zero keys is not allowed")
+
+	privKeys := make([]crypto.PrivKey, n)
+	pubKeys := make([]crypto.PubKey, n)
+	for i := uint64(0); i < n; i++ {
+		privKeys[i] = secp256k1.GenPrivKey()
+		pubKeys[i] = privKeys[i].PubKey()
+	}
+
+	var pk crypto.PubKey
+	if len(privKeys) > 1 {
+		// generate multi sig pk
+		pk = multisig.NewPubKeyMultisigThreshold(int(n), pubKeys)
+	} else {
+		pk = privKeys[0].PubKey()
+	}
+
+	return privKeys, pubKeys, pk
+}
+
+// ClientState returns a new solo machine ClientState instance.
+func (solo *Solomachine) ClientState() solomachinetypes.ClientState {
+	return solomachinetypes.NewClientState(solo.ClientID, solo.ConsensusState())
+}
+
+// ConsensusState returns a new solo machine ConsensusState instance.
+func (solo *Solomachine) ConsensusState() solomachinetypes.ConsensusState {
+	return solomachinetypes.NewConsensusState(solo.Sequence, solo.PublicKey, solo.Diversifier, solo.Time)
+}
+
+// GetHeight returns the current sequence of the solo machine.
+func (solo *Solomachine) GetHeight() uint64 {
+	return solo.Sequence
+}
+
+// CreateHeader generates a new private/public key pair and creates the
+// necessary signature to construct a valid solo machine header. The solo
+// machine is updated to the new keys and its sequence is incremented.
+func (solo *Solomachine) CreateHeader() solomachinetypes.Header {
+	// generate new private keys and signature for header
+	newPrivKeys, newPubKeys, newPubKey := GenerateKeys(solo.t, uint64(len(solo.PrivateKeys)))
+
+	header := solomachinetypes.Header{
+		Sequence:       solo.Sequence,
+		Timestamp:      solo.Time,
+		NewPubKey:      newPubKey,
+		NewDiversifier: solo.Diversifier,
+	}
+
+	signBytes, err := solomachinetypes.HeaderSignBytes(header, solo.Diversifier)
+	require.NoError(solo.t, err)
+
+	header.Signature = solo.GenerateSignature(signBytes)
+
+	// assumes successful header update
+	solo.Sequence++
+	solo.PrivateKeys = newPrivKeys
+	solo.PublicKeys = newPubKeys
+	solo.PublicKey = newPubKey
+
+	return header
+}
+
+// CreateMisbehaviour constructs testing misbehaviour for the solo machine
+// client by signing over two different data bytes at the same sequence.
+func (solo *Solomachine) CreateMisbehaviour() solomachinetypes.Evidence {
+	signatureOne := solo.GenerateSignatureAndData([]byte("DATA ONE"))
+	signatureTwo := solo.GenerateSignatureAndData([]byte("DATA TWO"))
+
+	return solomachinetypes.Evidence{
+		ClientID:     solo.ClientID,
+		Sequence:     solo.Sequence,
+		SignatureOne: signatureOne,
+		SignatureTwo: signatureTwo,
+	}
+}
+
+// GenerateSignatureAndData signs over the given data at the current sequence
+// and time of the solo machine.
+func (solo *Solomachine) GenerateSignatureAndData(data []byte) solomachinetypes.SignatureAndData {
+	sd := solomachinetypes.SignatureAndData{
+		Data:      data,
+		Timestamp: solo.Time,
+	}
+
+	signBytes, err := solomachinetypes.EvidenceSignBytes(solo.Sequence, solo.Diversifier, sd)
+	require.NoError(solo.t, err)
+
+	sd.Signature = solo.GenerateSignature(signBytes)
+	return sd
+}
+
+// GenerateProof signs over the value stored under the given path and returns
+// the encoded timestamped signature expected by the solo machine state
+// verification functions. The sequence of the solo machine is incremented.
+func (solo *Solomachine) GenerateProof(path commitmenttypes.MerklePath, value []byte) []byte {
+	signBytes, err := solomachinetypes.StateSignBytes(solo.Sequence, solo.Time, solo.Diversifier, path, value)
+	require.NoError(solo.t, err)
+
+	signature := solomachinetypes.NewTimestampedSignature(solo.GenerateSignature(signBytes), solo.Time)
+
+	proof, err := solomachinetypes.SubModuleCdc.MarshalBinaryBare(signature)
+	require.NoError(solo.t, err)
+
+	// assumes successful verification
+	solo.Sequence++
+
+	return proof
+}
+
+// GenerateSignature uses the stored private keys to generate a signature
+// over the sign bytes with each key. If the amount of keys is greater than
+// 1 then an amino encoded multisignature is returned.
+func (solo *Solomachine) GenerateSignature(signBytes []byte) []byte {
+	sigs := make([][]byte, len(solo.PrivateKeys))
+	for i, key := range solo.PrivateKeys {
+		sig, err := key.Sign(signBytes)
+		require.NoError(solo.t, err)
+
+		sigs[i] = sig
+	}
+
+	if len(sigs) == 1 {
+		// single public key
+		return sigs[0]
+	}
+
+	// generate multisignature with every key set
+	bitArray := cryptotypes.NewCompactBitArray(len(sigs))
+	for i := range sigs {
+		bitArray.SetIndex(i, true)
+	}
+
+	return multisig.Cdc.MustMarshalBinaryBare(multisig.AminoMultisignature{
+		BitArray: bitArray,
+		Sigs:     sigs,
+	})
+}
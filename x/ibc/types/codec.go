@@ -6,6 +6,7 @@ import (
 	client "github.com/cosmos/cosmos-sdk/x/ibc/02-client"
 	connection "github.com/cosmos/cosmos-sdk/x/ibc/03-connection"
 	channel "github.com/cosmos/cosmos-sdk/x/ibc/04-channel"
+	solomachinetypes "github.com/cosmos/cosmos-sdk/x/ibc/06-solomachine/types"
 	ibctmtypes "github.com/cosmos/cosmos-sdk/x/ibc/07-tendermint/types"
 	localhosttypes "github.com/cosmos/cosmos-sdk/x/ibc/09-localhost/types"
 	commitmenttypes "github.com/cosmos/cosmos-sdk/x/ibc/23-commitment/types"
@@ -17,6 +18,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	client.RegisterCodec(cdc)
 	connection.RegisterCodec(cdc)
 	channel.RegisterCodec(cdc)
+	solomachinetypes.RegisterCodec(cdc)
 	ibctmtypes.RegisterCodec(cdc)
 	localhosttypes.RegisterCodec(cdc)
 	commitmenttypes.RegisterCodec(cdc)
@@ -24,6 +26,7 @@ func RegisterCodec(cdc *codec.Codec) {
 
 // RegisterInterfaces registers x/ibc interfaces into protobuf Any.
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	client.RegisterInterfaces(registry)
 	connection.RegisterInterfaces(registry)
 	channel.RegisterInterfaces(registry)
 }
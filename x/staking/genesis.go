@@ -91,6 +91,12 @@ func InitGenesis(
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		keeper.SetTokenizeShareRecord(ctx, record)
+	}
+
+	keeper.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordID)
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -178,6 +184,9 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		TokenizeShareRecords:      keeper.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordID: keeper.GetLastTokenizeShareRecordID(ctx),
 	}
 }
 
@@ -203,9 +212,39 @@ func ValidateGenesis(data types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordID); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.ID] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.ID)
+		}
+
+		if record.ID == 0 || record.ID > lastID {
+			return fmt.Errorf("invalid tokenize share record id %d, last id is %d", record.ID, lastID)
+		}
+
+		if record.ValidatorAddress.Empty() {
+			return fmt.Errorf("tokenize share record %d has an empty validator address", record.ID)
+		}
+
+		if record.Owner.Empty() {
+			return fmt.Errorf("tokenize share record %d has an empty owner", record.ID)
+		}
+
+		ids[record.ID] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) (err error) {
 	addrMap := make(map[string]bool, len(validators))
 
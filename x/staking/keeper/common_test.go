@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -47,3 +48,36 @@ func generateAddresses(app *simapp.SimApp, ctx sdk.Context, numAddrs int) ([]sdk
 
 	return addrDels, addrVals
 }
+
+// bootstrapDelegationTest creates a bonded validator with a self-delegation
+// and a delegation from addrs[1] of the given amount.
+func bootstrapDelegationTest(t *testing.T, delAmount sdk.Int) (*simapp.SimApp, sdk.Context, []sdk.AccAddress, []sdk.ValAddress) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, abci.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.TokensFromConsensusPower(100))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrs)
+
+	validator := types.NewValidator(valAddrs[0], PKs[0], types.Description{})
+	app.StakingKeeper.SetValidator(ctx, validator)
+	app.StakingKeeper.SetValidatorByConsAddr(ctx, validator)
+	app.StakingKeeper.AfterValidatorCreated(ctx, validator.OperatorAddress)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[0], sdk.TokensFromConsensusPower(10), sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	_, err = app.StakingKeeper.Delegate(ctx, addrs[1], delAmount, sdk.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	validator, _ = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	require.True(t, validator.IsBonded())
+
+	return app, ctx, addrs, valAddrs
+}
+
+func requireStakingInvariants(t *testing.T, app *simapp.SimApp, ctx sdk.Context) {
+	msg, broken := keeper.AllInvariants(app.StakingKeeper)(ctx)
+	require.False(t, broken, msg)
+}
@@ -259,6 +259,25 @@ func (k Keeper) InsertUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation,
 	}
 }
 
+// RemoveUBDQueue removes a single entry of an unbonding delegation from the
+// appropriate timeslice in the unbonding queue
+func (k Keeper) RemoveUBDQueue(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+
+	for i, dvPair := range timeSlice {
+		if dvPair.DelegatorAddress.Equals(ubd.DelegatorAddress) && dvPair.ValidatorAddress.Equals(ubd.ValidatorAddress) {
+			timeSlice = append(timeSlice[:i], timeSlice[i+1:]...)
+			break
+		}
+	}
+
+	if len(timeSlice) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+	} else {
+		k.SetUBDQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
 // Returns all the unbonding queue timeslices from time 0 until endTime
 func (k Keeper) UBDQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -730,6 +749,71 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation delegates back to the validator the given amount
+// of the unbonding delegation entry created at creationHeight. The entry is
+// reduced by the amount, or removed along with its unbonding queue entry if
+// nothing is left to unbond.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, creationHeight int64,
+) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoUnbondingDelegation
+	}
+
+	entryIndex := -1
+
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+
+	if entryIndex == -1 {
+		return sdkerrors.Wrapf(types.ErrNoUnbondingDelegation, "no entry at creation height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+
+	if entry.IsMature(ctx.BlockHeader().Time) {
+		return sdkerrors.Wrap(types.ErrNoUnbondingDelegation, "unbonding delegation entry has already matured")
+	}
+
+	if entry.Balance.LT(amount) {
+		return sdkerrors.Wrapf(types.ErrBadDelegationAmount, "amount is greater than the unbonding delegation entry balance %s", entry.Balance)
+	}
+
+	// the unbonding tokens are held by the not bonded pool
+	if _, err := k.Delegate(ctx, delAddr, amount, sdk.Unbonding, validator, false); err != nil {
+		return err
+	}
+
+	entry.Balance = entry.Balance.Sub(amount)
+	entry.InitialBalance = entry.InitialBalance.Sub(amount)
+
+	if entry.Balance.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.RemoveUBDQueue(ctx, ubd, entry.CompletionTime)
+	} else {
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return nil
+}
+
 // begin unbonding / redelegation; create a redelegation record
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
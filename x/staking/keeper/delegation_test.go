@@ -1023,3 +1023,69 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestCancelUnbondingDelegation(t *testing.T) {
+	delTokens := sdk.TokensFromConsensusPower(20)
+	app, ctx, addrDels, addrVals := bootstrapDelegationTest(t, delTokens)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	unbondTokens := sdk.TokensFromConsensusPower(10)
+	completionTime, err := app.StakingKeeper.Undelegate(ctx, addrDels[1], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+	require.Len(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime), 1)
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondedBefore := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount
+	notBondedBefore := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount
+
+	// unknown entry
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], unbondTokens, 11)
+	require.Error(t, err)
+
+	// more than the entry balance
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], unbondTokens.AddRaw(1), 10)
+	require.Error(t, err)
+
+	// partial cancellation keeps the entry
+	cancelTokens := sdk.TokensFromConsensusPower(4)
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], cancelTokens, 10)
+	require.NoError(t, err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].Balance)
+	require.Equal(t, unbondTokens.Sub(cancelTokens), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.Sub(unbondTokens).Add(cancelTokens).ToDec(), delegation.Shares)
+
+	require.Equal(t, bondedBefore.Add(cancelTokens), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom).Amount)
+	require.Equal(t, notBondedBefore.Sub(cancelTokens), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom).Amount)
+	requireStakingInvariants(t, app, ctx)
+
+	// cancelling the rest removes the entry and its unbonding queue entry
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], unbondTokens.Sub(cancelTokens), 10)
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, delTokens.ToDec(), delegation.Shares)
+	requireStakingInvariants(t, app, ctx)
+
+	// matured entries cannot be cancelled
+	_, err = app.StakingKeeper.Undelegate(ctx, addrDels[1], addrVals[0], unbondTokens.ToDec())
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(completionTime)
+	err = app.StakingKeeper.CancelUnbondingDelegation(ctx, addrDels[1], addrVals[0], unbondTokens, 10)
+	require.Error(t, err)
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizeShareRecord gets the tokenize share record with the given id
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetTokenizeShareRecordKey(id))
+	if value == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &record)
+
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom gets the tokenize share record backing the
+// given share token denomination
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found || record.GetShareTokenDenom() != denom {
+		return types.TokenizeShareRecord{}, types.ErrNoTokenizeShareRecord
+	}
+
+	return record, nil
+}
+
+// SetTokenizeShareRecord sets a tokenize share record
+func (k Keeper) SetTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordKey(record.ID), k.cdc.MustMarshalBinaryBare(&record))
+}
+
+// DeleteTokenizeShareRecord removes the tokenize share record with the given id
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordKey(id))
+}
+
+// IterateTokenizeShareRecords iterates through all tokenize share records
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by
+// the given address
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		if record.Owner.Equals(owner) {
+			records = append(records, record)
+		}
+		return false
+	})
+
+	return records
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last created tokenize share record
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last created tokenize share record
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// TokenizeShares moves the shares backing amount tokens of a delegation to a
+// new tokenize share record and mints share tokens for them to the owner, who
+// also becomes the owner of the record and of its rewards. The shares remain
+// delegated to the validator, so the validator's tokens and delegator shares
+// are left untouched and slashes apply to them as usual.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Int, owner sdk.AccAddress,
+) (sdk.Coin, error) {
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	// the operator self-delegation backs the min self delegation requirement
+	if delAddr.Equals(valAddr) {
+		return sdk.Coin{}, sdkerrors.Wrap(types.ErrTokenizeSharesDisabled, "validator self-delegation")
+	}
+
+	// share tokens are freely transferable and would bypass the vesting schedule
+	if acc := k.authKeeper.GetAccount(ctx, delAddr); acc != nil {
+		if _, ok := acc.(vestexported.VestingAccount); ok {
+			return sdk.Coin{}, sdkerrors.Wrap(types.ErrTokenizeSharesDisabled, "vesting account")
+		}
+	}
+
+	// redelegation slashes are applied to the delegation at the destination
+	// validator, so the shares must stay with the delegator until it matures
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	shareTokens := shares.TruncateInt()
+	if !shareTokens.IsPositive() {
+		return sdk.Coin{}, types.ErrBadSharesAmount
+	}
+
+	id := k.GetLastTokenizeShareRecordID(ctx) + 1
+	record := types.NewTokenizeShareRecord(id, valAddr, owner)
+
+	if err := k.transferDelegationShares(ctx, delAddr, record.GetModuleAddress(), valAddr, shares); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	coin := sdk.NewCoin(record.GetShareTokenDenom(), shareTokens)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(coin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetTokenizeShareRecord(ctx, record)
+	k.SetLastTokenizeShareRecordID(ctx, id)
+
+	return coin, nil
+}
+
+// RedeemTokensForShares burns share tokens held by delAddr and moves the
+// shares they represent from the tokenize share record to a delegation of
+// delAddr. Once all share tokens of a record are redeemed, the record is
+// removed and the rewards left in the record module account are sent to the
+// owner of the record.
+func (k Keeper) RedeemTokensForShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin,
+) (types.TokenizeShareRecord, sdk.Dec, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if err != nil {
+		return record, sdk.Dec{}, err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return record, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	recordAddr := record.GetModuleAddress()
+
+	delegation, found := k.GetDelegation(ctx, recordAddr, record.ValidatorAddress)
+	if !found {
+		return record, sdk.Dec{}, types.ErrNoDelegation
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, coins); err != nil {
+		return record, sdk.Dec{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return record, sdk.Dec{}, err
+	}
+
+	// share tokens are minted for the truncated shares, so the fractional
+	// remainder goes to whoever redeems the last tokens of the record
+	shares := amount.Amount.ToDec()
+	if k.bankKeeper.GetSupply(ctx).GetTotal().AmountOf(amount.Denom).IsZero() || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	if err := k.transferDelegationShares(ctx, recordAddr, delAddr, record.ValidatorAddress, shares); err != nil {
+		return record, sdk.Dec{}, err
+	}
+
+	if _, found := k.GetDelegation(ctx, recordAddr, record.ValidatorAddress); !found {
+		// the rewards of the record delegation were withdrawn to the record
+		// module account when its last shares were transferred, they are paid
+		// out like module account rewards, regardless of the send enabled denoms
+		if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr); !rewards.IsZero() {
+			if err := k.bankKeeper.SendCoinsUnchecked(ctx, recordAddr, record.Owner, rewards); err != nil {
+				return record, sdk.Dec{}, err
+			}
+		}
+
+		k.DeleteTokenizeShareRecord(ctx, record.ID)
+	}
+
+	return record, shares, nil
+}
+
+// transferDelegationShares moves shares of a delegation to a validator from one
+// delegator to another. The validator is not modified.
+func (k Keeper) transferDelegationShares(
+	ctx sdk.Context, srcAddr, dstAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	srcDelegation, found := k.GetDelegation(ctx, srcAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	if srcDelegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, srcDelegation.Shares.String())
+	}
+
+	k.BeforeDelegationSharesModified(ctx, srcAddr, valAddr)
+
+	srcDelegation.Shares = srcDelegation.Shares.Sub(shares)
+	if srcDelegation.Shares.IsZero() {
+		k.RemoveDelegation(ctx, srcDelegation)
+	} else {
+		k.SetDelegation(ctx, srcDelegation)
+		k.AfterDelegationModified(ctx, srcAddr, valAddr)
+	}
+
+	dstDelegation, found := k.GetDelegation(ctx, dstAddr, valAddr)
+	if found {
+		k.BeforeDelegationSharesModified(ctx, dstAddr, valAddr)
+	} else {
+		dstDelegation = types.NewDelegation(dstAddr, valAddr, sdk.ZeroDec())
+		k.BeforeDelegationCreated(ctx, dstAddr, valAddr)
+	}
+
+	dstDelegation.Shares = dstDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, dstDelegation)
+	k.AfterDelegationModified(ctx, dstAddr, valAddr)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	delAmount := sdk.TokensFromConsensusPower(20)
+	app, ctx, addrs, valAddrs := bootstrapDelegationTest(t, delAmount)
+	requireStakingInvariants(t, app, ctx)
+
+	validatorBefore, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	tokenizeAmount := sdk.TokensFromConsensusPower(15)
+	shareTokens, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], tokenizeAmount, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, tokenizeAmount, shareTokens.Amount)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, record.GetShareTokenDenom(), shareTokens.Denom)
+	require.Equal(t, uint64(1), app.StakingKeeper.GetLastTokenizeShareRecordID(ctx))
+	require.Equal(t, shareTokens, app.BankKeeper.GetBalance(ctx, addrs[2], shareTokens.Denom))
+
+	// the shares moved from the delegator to the record without touching the validator
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delAmount.Sub(tokenizeAmount).ToDec(), delegation.Shares)
+
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.ToDec(), recordDelegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validatorBefore.Tokens, validator.Tokens)
+	require.Equal(t, validatorBefore.DelegatorShares, validator.DelegatorShares)
+	requireStakingInvariants(t, app, ctx)
+
+	// partial redemption keeps the record
+	redeemAmount := sdk.NewCoin(shareTokens.Denom, sdk.TokensFromConsensusPower(5))
+	_, shares, err := app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], redeemAmount)
+	require.NoError(t, err)
+	require.Equal(t, redeemAmount.Amount.ToDec(), shares)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.True(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, redeemAmount.Amount.ToDec(), delegation.Shares)
+	requireStakingInvariants(t, app, ctx)
+
+	// redeeming the remaining tokens removes the record
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], shareTokens.Sub(redeemAmount))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.ID)
+	require.False(t, found)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[0])
+	require.False(t, found)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, tokenizeAmount.ToDec(), delegation.Shares)
+	require.True(t, app.BankKeeper.GetBalance(ctx, addrs[2], shareTokens.Denom).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx).GetTotal().AmountOf(shareTokens.Denom).IsZero())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, validatorBefore.Tokens, validator.Tokens)
+	require.Equal(t, validatorBefore.DelegatorShares, validator.DelegatorShares)
+	requireStakingInvariants(t, app, ctx)
+}
+
+func TestTokenizeSharesFailures(t *testing.T) {
+	delAmount := sdk.TokensFromConsensusPower(20)
+	app, ctx, addrs, valAddrs := bootstrapDelegationTest(t, delAmount)
+
+	// self-delegations cannot be tokenized
+	_, err := app.StakingKeeper.TokenizeShares(ctx, addrs[0], valAddrs[0], sdk.TokensFromConsensusPower(1), addrs[2])
+	require.Error(t, err)
+
+	// unknown validator
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[1], sdk.TokensFromConsensusPower(1), addrs[2])
+	require.Error(t, err)
+
+	// more than delegated
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], delAmount.AddRaw(1), addrs[2])
+	require.Error(t, err)
+
+	// delegator without delegation
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[2], valAddrs[0], sdk.TokensFromConsensusPower(1), addrs[2])
+	require.Error(t, err)
+
+	// pending redelegation to the validator
+	red := types.NewRedelegation(addrs[1], valAddrs[1], valAddrs[0], 0, time.Unix(0, 0), sdk.NewInt(5), sdk.NewDec(5))
+	app.StakingKeeper.SetRedelegation(ctx, red)
+	_, err = app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], sdk.TokensFromConsensusPower(1), addrs[2])
+	require.Equal(t, types.ErrRedelegationInProgress, err)
+	app.StakingKeeper.RemoveRedelegation(ctx, red)
+
+	shareTokens, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], sdk.TokensFromConsensusPower(1), addrs[2])
+	require.NoError(t, err)
+
+	// redeeming more than the balance
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], shareTokens.Add(sdk.NewCoin(shareTokens.Denom, sdk.OneInt())))
+	require.Error(t, err)
+
+	// redeeming tokens of an unknown record
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], sdk.NewCoin(shareTokens.Denom+"0", sdk.OneInt()))
+	require.Error(t, err)
+
+	// redeeming tokens that are not share tokens
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+	require.Error(t, err)
+}
+
+func TestTokenizeSharesSlashed(t *testing.T) {
+	delAmount := sdk.TokensFromConsensusPower(20)
+	app, ctx, addrs, valAddrs := bootstrapDelegationTest(t, delAmount)
+
+	shareTokens, err := app.StakingKeeper.TokenizeShares(ctx, addrs[1], valAddrs[0], delAmount, addrs[2])
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetDelegation(ctx, addrs[1], valAddrs[0])
+	require.False(t, found)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	// slash the validator by half
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(), sdk.NewDecWithPrec(5, 1))
+	requireStakingInvariants(t, app, ctx)
+
+	// the redeemed delegation carries the slash
+	_, _, err = app.StakingKeeper.RedeemTokensForShares(ctx, addrs[2], shareTokens)
+	require.NoError(t, err)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[0])
+	require.True(t, found)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, delAmount.QuoRaw(2), validator.TokensFromShares(delegation.Shares).TruncateInt())
+	requireStakingInvariants(t, app, ctx)
+}
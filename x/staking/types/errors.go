@@ -57,4 +57,8 @@ var (
 	ErrInvalidHistoricalInfo           = sdkerrors.Register(ModuleName, 45, "invalid historical info")
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 46, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 47, "empty validator public key")
+	ErrTokenizeSharesDisabled          = sdkerrors.Register(ModuleName, 48, "shares of this delegation cannot be tokenized")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 49, "delegator is not allowed to tokenize shares while a redelegation to the validator is in progress")
+	ErrNotTokenizeShareDenom           = sdkerrors.Register(ModuleName, 50, "denomination is not a tokenized share")
+	ErrNoTokenizeShareRecord           = sdkerrors.Register(ModuleName, 51, "tokenize share record not found")
 )
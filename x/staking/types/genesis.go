@@ -17,6 +17,9 @@ type GenesisState struct {
 	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	Redelegations        []Redelegation        `json:"redelegations" yaml:"redelegations"`
 	Exported             bool                  `json:"exported" yaml:"exported"`
+
+	TokenizeShareRecords      []TokenizeShareRecord `json:"tokenize_share_records" yaml:"tokenize_share_records"`
+	LastTokenizeShareRecordID uint64                `json:"last_tokenize_share_record_id" yaml:"last_tokenize_share_record_id"`
 }
 
 // LastValidatorPower required for validator set update logic
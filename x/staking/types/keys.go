@@ -20,6 +20,10 @@ const (
 
 	// RouterKey is the msg router key for the staking module
 	RouterKey = ModuleName
+
+	// TokenizeShareModuleAccountPrefix is the prefix of the module accounts
+	// holding tokenized delegations
+	TokenizeShareModuleAccountPrefix = "tokenizeshare"
 )
 
 var (
@@ -44,6 +48,9 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordKey       = []byte{0x61} // prefix for the tokenize share records
+	LastTokenizeShareRecordIDKey = []byte{0x62} // key for the last tokenize share record id
 )
 
 // gets the key for the validator with address
@@ -293,3 +300,11 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+//________________________________________________________________________________
+
+// GetTokenizeShareRecordKey gets the key for the tokenize share record with the given id
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
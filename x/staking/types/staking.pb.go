@@ -372,6 +372,202 @@ func (m *MsgUndelegate) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// MsgCancelUnbondingDelegation defines an SDK message for delegating back to
+// the validator the tokens of an unbonding delegation entry.
+type MsgCancelUnbondingDelegation struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	// amount is always less than or equal to the unbonding delegation entry balance
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the height at which the unbonding delegation entry was created
+	CreationHeight int64 `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty" yaml:"creation_height"`
+}
+
+func (m *MsgCancelUnbondingDelegation) Reset()         { *m = MsgCancelUnbondingDelegation{} }
+func (m *MsgCancelUnbondingDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUnbondingDelegation) ProtoMessage()    {}
+func (*MsgCancelUnbondingDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{5}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUnbondingDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.Merge(m, src)
+}
+func (m *MsgCancelUnbondingDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUnbondingDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUnbondingDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelUnbondingDelegation proto.InternalMessageInfo
+
+func (m *MsgCancelUnbondingDelegation) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelUnbondingDelegation) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgCancelUnbondingDelegation) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCancelUnbondingDelegation) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+// MsgTokenizeShares defines an SDK message for converting a delegation into
+// transferable share tokens.
+type MsgTokenizeShares struct {
+	DelegatorAddress    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	ValidatorAddress    github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Amount              types.Coin                                    `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	TokenizedShareOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=tokenized_share_owner,json=tokenizedShareOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"tokenized_share_owner,omitempty" yaml:"tokenized_share_owner"`
+}
+
+func (m *MsgTokenizeShares) Reset()         { *m = MsgTokenizeShares{} }
+func (m *MsgTokenizeShares) String() string { return proto.CompactTextString(m) }
+func (*MsgTokenizeShares) ProtoMessage()    {}
+func (*MsgTokenizeShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{6}
+}
+func (m *MsgTokenizeShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTokenizeShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTokenizeShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTokenizeShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTokenizeShares.Merge(m, src)
+}
+func (m *MsgTokenizeShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTokenizeShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTokenizeShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTokenizeShares proto.InternalMessageInfo
+
+func (m *MsgTokenizeShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *MsgTokenizeShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgTokenizeShares) GetTokenizedShareOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.TokenizedShareOwner
+	}
+	return nil
+}
+
+// MsgRedeemTokensForShares defines an SDK message for redeeming share tokens
+// back into a delegation.
+type MsgRedeemTokensForShares struct {
+	DelegatorAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"delegator_address,omitempty" yaml:"delegator_address"`
+	Amount           types.Coin                                    `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgRedeemTokensForShares) Reset()         { *m = MsgRedeemTokensForShares{} }
+func (m *MsgRedeemTokensForShares) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemTokensForShares) ProtoMessage()    {}
+func (*MsgRedeemTokensForShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{7}
+}
+func (m *MsgRedeemTokensForShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemTokensForShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemTokensForShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemTokensForShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemTokensForShares.Merge(m, src)
+}
+func (m *MsgRedeemTokensForShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemTokensForShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemTokensForShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemTokensForShares proto.InternalMessageInfo
+
+func (m *MsgRedeemTokensForShares) GetDelegatorAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *MsgRedeemTokensForShares) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 // HistoricalInfo contains the historical information that gets stored at
 // each height.
 type HistoricalInfo struct {
@@ -383,7 +579,7 @@ func (m *HistoricalInfo) Reset()         { *m = HistoricalInfo{} }
 func (m *HistoricalInfo) String() string { return proto.CompactTextString(m) }
 func (*HistoricalInfo) ProtoMessage()    {}
 func (*HistoricalInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{5}
+	return fileDescriptor_146003fcdb99b683, []int{8}
 }
 func (m *HistoricalInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +633,7 @@ type CommissionRates struct {
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
 func (*CommissionRates) ProtoMessage() {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{6}
+	return fileDescriptor_146003fcdb99b683, []int{9}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,7 +671,7 @@ type Commission struct {
 func (m *Commission) Reset()      { *m = Commission{} }
 func (*Commission) ProtoMessage() {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{7}
+	return fileDescriptor_146003fcdb99b683, []int{10}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +719,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{8}
+	return fileDescriptor_146003fcdb99b683, []int{11}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +807,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{9}
+	return fileDescriptor_146003fcdb99b683, []int{12}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +847,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{10}
+	return fileDescriptor_146003fcdb99b683, []int{13}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +899,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{11}
+	return fileDescriptor_146003fcdb99b683, []int{14}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +948,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{12}
+	return fileDescriptor_146003fcdb99b683, []int{15}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +1007,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{13}
+	return fileDescriptor_146003fcdb99b683, []int{16}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +1055,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{14}
+	return fileDescriptor_146003fcdb99b683, []int{17}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1109,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{15}
+	return fileDescriptor_146003fcdb99b683, []int{18}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1170,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{16}
+	return fileDescriptor_146003fcdb99b683, []int{19}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1224,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{17}
+	return fileDescriptor_146003fcdb99b683, []int{20}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1279,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{18}
+	return fileDescriptor_146003fcdb99b683, []int{21}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1140,6 +1336,68 @@ func (m *Redelegation) GetEntries() []RedelegationEntry {
 	return nil
 }
 
+// TokenizeShareRecord links a share token denomination to the delegation that
+// backs it. The delegation is held by a module account derived from the
+// record id, and its rewards can be withdrawn by the owner of the record.
+type TokenizeShareRecord struct {
+	ID               uint64                                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ValidatorAddress github_com_cosmos_cosmos_sdk_types.ValAddress `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.ValAddress" json:"validator_address,omitempty" yaml:"validator_address"`
+	Owner            github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()      { *m = TokenizeShareRecord{} }
+func (*TokenizeShareRecord) ProtoMessage() {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_146003fcdb99b683, []int{22}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetValidatorAddress() github_com_cosmos_cosmos_sdk_types.ValAddress {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *TokenizeShareRecord) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
 // Params defines the parameters for the staking module.
 type Params struct {
 	UnbondingTime     time.Duration `protobuf:"bytes,1,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
@@ -1152,7 +1410,7 @@ type Params struct {
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_146003fcdb99b683, []int{19}
+	return fileDescriptor_146003fcdb99b683, []int{23}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,6 +1480,9 @@ func init() {
 	proto.RegisterType((*MsgDelegate)(nil), "cosmos.staking.MsgDelegate")
 	proto.RegisterType((*MsgBeginRedelegate)(nil), "cosmos.staking.MsgBeginRedelegate")
 	proto.RegisterType((*MsgUndelegate)(nil), "cosmos.staking.MsgUndelegate")
+	proto.RegisterType((*MsgCancelUnbondingDelegation)(nil), "cosmos.staking.MsgCancelUnbondingDelegation")
+	proto.RegisterType((*MsgTokenizeShares)(nil), "cosmos.staking.MsgTokenizeShares")
+	proto.RegisterType((*MsgRedeemTokensForShares)(nil), "cosmos.staking.MsgRedeemTokensForShares")
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.Commission")
@@ -1236,117 +1497,127 @@ func init() {
 	proto.RegisterType((*UnbondingDelegationEntry)(nil), "cosmos.staking.UnbondingDelegationEntry")
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.Redelegation")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.TokenizeShareRecord")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/staking.proto", fileDescriptor_146003fcdb99b683) }
 
 var fileDescriptor_146003fcdb99b683 = []byte{
-	// 1657 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x14, 0x25, 0x3d, 0x4a, 0xa4, 0xb4, 0xaa, 0x65, 0x4a, 0xb6, 0xb9, 0xf2, 0x1e,
-	0x0a, 0xa1, 0xa8, 0x29, 0xd4, 0x2d, 0x60, 0x40, 0x6d, 0x81, 0x8a, 0xa4, 0x04, 0x09, 0xb5, 0x00,
-	0x77, 0x65, 0xeb, 0xd0, 0x16, 0x20, 0x86, 0xbb, 0xa3, 0xd5, 0x56, 0xdc, 0x5d, 0x76, 0x67, 0x68,
-	0x4b, 0x45, 0xaf, 0x05, 0x8a, 0xa2, 0x45, 0x7d, 0xf4, 0xd1, 0xc8, 0x1f, 0x90, 0x1c, 0x93, 0xfc,
-	0x07, 0xce, 0xcd, 0xc8, 0x21, 0x08, 0x72, 0x60, 0x12, 0xfb, 0x90, 0x9c, 0x79, 0xc8, 0x21, 0xa7,
-	0x60, 0x3e, 0xf6, 0x43, 0x4b, 0x2a, 0xa2, 0x14, 0xc7, 0x31, 0x60, 0x5d, 0x6c, 0xce, 0xdb, 0xdf,
-	0x7b, 0x6f, 0xe6, 0x7d, 0xcf, 0x08, 0xae, 0x9b, 0x3e, 0x71, 0x7d, 0xb2, 0x4a, 0x28, 0x3a, 0x74,
-	0x3c, 0x3b, 0xfc, 0xbf, 0xda, 0x09, 0x7c, 0xea, 0xab, 0x45, 0xf1, 0xb5, 0x2a, 0xa9, 0x4b, 0x3f,
-	0xb3, 0x7d, 0xdb, 0xe7, 0x9f, 0x56, 0xd9, 0x2f, 0x81, 0x5a, 0xba, 0x49, 0xb1, 0x67, 0xe1, 0xc0,
-	0x75, 0x3c, 0xba, 0x8a, 0x5a, 0xa6, 0xb3, 0x4a, 0x8f, 0x3b, 0x98, 0x88, 0x7f, 0x25, 0x44, 0xb3,
-	0x7d, 0xdf, 0x6e, 0xe3, 0x55, 0xbe, 0x6a, 0x75, 0xf7, 0x57, 0xa9, 0xe3, 0x62, 0x42, 0x91, 0xdb,
-	0x91, 0x80, 0x4a, 0x1a, 0x60, 0x75, 0x03, 0x44, 0x1d, 0xdf, 0x93, 0xdf, 0xe7, 0xe5, 0x3e, 0xe5,
-	0x86, 0x38, 0x51, 0xef, 0xe5, 0x40, 0xdd, 0x21, 0x76, 0x3d, 0xc0, 0x88, 0xe2, 0x3d, 0xd4, 0x76,
-	0x2c, 0x44, 0xfd, 0x40, 0xad, 0x43, 0xc1, 0xc2, 0xc4, 0x0c, 0x9c, 0x0e, 0x13, 0x50, 0x56, 0x96,
-	0x95, 0x95, 0xc2, 0xed, 0x6b, 0xd5, 0x93, 0x67, 0xa9, 0x36, 0x62, 0x48, 0x2d, 0xf7, 0xac, 0xa7,
-	0x8d, 0x19, 0x49, 0x2e, 0x75, 0x03, 0xc0, 0xf4, 0x5d, 0xd7, 0x21, 0x84, 0xc9, 0xc8, 0x70, 0x19,
-	0x5a, 0x5a, 0x46, 0x3d, 0x42, 0x18, 0x88, 0x62, 0x22, 0xe5, 0x24, 0x18, 0xd5, 0x7f, 0xc2, 0xbc,
-	0xeb, 0x78, 0x4d, 0x82, 0xdb, 0xfb, 0x4d, 0x0b, 0xb7, 0xb1, 0xcd, 0x0f, 0x55, 0xce, 0x2e, 0x2b,
-	0x2b, 0x53, 0xb5, 0xbb, 0x0c, 0xfe, 0x59, 0x4f, 0xfb, 0xb9, 0xed, 0xd0, 0x83, 0x6e, 0xab, 0x6a,
-	0xfa, 0xee, 0xea, 0x89, 0x73, 0xde, 0x22, 0xd6, 0xa1, 0xb4, 0xe3, 0xb6, 0x47, 0xfb, 0x3d, 0x6d,
-	0xe9, 0x18, 0xb9, 0xed, 0x35, 0x7d, 0x88, 0x48, 0xdd, 0x98, 0x73, 0x1d, 0x6f, 0x17, 0xb7, 0xf7,
-	0x1b, 0x11, 0x4d, 0xfd, 0x07, 0xcc, 0x49, 0x84, 0x1f, 0x34, 0x91, 0x65, 0x05, 0x98, 0x90, 0x72,
-	0x6e, 0x59, 0x59, 0x99, 0xae, 0xed, 0xf4, 0x7b, 0x5a, 0x59, 0x48, 0x1b, 0x80, 0xe8, 0xdf, 0xf6,
-	0xb4, 0x5b, 0x23, 0xec, 0x69, 0xdd, 0x34, 0xd7, 0x05, 0x87, 0x31, 0x1b, 0x09, 0x91, 0x14, 0xa6,
-	0xfb, 0x61, 0xe8, 0x92, 0x48, 0xf7, 0x78, 0x5a, 0xf7, 0x00, 0x64, 0x54, 0xdd, 0x7b, 0xa8, 0x1d,
-	0xe9, 0x8e, 0x84, 0x84, 0xba, 0x17, 0x20, 0xdf, 0xe9, 0xb6, 0x0e, 0xf1, 0x71, 0x39, 0xcf, 0x0c,
-	0x6d, 0xc8, 0x95, 0xba, 0x02, 0xe3, 0x0f, 0x51, 0xbb, 0x8b, 0xcb, 0x13, 0xdc, 0x9f, 0xd3, 0xa1,
-	0x3f, 0xeb, 0xbe, 0x13, 0x06, 0x81, 0x00, 0xac, 0xe5, 0xbe, 0x7e, 0xaa, 0x29, 0xfa, 0x07, 0x59,
-	0x98, 0xdd, 0x21, 0xf6, 0x86, 0xe5, 0xd0, 0x57, 0x1c, 0x5e, 0x9d, 0x61, 0xd6, 0xc9, 0x70, 0xeb,
-	0xd4, 0xfb, 0x3d, 0xad, 0x28, 0xac, 0xf3, 0x2a, 0x6d, 0xe2, 0x42, 0x29, 0x8e, 0xcb, 0x66, 0x80,
-	0x28, 0x96, 0x51, 0xd8, 0x18, 0x31, 0x02, 0x1b, 0xd8, 0xec, 0xf7, 0xb4, 0x05, 0xb1, 0xb3, 0x94,
-	0x28, 0xdd, 0x28, 0x9a, 0x27, 0x72, 0x41, 0x3d, 0x1a, 0x1e, 0xf8, 0x39, 0xae, 0x72, 0xeb, 0x47,
-	0x0c, 0x7a, 0xe9, 0xba, 0xf7, 0x33, 0x50, 0xd8, 0x21, 0xb6, 0xa4, 0xe3, 0xe1, 0xa9, 0xa0, 0xfc,
-	0x84, 0xa9, 0x90, 0x79, 0x3d, 0xa9, 0xf0, 0x0b, 0xc8, 0x23, 0xd7, 0xef, 0x7a, 0x94, 0x7b, 0x7b,
-	0x78, 0xcc, 0x4b, 0x84, 0xb4, 0xdc, 0xc7, 0x59, 0x5e, 0x55, 0x6b, 0xd8, 0x76, 0x3c, 0x03, 0x5b,
-	0x6f, 0x82, 0x01, 0xff, 0xa5, 0xc0, 0x95, 0xd8, 0x3c, 0x24, 0x30, 0x53, 0x56, 0xfc, 0x53, 0xbf,
-	0xa7, 0x5d, 0x4f, 0x5b, 0x31, 0x01, 0xbb, 0x80, 0x25, 0xe7, 0x23, 0x41, 0xbb, 0x81, 0x39, 0x7c,
-	0x1f, 0x16, 0xa1, 0xd1, 0x3e, 0xb2, 0xa7, 0xef, 0x23, 0x01, 0xfb, 0x41, 0xfb, 0x68, 0x10, 0x3a,
-	0xe8, 0xd4, 0xdc, 0x88, 0x4e, 0xfd, 0x30, 0x03, 0x33, 0x3b, 0xc4, 0x7e, 0xe0, 0x59, 0x97, 0x09,
-	0x71, 0xde, 0x84, 0xf8, 0xaf, 0x02, 0xc5, 0x2d, 0x87, 0x50, 0x3f, 0x70, 0x4c, 0xd4, 0xde, 0xf6,
-	0xf6, 0x7d, 0xf5, 0xb7, 0x90, 0x3f, 0xc0, 0xc8, 0xc2, 0x81, 0x2c, 0xff, 0x37, 0xaa, 0xf1, 0x0c,
-	0x54, 0x65, 0x33, 0x50, 0x55, 0xec, 0x64, 0x8b, 0x83, 0x42, 0xa9, 0x82, 0x45, 0xbd, 0x03, 0xf9,
-	0x87, 0xa8, 0x4d, 0x30, 0x2d, 0x67, 0x96, 0xb3, 0x2b, 0x85, 0xdb, 0x8b, 0xe9, 0xde, 0x11, 0xf5,
-	0x9a, 0x90, 0x51, 0xc0, 0xe5, 0x76, 0xde, 0xcb, 0x40, 0x29, 0x35, 0x78, 0xa8, 0x35, 0xc8, 0xf1,
-	0x8a, 0xae, 0xf0, 0xf2, 0x5a, 0x3d, 0xc7, 0x5c, 0xd1, 0xc0, 0xa6, 0xc1, 0x79, 0xd5, 0xbf, 0xc2,
-	0xa4, 0x8b, 0x8e, 0x44, 0x67, 0xc8, 0x70, 0x39, 0xeb, 0xe7, 0x93, 0xd3, 0xef, 0x69, 0x25, 0x59,
-	0xaa, 0xa5, 0x1c, 0xdd, 0x98, 0x70, 0xd1, 0x11, 0xef, 0x07, 0x1d, 0x28, 0x31, 0xaa, 0x79, 0x80,
-	0x3c, 0x1b, 0x27, 0xdb, 0xcf, 0xd6, 0xb9, 0x95, 0x2c, 0xc4, 0x4a, 0x12, 0xe2, 0x74, 0x63, 0xc6,
-	0x45, 0x47, 0x75, 0x4e, 0x60, 0x1a, 0xd7, 0x26, 0x9f, 0x3c, 0xd5, 0xc6, 0xb8, 0xc5, 0x3e, 0x52,
-	0x00, 0x62, 0x8b, 0xa9, 0xf7, 0x61, 0x36, 0xd5, 0xbe, 0x88, 0x74, 0xe3, 0x99, 0x03, 0xde, 0x24,
-	0xdb, 0xec, 0xf3, 0x9e, 0xa6, 0x18, 0x25, 0x33, 0xe5, 0x82, 0xbf, 0x40, 0xa1, 0xdb, 0xb1, 0x10,
-	0xc5, 0x4d, 0x36, 0xdb, 0xca, 0x89, 0x71, 0xa9, 0x2a, 0xe6, 0xda, 0x6a, 0x38, 0xd7, 0x56, 0xef,
-	0x87, 0x83, 0x6f, 0xad, 0xc2, 0x64, 0xf5, 0x7b, 0x9a, 0x2a, 0x8e, 0x93, 0x60, 0xd6, 0x1f, 0x7f,
-	0xae, 0x29, 0x06, 0x08, 0x0a, 0x63, 0x38, 0x79, 0x96, 0x42, 0x62, 0xb6, 0x50, 0xcb, 0x30, 0xe1,
-	0xfa, 0x9e, 0x73, 0x28, 0x43, 0x71, 0xca, 0x08, 0x97, 0xea, 0x12, 0x4c, 0x3a, 0x16, 0xf6, 0xa8,
-	0x43, 0x8f, 0x85, 0x3f, 0x8d, 0x68, 0xcd, 0xb8, 0x1e, 0xe1, 0x16, 0x71, 0x42, 0x2f, 0x18, 0xe1,
-	0x52, 0xdd, 0x84, 0x59, 0x82, 0xcd, 0x6e, 0xe0, 0xd0, 0xe3, 0xa6, 0xe9, 0x7b, 0x14, 0x99, 0x54,
-	0x36, 0xed, 0x6b, 0xfd, 0x9e, 0x76, 0x55, 0xec, 0x35, 0x8d, 0xd0, 0x8d, 0x52, 0x48, 0xaa, 0x0b,
-	0x0a, 0xd3, 0x60, 0x61, 0x8a, 0x9c, 0xb6, 0x18, 0xfa, 0xa6, 0x8c, 0x70, 0x99, 0x38, 0xcb, 0xbb,
-	0x13, 0x30, 0x15, 0xcf, 0x55, 0x8f, 0x60, 0xd6, 0xef, 0xe0, 0x60, 0x48, 0x3d, 0xba, 0x1b, 0x6b,
-	0x4e, 0x23, 0x2e, 0x50, 0x12, 0x4a, 0xa1, 0x8c, 0xb0, 0x22, 0x6c, 0xb2, 0x78, 0xf0, 0x08, 0xf6,
-	0x48, 0x97, 0x34, 0xe5, 0xdc, 0x98, 0x49, 0x1f, 0x39, 0x8d, 0xd0, 0x59, 0x04, 0x48, 0xd2, 0x3d,
-	0x31, 0x5d, 0x2e, 0x40, 0xfe, 0x6f, 0xc8, 0x69, 0x63, 0x8b, 0xdb, 0x74, 0xd2, 0x90, 0x2b, 0x75,
-	0x1b, 0xf2, 0x84, 0x22, 0xda, 0x15, 0xa3, 0xf7, 0x78, 0xed, 0x57, 0x23, 0xee, 0xb9, 0xe6, 0x7b,
-	0xd6, 0x2e, 0x67, 0x34, 0xa4, 0x00, 0x75, 0x13, 0xf2, 0xd4, 0x3f, 0xc4, 0x9e, 0x34, 0xea, 0xb9,
-	0x32, 0x7d, 0xdb, 0xa3, 0x86, 0xe4, 0x56, 0x29, 0xc4, 0x45, 0xb9, 0x49, 0x0e, 0x50, 0x80, 0x89,
-	0x18, 0x95, 0x6b, 0xdb, 0xe7, 0x4e, 0xc7, 0xab, 0xe9, 0x4e, 0x21, 0xe4, 0xe9, 0x46, 0x29, 0x22,
-	0xed, 0x72, 0x4a, 0x7a, 0x72, 0x9e, 0xb8, 0xd0, 0xe4, 0xbc, 0x09, 0xb3, 0x5d, 0xaf, 0xe5, 0x7b,
-	0x96, 0xe3, 0xd9, 0xcd, 0x03, 0xec, 0xd8, 0x07, 0xb4, 0x3c, 0xb9, 0xac, 0xac, 0x64, 0x93, 0xde,
-	0x4a, 0x23, 0x74, 0xa3, 0x14, 0x91, 0xb6, 0x38, 0x45, 0xb5, 0xa0, 0x18, 0xa3, 0x78, 0xca, 0x4e,
-	0x9d, 0x99, 0xb2, 0x37, 0x65, 0xca, 0x5e, 0x49, 0x6b, 0x89, 0xb3, 0x76, 0x26, 0x22, 0x32, 0x36,
-	0xf5, 0x0f, 0x27, 0xae, 0x91, 0x20, 0x35, 0x9c, 0x5a, 0x65, 0x46, 0xbf, 0x41, 0x16, 0x5e, 0xcb,
-	0x0d, 0x72, 0x6d, 0xfa, 0xdf, 0x4f, 0xb5, 0xb1, 0x28, 0x61, 0xff, 0x93, 0x81, 0x7c, 0x63, 0xef,
-	0x1e, 0x72, 0x82, 0xb7, 0x75, 0x7c, 0x48, 0x54, 0xaf, 0xdf, 0xc3, 0x84, 0xb0, 0x05, 0x51, 0x6f,
-	0xc3, 0x78, 0x87, 0xfd, 0x28, 0x2b, 0xbc, 0xa1, 0x2f, 0x0c, 0x84, 0x34, 0xc7, 0x85, 0x37, 0x4c,
-	0x0e, 0xd5, 0xdf, 0xc9, 0x02, 0x34, 0xf6, 0xf6, 0xee, 0x07, 0x4e, 0xa7, 0x8d, 0xe9, 0xe5, 0x78,
-	0xfd, 0xe6, 0x8c, 0xd7, 0x09, 0x1f, 0xff, 0x11, 0x0a, 0xb1, 0x8f, 0x88, 0xfa, 0x3b, 0x98, 0xa4,
-	0xf2, 0xb7, 0x74, 0xf5, 0xd2, 0xa0, 0xab, 0x43, 0xb8, 0x74, 0x77, 0xc4, 0xa1, 0x7f, 0x92, 0x01,
-	0x38, 0xeb, 0x71, 0xe6, 0x2d, 0x18, 0xc0, 0x37, 0x21, 0x2f, 0x3b, 0x4e, 0xf6, 0x42, 0xd3, 0xaa,
-	0xe4, 0x4e, 0x78, 0xe9, 0xcb, 0x0c, 0xcc, 0x3f, 0x08, 0xcb, 0xee, 0xa5, 0x85, 0xd5, 0x2d, 0x98,
-	0xc0, 0x1e, 0x0d, 0x1c, 0x6e, 0x62, 0x16, 0xa5, 0x2b, 0xe9, 0x28, 0x1d, 0x62, 0xad, 0x0d, 0x8f,
-	0x06, 0xc7, 0x32, 0x66, 0x43, 0xf6, 0x84, 0x8d, 0xff, 0x9f, 0x85, 0xf2, 0x69, 0x5c, 0x6a, 0x1d,
-	0x4a, 0x66, 0x80, 0x39, 0x21, 0x6c, 0xc9, 0x0a, 0x6f, 0xc9, 0x4b, 0x89, 0x17, 0xa3, 0x93, 0x00,
-	0xdd, 0x28, 0x86, 0x14, 0xd9, 0x90, 0x6d, 0xfe, 0x40, 0xc5, 0x52, 0x85, 0xa1, 0x46, 0x1c, 0xa2,
-	0x75, 0xd9, 0x91, 0xe3, 0x67, 0xa9, 0xa4, 0x00, 0xd1, 0x92, 0x8b, 0x31, 0x95, 0xf7, 0xe4, 0xbf,
-	0x43, 0xc9, 0xf1, 0x1c, 0xea, 0xa0, 0x76, 0xb3, 0x85, 0xda, 0xc8, 0x33, 0x2f, 0x72, 0x15, 0x11,
-	0xdd, 0x54, 0xaa, 0x4d, 0x89, 0xd3, 0x8d, 0xa2, 0xa4, 0xd4, 0x04, 0x81, 0x79, 0x24, 0x54, 0x95,
-	0xbb, 0xd0, 0xe0, 0x16, 0xb2, 0x27, 0x3c, 0xf2, 0xbf, 0x2c, 0xcc, 0x45, 0xef, 0x33, 0x97, 0xae,
-	0x18, 0xd5, 0x15, 0x3b, 0x00, 0xa2, 0x80, 0xb0, 0xce, 0x71, 0x01, 0x6f, 0xb0, 0x12, 0x34, 0x25,
-	0x24, 0x34, 0x08, 0x4d, 0xf8, 0xe3, 0xab, 0x2c, 0x4c, 0x27, 0xfd, 0x71, 0xd9, 0xd2, 0xdf, 0xa0,
-	0x17, 0xb3, 0xf5, 0xb8, 0x24, 0xe6, 0x78, 0x49, 0xbc, 0x99, 0x2e, 0x89, 0x03, 0xa9, 0x74, 0x7a,
-	0x2d, 0xfc, 0x26, 0x03, 0xf9, 0x7b, 0x28, 0x40, 0x2e, 0x51, 0xcd, 0x81, 0x5b, 0x84, 0x78, 0x49,
-	0x58, 0x1c, 0x48, 0x94, 0x86, 0xfc, 0x83, 0xd6, 0x19, 0x97, 0x88, 0x27, 0x43, 0x2f, 0x11, 0x45,
-	0x17, 0x1d, 0x35, 0xa3, 0x73, 0x09, 0x27, 0xce, 0xd4, 0x16, 0x63, 0x29, 0x27, 0xbf, 0x8b, 0xb7,
-	0x90, 0xe8, 0x6a, 0x4d, 0xd4, 0x3b, 0x50, 0x60, 0x88, 0xb8, 0x2b, 0x30, 0xf6, 0x85, 0xf8, 0xf1,
-	0x21, 0xf1, 0x51, 0x37, 0xc0, 0x45, 0x47, 0x1b, 0x62, 0xa1, 0xde, 0x05, 0xf5, 0x20, 0x7a, 0xfa,
-	0x6a, 0xc6, 0x26, 0x64, 0xfc, 0x37, 0xfa, 0x3d, 0x6d, 0x51, 0xf0, 0x0f, 0x62, 0x74, 0x63, 0x2e,
-	0x26, 0x86, 0xd2, 0x7e, 0x03, 0xc0, 0xce, 0xd5, 0xb4, 0xb0, 0xe7, 0xbb, 0xf2, 0x0a, 0x7b, 0xa5,
-	0xdf, 0xd3, 0xe6, 0x84, 0x94, 0xf8, 0x9b, 0x6e, 0x4c, 0xb1, 0x45, 0x83, 0xfd, 0x8e, 0x0d, 0x5f,
-	0xdb, 0x7c, 0xf6, 0xa2, 0xa2, 0x3c, 0x7f, 0x51, 0x51, 0xbe, 0x78, 0x51, 0x51, 0x1e, 0xbf, 0xac,
-	0x8c, 0x3d, 0x7f, 0x59, 0x19, 0xfb, 0xf4, 0x65, 0x65, 0xec, 0xcf, 0xbf, 0xfc, 0xde, 0x18, 0x39,
-	0x8a, 0xfe, 0xbe, 0xc9, 0xa3, 0xa5, 0x95, 0xe7, 0x5e, 0xf9, 0xf5, 0x77, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x28, 0x97, 0x39, 0x40, 0xfe, 0x1c, 0x00, 0x00,
+	// 1800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xf7, 0x8c, 0xc7, 0xf6, 0x9b, 0xc4, 0x63, 0xb7, 0x89, 0x77, 0xe2, 0xcd, 0xba, 0x93,
+	0x3e, 0x20, 0x0b, 0xb1, 0x63, 0x6d, 0x40, 0x5a, 0x29, 0x80, 0x44, 0xc6, 0x13, 0x63, 0x8b, 0x58,
+	0x84, 0x4e, 0xd6, 0x07, 0x40, 0x1a, 0x95, 0xbb, 0x2b, 0xed, 0xc2, 0xfd, 0x31, 0x74, 0xd5, 0x24,
+	0xf6, 0x8a, 0x2b, 0x12, 0x42, 0xac, 0xd8, 0xe3, 0x1e, 0x23, 0xfe, 0x00, 0x38, 0x02, 0x7f, 0x00,
+	0x52, 0xb8, 0x45, 0x1c, 0x10, 0xe2, 0xd0, 0x40, 0x72, 0x80, 0xf3, 0x1c, 0x38, 0x20, 0x0e, 0xa8,
+	0x3e, 0xfa, 0xc3, 0x3d, 0x63, 0x32, 0x33, 0x2c, 0x1b, 0x4b, 0xcc, 0x25, 0x99, 0x7a, 0xfd, 0x3e,
+	0xaa, 0xde, 0xef, 0xd5, 0xab, 0xf7, 0x5e, 0x02, 0x37, 0x9c, 0x88, 0x06, 0x11, 0xdd, 0xa6, 0x0c,
+	0x9d, 0x90, 0xd0, 0x4b, 0xff, 0x6e, 0xf5, 0xe2, 0x88, 0x45, 0xc6, 0xb2, 0xfc, 0xda, 0x52, 0xd4,
+	0x8d, 0xcf, 0x79, 0x91, 0x17, 0x89, 0x4f, 0xdb, 0xfc, 0x97, 0xe4, 0xda, 0xb8, 0xc5, 0x70, 0xe8,
+	0xe2, 0x38, 0x20, 0x21, 0xdb, 0x46, 0x47, 0x0e, 0xd9, 0x66, 0x67, 0x3d, 0x4c, 0xe5, 0x9f, 0x8a,
+	0xc5, 0xf4, 0xa2, 0xc8, 0xf3, 0xf1, 0xb6, 0x58, 0x1d, 0xf5, 0x1f, 0x6f, 0x33, 0x12, 0x60, 0xca,
+	0x50, 0xd0, 0x53, 0x0c, 0x9b, 0x65, 0x06, 0xb7, 0x1f, 0x23, 0x46, 0xa2, 0x50, 0x7d, 0x5f, 0x53,
+	0xfb, 0x54, 0x1b, 0x12, 0x44, 0x2b, 0xa9, 0x82, 0x71, 0x40, 0xbd, 0x9d, 0x18, 0x23, 0x86, 0x0f,
+	0x91, 0x4f, 0x5c, 0xc4, 0xa2, 0xd8, 0xd8, 0x81, 0xba, 0x8b, 0xa9, 0x13, 0x93, 0x1e, 0x57, 0xd0,
+	0xd4, 0x6e, 0x6a, 0x5b, 0xf5, 0xdb, 0x6f, 0xb7, 0xce, 0x9f, 0xa5, 0xd5, 0xc9, 0x59, 0xda, 0xd5,
+	0xe7, 0x89, 0x39, 0x67, 0x17, 0xa5, 0x8c, 0x7b, 0x00, 0x4e, 0x14, 0x04, 0x84, 0x52, 0xae, 0x43,
+	0x17, 0x3a, 0xcc, 0xb2, 0x8e, 0x9d, 0x8c, 0xc3, 0x46, 0x0c, 0x53, 0xa5, 0xa7, 0x20, 0x68, 0xfc,
+	0x10, 0xd6, 0x02, 0x12, 0x76, 0x29, 0xf6, 0x1f, 0x77, 0x5d, 0xec, 0x63, 0x4f, 0x1c, 0xaa, 0x59,
+	0xb9, 0xa9, 0x6d, 0x2d, 0xb5, 0xef, 0x73, 0xf6, 0x3f, 0x25, 0xe6, 0xe7, 0x3d, 0xc2, 0x8e, 0xfb,
+	0x47, 0x2d, 0x27, 0x0a, 0xb6, 0xcf, 0x9d, 0xf3, 0x5d, 0xea, 0x9e, 0x28, 0x3f, 0xee, 0x87, 0x6c,
+	0x90, 0x98, 0x1b, 0x67, 0x28, 0xf0, 0xef, 0x58, 0x23, 0x54, 0x5a, 0xf6, 0x6a, 0x40, 0xc2, 0x87,
+	0xd8, 0x7f, 0xdc, 0xc9, 0x68, 0xc6, 0x87, 0xb0, 0xaa, 0x38, 0xa2, 0xb8, 0x8b, 0x5c, 0x37, 0xc6,
+	0x94, 0x36, 0xab, 0x37, 0xb5, 0xad, 0x2b, 0xed, 0x83, 0x41, 0x62, 0x36, 0xa5, 0xb6, 0x21, 0x16,
+	0xeb, 0x9f, 0x89, 0xf9, 0xee, 0x18, 0x7b, 0xba, 0xeb, 0x38, 0x77, 0xa5, 0x84, 0xbd, 0x92, 0x29,
+	0x51, 0x14, 0x6e, 0xfb, 0x49, 0x0a, 0x49, 0x66, 0x7b, 0xbe, 0x6c, 0x7b, 0x88, 0x65, 0x5c, 0xdb,
+	0x87, 0xc8, 0xcf, 0x6c, 0x67, 0x4a, 0x52, 0xdb, 0xeb, 0x50, 0xeb, 0xf5, 0x8f, 0x4e, 0xf0, 0x59,
+	0xb3, 0xc6, 0x1d, 0x6d, 0xab, 0x95, 0xb1, 0x05, 0xf3, 0x4f, 0x90, 0xdf, 0xc7, 0xcd, 0x05, 0x81,
+	0xe7, 0x95, 0x14, 0xcf, 0x9d, 0x88, 0xa4, 0x41, 0x20, 0x19, 0xee, 0x54, 0xff, 0xfe, 0xcc, 0xd4,
+	0xac, 0x5f, 0x57, 0x60, 0xe5, 0x80, 0x7a, 0xf7, 0x5c, 0xc2, 0x3e, 0xe5, 0xf0, 0xea, 0x8d, 0xf2,
+	0x8e, 0x2e, 0xbc, 0xb3, 0x33, 0x48, 0xcc, 0x65, 0xe9, 0x9d, 0x4f, 0xd3, 0x27, 0x01, 0x34, 0xf2,
+	0xb8, 0xec, 0xc6, 0x88, 0x61, 0x15, 0x85, 0x9d, 0x31, 0x23, 0xb0, 0x83, 0x9d, 0x41, 0x62, 0xae,
+	0xcb, 0x9d, 0x95, 0x54, 0x59, 0xf6, 0xb2, 0x73, 0xee, 0x2e, 0x18, 0xa7, 0xa3, 0x03, 0xbf, 0x2a,
+	0x4c, 0xee, 0xfd, 0x0f, 0x83, 0x5e, 0x41, 0xf7, 0x2b, 0x1d, 0xea, 0x07, 0xd4, 0x53, 0x74, 0x3c,
+	0xfa, 0x2a, 0x68, 0x6f, 0xf0, 0x2a, 0xe8, 0x9f, 0xcd, 0x55, 0xf8, 0x02, 0xd4, 0x50, 0x10, 0xf5,
+	0x43, 0xd6, 0xac, 0x5c, 0x18, 0xf3, 0x8a, 0x43, 0x79, 0xee, 0xf7, 0x15, 0x91, 0x55, 0xdb, 0xd8,
+	0x23, 0xa1, 0x8d, 0xdd, 0xcb, 0xe0, 0xc0, 0x1f, 0x69, 0x70, 0x2d, 0x77, 0x0f, 0x8d, 0x9d, 0x92,
+	0x17, 0xbf, 0x3d, 0x48, 0xcc, 0x1b, 0x65, 0x2f, 0x16, 0xd8, 0xa6, 0xf0, 0xe4, 0x5a, 0xa6, 0xe8,
+	0x61, 0xec, 0x8c, 0xde, 0x87, 0x4b, 0x59, 0xb6, 0x8f, 0xca, 0xc5, 0xfb, 0x28, 0xb0, 0xfd, 0x57,
+	0xfb, 0xe8, 0x50, 0x36, 0x0c, 0x6a, 0x75, 0x4c, 0x50, 0x7f, 0xa3, 0xc3, 0xd5, 0x03, 0xea, 0x7d,
+	0x10, 0xba, 0xb3, 0x0b, 0x31, 0xe9, 0x85, 0xf8, 0xa8, 0x02, 0x37, 0x78, 0x99, 0x81, 0x42, 0x07,
+	0xfb, 0x1f, 0x84, 0x47, 0x51, 0xe8, 0x92, 0xd0, 0x7b, 0xdd, 0x33, 0x3b, 0x73, 0x65, 0xd1, 0x95,
+	0xc6, 0x0e, 0x34, 0x9c, 0x18, 0x0b, 0x7f, 0x75, 0x8f, 0x31, 0xf1, 0x8e, 0x65, 0xec, 0x56, 0xda,
+	0x1b, 0x85, 0x47, 0xe5, 0x3c, 0x03, 0x7f, 0x54, 0x14, 0x65, 0x4f, 0x10, 0x14, 0x1e, 0xbf, 0xad,
+	0xc0, 0xea, 0x01, 0xf5, 0x1e, 0x45, 0x27, 0x38, 0x24, 0x1f, 0xe2, 0x87, 0xc7, 0x28, 0xc6, 0x74,
+	0x06, 0xc2, 0x18, 0x20, 0xf0, 0xfc, 0xc5, 0x94, 0xdb, 0xdc, 0x2e, 0xe5, 0x8e, 0xeb, 0x46, 0x4f,
+	0x43, 0x1c, 0x37, 0xab, 0xe5, 0xfc, 0x35, 0x92, 0x6d, 0x0a, 0x67, 0xad, 0x65, 0x8a, 0x04, 0x4e,
+	0xdf, 0xe2, 0x6a, 0x14, 0x8e, 0xcf, 0x35, 0x68, 0x1e, 0x50, 0x8f, 0xbf, 0x31, 0x38, 0x10, 0x68,
+	0xd2, 0xdd, 0x28, 0xbe, 0x04, 0x70, 0xe6, 0x2e, 0xd5, 0xc7, 0x4c, 0x11, 0x3f, 0xd5, 0x60, 0x79,
+	0x8f, 0x50, 0x16, 0xc5, 0xc4, 0x41, 0xfe, 0x7e, 0xf8, 0x38, 0x32, 0xbe, 0x02, 0xb5, 0x63, 0x8c,
+	0x5c, 0x1c, 0xab, 0x0a, 0xf1, 0x9d, 0x56, 0xde, 0x26, 0xb5, 0x78, 0x9b, 0xd4, 0x92, 0x3b, 0xd9,
+	0x13, 0x4c, 0xa9, 0x56, 0x29, 0x62, 0xbc, 0x0f, 0xb5, 0x27, 0xc8, 0xa7, 0x98, 0xef, 0xa0, 0xb2,
+	0x55, 0xbf, 0x7d, 0xbd, 0x5c, 0x5e, 0x66, 0xe5, 0x68, 0x2a, 0x28, 0xd9, 0xd5, 0x76, 0x7e, 0xa9,
+	0x43, 0xa3, 0xd4, 0x9b, 0x18, 0x6d, 0xa8, 0x8a, 0xa2, 0x4f, 0x13, 0x15, 0x58, 0x6b, 0x82, 0xd6,
+	0xa3, 0x83, 0x1d, 0x5b, 0xc8, 0x1a, 0xdf, 0x83, 0xc5, 0x00, 0x9d, 0xca, 0xe2, 0x51, 0x17, 0x7a,
+	0xee, 0x4e, 0xa6, 0x67, 0x90, 0x98, 0x0d, 0x55, 0xcd, 0x29, 0x3d, 0x96, 0xbd, 0x10, 0xa0, 0x53,
+	0x51, 0x32, 0xf6, 0xa0, 0xc1, 0xa9, 0xce, 0x31, 0x0a, 0x3d, 0x5c, 0xac, 0x50, 0xf7, 0x26, 0x36,
+	0xb2, 0x9e, 0x1b, 0x29, 0xa8, 0xb3, 0xec, 0xab, 0x01, 0x3a, 0xdd, 0x11, 0x04, 0x6e, 0xf1, 0xce,
+	0xe2, 0x27, 0xcf, 0xcc, 0x39, 0xe1, 0xb1, 0xdf, 0x69, 0x00, 0xb9, 0xc7, 0x8c, 0x47, 0xb0, 0x52,
+	0xaa, 0x70, 0x69, 0x53, 0x1b, 0xaf, 0x07, 0x5c, 0xe4, 0x9b, 0x7d, 0x91, 0x98, 0x9a, 0xdd, 0x70,
+	0x4a, 0x10, 0x7c, 0x17, 0xea, 0xfd, 0x9e, 0x8b, 0x18, 0xee, 0xf2, 0xf6, 0x57, 0x05, 0xd7, 0x46,
+	0x4b, 0xb6, 0xbe, 0xad, 0xb4, 0xf5, 0x6d, 0x3d, 0x4a, 0x7b, 0xe3, 0xf6, 0x26, 0xd7, 0x35, 0x48,
+	0x4c, 0x43, 0x1e, 0xa7, 0x20, 0x6c, 0x7d, 0xfc, 0x67, 0x53, 0xb3, 0x41, 0x52, 0xb8, 0xc0, 0xf9,
+	0xb3, 0xd4, 0x0b, 0xed, 0x87, 0xd1, 0x84, 0x85, 0x20, 0x0a, 0xc9, 0x89, 0x0a, 0xc5, 0x25, 0x3b,
+	0x5d, 0x1a, 0x1b, 0xb0, 0x48, 0x5c, 0x1c, 0x32, 0xc2, 0xce, 0x24, 0x9e, 0x76, 0xb6, 0xe6, 0x52,
+	0x4f, 0xf1, 0x11, 0x25, 0x29, 0x0a, 0x76, 0xba, 0x34, 0x76, 0x61, 0x85, 0x62, 0xa7, 0x1f, 0x13,
+	0x76, 0xd6, 0x75, 0xa2, 0x90, 0x21, 0x87, 0xa9, 0xba, 0xfe, 0xed, 0x41, 0x62, 0xbe, 0x25, 0xf7,
+	0x5a, 0xe6, 0xb0, 0xec, 0x46, 0x4a, 0xda, 0x91, 0x14, 0x6e, 0xc1, 0xc5, 0x0c, 0x11, 0x5f, 0xf6,
+	0x85, 0x4b, 0x76, 0xba, 0x2c, 0x9c, 0xe5, 0x17, 0x0b, 0xb0, 0x94, 0xb7, 0x5e, 0x4f, 0x61, 0x25,
+	0xea, 0xe1, 0x78, 0x44, 0x4e, 0xb8, 0x9f, 0x5b, 0x2e, 0x73, 0x4c, 0x91, 0x65, 0x1b, 0xa9, 0x8e,
+	0x34, 0x23, 0xec, 0xf2, 0x78, 0x08, 0x29, 0x0e, 0x69, 0x9f, 0x76, 0x55, 0x6b, 0xa9, 0x97, 0x8f,
+	0x5c, 0xe6, 0xb0, 0xec, 0x46, 0x46, 0x7a, 0x20, 0x28, 0xbc, 0x31, 0xfd, 0x3e, 0x22, 0x3e, 0x76,
+	0x85, 0x4f, 0x17, 0x6d, 0xb5, 0x32, 0xf6, 0xa1, 0x46, 0x19, 0x62, 0x7d, 0xd9, 0x9d, 0xcf, 0xb7,
+	0xdf, 0x1b, 0x73, 0xcf, 0xed, 0x28, 0x74, 0x1f, 0x0a, 0x41, 0x5b, 0x29, 0x30, 0x76, 0xa1, 0x26,
+	0x52, 0xae, 0x72, 0xea, 0x44, 0x37, 0x7d, 0x3f, 0x64, 0xb6, 0x92, 0x36, 0x18, 0xe4, 0x89, 0x51,
+	0xbe, 0x01, 0x54, 0x76, 0xd3, 0xed, 0xfd, 0x89, 0xaf, 0xe3, 0x5b, 0xe5, 0x6c, 0x2d, 0xf5, 0x59,
+	0x76, 0x23, 0x23, 0xa9, 0xb4, 0x5f, 0x6a, 0xae, 0x17, 0xa6, 0x6a, 0xae, 0x77, 0x61, 0xa5, 0x9f,
+	0x96, 0x69, 0x69, 0xb1, 0xb1, 0x28, 0x8a, 0x8d, 0x02, 0x5a, 0x65, 0x0e, 0xcb, 0x6e, 0x64, 0x24,
+	0x59, 0x6e, 0x18, 0x2e, 0x2c, 0xe7, 0x5c, 0xe2, 0xca, 0x2e, 0xbd, 0xf6, 0xca, 0xde, 0x52, 0x57,
+	0xf6, 0x5a, 0xd9, 0x4a, 0x7e, 0x6b, 0xaf, 0x66, 0x44, 0x2e, 0x66, 0x7c, 0xfd, 0xdc, 0xa4, 0x09,
+	0x94, 0x85, 0x0b, 0xb3, 0xcc, 0xf8, 0x43, 0xa6, 0xfa, 0x67, 0x32, 0x64, 0xba, 0x73, 0xe5, 0xc7,
+	0xcf, 0xcc, 0xb9, 0xec, 0xc2, 0xfe, 0x44, 0x87, 0x5a, 0xe7, 0xf0, 0x01, 0x22, 0xf1, 0xff, 0x6b,
+	0x45, 0x56, 0xc8, 0x5e, 0x5f, 0x83, 0x05, 0xe9, 0x0b, 0x6a, 0xdc, 0x86, 0xf9, 0x1e, 0xff, 0xd1,
+	0xd4, 0xc4, 0x83, 0xbe, 0x3e, 0x14, 0xd2, 0x82, 0x2f, 0x1d, 0x42, 0x09, 0x56, 0xeb, 0xe7, 0x15,
+	0x80, 0xce, 0xe1, 0xe1, 0xa3, 0x98, 0xf4, 0x7c, 0xcc, 0x66, 0x1d, 0xf8, 0xe5, 0xe9, 0xc0, 0x0b,
+	0x18, 0x7f, 0x13, 0xea, 0x39, 0x46, 0xd4, 0xf8, 0x2a, 0x2c, 0x32, 0xf5, 0x5b, 0x41, 0xbd, 0x31,
+	0x0c, 0x75, 0xca, 0xae, 0xe0, 0xce, 0x24, 0xac, 0x3f, 0xe8, 0x00, 0xb3, 0xc6, 0x92, 0xbf, 0x61,
+	0xea, 0xc5, 0xa9, 0x4c, 0x55, 0xad, 0x2a, 0xe9, 0x02, 0x4a, 0x7f, 0xd5, 0x61, 0x6d, 0xd6, 0xba,
+	0xe7, 0xb6, 0xf7, 0x60, 0x01, 0x87, 0x2c, 0x26, 0xc2, 0xc5, 0x3c, 0x4a, 0xb7, 0xca, 0x51, 0x3a,
+	0xc2, 0x5b, 0xf7, 0x42, 0x16, 0x9f, 0xa9, 0x98, 0x4d, 0xc5, 0x0b, 0x3e, 0xfe, 0x59, 0x05, 0x9a,
+	0x17, 0x49, 0x8d, 0xea, 0xff, 0xb5, 0x49, 0xfb, 0x7f, 0xc3, 0x13, 0x33, 0x6c, 0x7e, 0x55, 0x38,
+	0xd7, 0x98, 0x45, 0xb4, 0xa5, 0x5e, 0xe4, 0x7c, 0x72, 0x5d, 0x54, 0x20, 0x9f, 0xe4, 0xe5, 0x9c,
+	0x2a, 0xde, 0xe4, 0x1f, 0x40, 0x83, 0x84, 0x84, 0x11, 0xe4, 0x77, 0x8f, 0x90, 0x8f, 0x42, 0x67,
+	0x9a, 0x56, 0x44, 0xbe, 0xa6, 0xca, 0x6c, 0x49, 0x9d, 0x65, 0x2f, 0x2b, 0x4a, 0x5b, 0x12, 0x38,
+	0x22, 0xa9, 0xa9, 0xea, 0x54, 0x85, 0x5b, 0x2a, 0x5e, 0x40, 0xe4, 0xa3, 0x0a, 0xac, 0x66, 0x23,
+	0xdc, 0x19, 0x14, 0xe3, 0x42, 0x71, 0x00, 0x20, 0x13, 0x08, 0x7f, 0x39, 0x9a, 0xd5, 0xa9, 0x52,
+	0xd0, 0x92, 0xd4, 0xd0, 0xa1, 0xac, 0x80, 0xc7, 0xdf, 0x2a, 0x70, 0xa5, 0x88, 0xc7, 0xec, 0x49,
+	0xbf, 0x44, 0x43, 0xf5, 0xbb, 0x79, 0x4a, 0xac, 0x8a, 0x94, 0x78, 0xab, 0x9c, 0x12, 0x87, 0xae,
+	0xd2, 0xc5, 0xb9, 0xf0, 0x5f, 0x1a, 0xac, 0x9d, 0x1b, 0x50, 0xda, 0xd8, 0x89, 0x62, 0xd7, 0x58,
+	0x07, 0x9d, 0xb8, 0x02, 0xe1, 0x6a, 0xbb, 0xf6, 0x32, 0x31, 0xf5, 0xfd, 0x8e, 0xad, 0x13, 0xf7,
+	0x8d, 0xbe, 0x05, 0xdf, 0x80, 0x79, 0x39, 0x04, 0x94, 0xfe, 0x7e, 0x6f, 0xf2, 0xe0, 0x92, 0xf2,
+	0x85, 0xe3, 0xff, 0x43, 0x87, 0xda, 0x03, 0x14, 0xa3, 0x80, 0x1a, 0xce, 0x50, 0x13, 0x25, 0x07,
+	0x29, 0xd7, 0x87, 0xf2, 0x44, 0x47, 0xfd, 0x93, 0xff, 0x6b, 0x7a, 0xa8, 0x4f, 0x46, 0xf6, 0x50,
+	0xcb, 0x7c, 0xd6, 0x93, 0x1d, 0x4d, 0xfa, 0xee, 0x6a, 0xfb, 0x7a, 0xae, 0xe5, 0xfc, 0x77, 0x39,
+	0x0a, 0xca, 0x26, 0x0b, 0xd4, 0x78, 0x1f, 0xea, 0x9c, 0x23, 0x7f, 0x14, 0xb9, 0xf8, 0x7a, 0x3e,
+	0x7b, 0x29, 0x7c, 0xb4, 0x6c, 0x08, 0xd0, 0xe9, 0x3d, 0xb9, 0x30, 0xee, 0x83, 0x71, 0x9c, 0x4d,
+	0xfe, 0xba, 0x79, 0x04, 0x71, 0xf9, 0x77, 0x06, 0x89, 0x79, 0x5d, 0xca, 0x0f, 0xf3, 0x58, 0xf6,
+	0x6a, 0x4e, 0x4c, 0xb5, 0x7d, 0x19, 0x80, 0x9f, 0xab, 0xeb, 0xe2, 0x30, 0x0a, 0x54, 0x07, 0x7f,
+	0x6d, 0x90, 0x98, 0xab, 0x52, 0x4b, 0xfe, 0xcd, 0xb2, 0x97, 0xf8, 0xa2, 0xc3, 0x7f, 0xe7, 0x8e,
+	0x6f, 0xef, 0x3e, 0x7f, 0xb9, 0xa9, 0xbd, 0x78, 0xb9, 0xa9, 0xfd, 0xe5, 0xe5, 0xa6, 0xf6, 0xf1,
+	0xab, 0xcd, 0xb9, 0x17, 0xaf, 0x36, 0xe7, 0xfe, 0xf8, 0x6a, 0x73, 0xee, 0x3b, 0x5f, 0xfc, 0x8f,
+	0x90, 0x9e, 0x66, 0xff, 0x03, 0x44, 0x80, 0x7b, 0x54, 0x13, 0xa8, 0x7c, 0xe9, 0xdf, 0x03, 0x00,
+	0xd4, 0x4f, 0x66, 0x5c, 0x20, 0x22, 0x00, 0x00,
 }
 
 func (this *MsgCreateValidator) Equal(that interface{}) bool {
@@ -1525,14 +1796,14 @@ func (this *MsgUndelegate) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *HistoricalInfo) Equal(that interface{}) bool {
+func (this *MsgCancelUnbondingDelegation) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*HistoricalInfo)
+	that1, ok := that.(*MsgCancelUnbondingDelegation)
 	if !ok {
-		that2, ok := that.(HistoricalInfo)
+		that2, ok := that.(MsgCancelUnbondingDelegation)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1544,27 +1815,28 @@ func (this *HistoricalInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Header.Equal(&that1.Header) {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if len(this.Valset) != len(that1.Valset) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	for i := range this.Valset {
-		if !this.Valset[i].Equal(&that1.Valset[i]) {
-			return false
-		}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
 	}
 	return true
 }
-func (this *CommissionRates) Equal(that interface{}) bool {
+func (this *MsgTokenizeShares) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CommissionRates)
+	that1, ok := that.(*MsgTokenizeShares)
 	if !ok {
-		that2, ok := that.(CommissionRates)
+		that2, ok := that.(MsgTokenizeShares)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1576,25 +1848,28 @@ func (this *CommissionRates) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.Rate.Equal(that1.Rate) {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !this.MaxRate.Equal(that1.MaxRate) {
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
 		return false
 	}
-	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	if !bytes.Equal(this.TokenizedShareOwner, that1.TokenizedShareOwner) {
 		return false
 	}
 	return true
 }
-func (this *Commission) Equal(that interface{}) bool {
+func (this *MsgRedeemTokensForShares) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Commission)
+	that1, ok := that.(*MsgRedeemTokensForShares)
 	if !ok {
-		that2, ok := that.(Commission)
+		that2, ok := that.(MsgRedeemTokensForShares)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1606,22 +1881,22 @@ func (this *Commission) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+	if !bytes.Equal(this.DelegatorAddress, that1.DelegatorAddress) {
 		return false
 	}
-	if !this.UpdateTime.Equal(that1.UpdateTime) {
+	if !this.Amount.Equal(&that1.Amount) {
 		return false
 	}
 	return true
 }
-func (this *Description) Equal(that interface{}) bool {
+func (this *HistoricalInfo) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Description)
+	that1, ok := that.(*HistoricalInfo)
 	if !ok {
-		that2, ok := that.(Description)
+		that2, ok := that.(HistoricalInfo)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1633,31 +1908,120 @@ func (this *Description) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Moniker != that1.Moniker {
-		return false
-	}
-	if this.Identity != that1.Identity {
-		return false
-	}
-	if this.Website != that1.Website {
+	if !this.Header.Equal(&that1.Header) {
 		return false
 	}
-	if this.SecurityContact != that1.SecurityContact {
+	if len(this.Valset) != len(that1.Valset) {
 		return false
 	}
-	if this.Details != that1.Details {
-		return false
+	for i := range this.Valset {
+		if !this.Valset[i].Equal(&that1.Valset[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *Validator) Equal(that interface{}) bool {
+func (this *CommissionRates) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Validator)
+	that1, ok := that.(*CommissionRates)
 	if !ok {
-		that2, ok := that.(Validator)
+		that2, ok := that.(CommissionRates)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MaxRate.Equal(that1.MaxRate) {
+		return false
+	}
+	if !this.MaxChangeRate.Equal(that1.MaxChangeRate) {
+		return false
+	}
+	return true
+}
+func (this *Commission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Commission)
+	if !ok {
+		that2, ok := that.(Commission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommissionRates.Equal(&that1.CommissionRates) {
+		return false
+	}
+	if !this.UpdateTime.Equal(that1.UpdateTime) {
+		return false
+	}
+	return true
+}
+func (this *Description) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Description)
+	if !ok {
+		that2, ok := that.(Description)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Moniker != that1.Moniker {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Website != that1.Website {
+		return false
+	}
+	if this.SecurityContact != that1.SecurityContact {
+		return false
+	}
+	if this.Details != that1.Details {
+		return false
+	}
+	return true
+}
+func (this *Validator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Validator)
+	if !ok {
+		that2, ok := that.(Validator)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1930,6 +2294,36 @@ func (this *Redelegation) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if !bytes.Equal(this.ValidatorAddress, that1.ValidatorAddress) {
+		return false
+	}
+	if !bytes.Equal(this.Owner, that1.Owner) {
+		return false
+	}
+	return true
+}
 func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2262,6 +2656,152 @@ func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelUnbondingDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUnbondingDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreationHeight != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTokenizeShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTokenizeShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTokenizeShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenizedShareOwner) > 0 {
+		i -= len(m.TokenizedShareOwner)
+		copy(dAtA[i:], m.TokenizedShareOwner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.TokenizedShareOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemTokensForShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemTokensForShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemTokensForShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2382,12 +2922,12 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
-	if err9 != nil {
-		return 0, err9
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdateTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -2501,12 +3041,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintStaking(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2869,12 +3409,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err14 != nil {
-		return 0, err14
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n14
-	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i -= n17
+	i = encodeVarintStaking(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2925,12 +3465,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err15 != nil {
-		return 0, err15
+	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n15
-	i = encodeVarintStaking(dAtA, i, uint64(n15))
+	i -= n18
+	i = encodeVarintStaking(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2999,6 +3539,48 @@ func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3041,12 +3623,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err16 != nil {
-		return 0, err16
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n16
-	i = encodeVarintStaking(dAtA, i, uint64(n16))
+	i -= n19
+	i = encodeVarintStaking(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3176,6 +3758,66 @@ func (m *MsgUndelegate) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelUnbondingDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovStaking(uint64(m.CreationHeight))
+	}
+	return n
+}
+
+func (m *MsgTokenizeShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = len(m.TokenizedShareOwner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedeemTokensForShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
 func (m *HistoricalInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3461,6 +4103,26 @@ func (m *Redelegation) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovStaking(uint64(m.ID))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3892,28 +4554,527 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CommissionRate = &v
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinSelfDelegation = &v
+			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorSrcAddress == nil {
+				m.ValidatorSrcAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorDstAddress == nil {
+				m.ValidatorDstAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = append(m.DelegatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.DelegatorAddress == nil {
+				m.DelegatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CommissionRate = &v
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -3923,25 +5084,22 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.MinSelfDelegation = &v
-			if err := m.MinSelfDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3969,7 +5127,7 @@ func (m *MsgEditValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelUnbondingDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3992,10 +5150,10 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelUnbondingDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4099,6 +5257,25 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -4123,7 +5300,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgTokenizeShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4146,10 +5323,10 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTokenizeShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTokenizeShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4188,7 +5365,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4215,16 +5392,16 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = append(m.ValidatorSrcAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorSrcAddress == nil {
-				m.ValidatorSrcAddress = []byte{}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4234,31 +5411,30 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = append(m.ValidatorDstAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorDstAddress == nil {
-				m.ValidatorDstAddress = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizedShareOwner", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4268,23 +5444,24 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TokenizedShareOwner = append(m.TokenizedShareOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenizedShareOwner == nil {
+				m.TokenizedShareOwner = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4311,7 +5488,7 @@ func (m *MsgBeginRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemTokensForShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4334,10 +5511,10 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemTokensForShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4375,40 +5552,6 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValidatorAddress == nil {
-				m.ValidatorAddress = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -6745,6 +7888,146 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = append(m.ValidatorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValidatorAddress == nil {
+				m.ValidatorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, valAddr sdk.ValAddress, owner sdk.AccAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		ID:               id,
+		ValidatorAddress: valAddr,
+		Owner:            owner,
+	}
+}
+
+// GetModuleAccountName returns the name of the module account that holds the
+// delegation backing the record.
+func (r TokenizeShareRecord) GetModuleAccountName() string {
+	return fmt.Sprintf("%s_%d", TokenizeShareModuleAccountPrefix, r.ID)
+}
+
+// GetModuleAddress returns the address of the module account that holds the
+// delegation backing the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.GetModuleAccountName())
+}
+
+// GetShareTokenDenom returns the denomination of the tokens representing the
+// shares of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.ValidatorAddress.String()), r.ID)
+}
+
+// String implements the Stringer interface for a TokenizeShareRecord object.
+func (r TokenizeShareRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// ParseShareTokenDenom returns the record id encoded in a share token denomination.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return 0, ErrNotTokenizeShareDenom
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, ErrNotTokenizeShareDenom
+	}
+
+	return id, nil
+}
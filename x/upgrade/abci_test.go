@@ -427,3 +427,38 @@ func TestDumpUpgradeInfoToFile(t *testing.T) {
 	err = os.Remove(upgradeInfoFilePath)
 	require.Nil(t, err)
 }
+
+func TestUpgradedClientState(t *testing.T) {
+	s := setupTest(10, map[int64]bool{})
+	upgradedClient := []byte("upgraded client state")
+
+	t.Log("Verify an upgraded client state requires a height based plan")
+	err := s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Time: time.Now().Add(time.Hour), UpgradedClientState: upgradedClient}})
+	require.NotNil(t, err)
+	require.True(t, errors.Is(sdkerrors.ErrInvalidRequest, err), err)
+
+	t.Log("Verify the upgraded client state is committed at the plan height")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: 15, UpgradedClientState: upgradedClient}})
+	require.Nil(t, err)
+
+	bz, found := s.keeper.GetUpgradedClient(s.ctx, 15)
+	require.True(t, found)
+	require.Equal(t, upgradedClient, bz)
+
+	t.Log("Verify overwriting the plan removes the previous upgraded client state")
+	err = s.handler(s.ctx, &types.SoftwareUpgradeProposal{Title: "prop", Plan: types.Plan{Name: "test", Height: 20, UpgradedClientState: upgradedClient}})
+	require.Nil(t, err)
+
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 15)
+	require.False(t, found)
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 20)
+	require.True(t, found)
+
+	t.Log("Verify cancelling the plan removes the upgraded client state")
+	err = s.handler(s.ctx, &types.CancelSoftwareUpgradeProposal{Title: "cancel"})
+	require.Nil(t, err)
+
+	_, found = s.keeper.GetUpgradedClient(s.ctx, 20)
+	require.False(t, found)
+	VerifyCleared(t, s.ctx)
+}
@@ -109,6 +109,15 @@ specified here https://github.com/regen-network/cosmosd/blob/master/README.md#au
 This will allow a properly configured cosmsod daemon to auto-download new binaries and auto-upgrade.
 As noted there, this is intended more for full nodes than validators.
 
+Upgrading IBC Clients
+
+An upgrade that changes the chain-id or the unbonding period of a chain breaks the IBC light clients that
+counterparty chains run of it. A height based Plan can carry the amino encoded client state that those
+clients should be upgraded to in Plan.UpgradedClientState. The upgrade module commits it under
+UpgradedClientKey(plan.Height) until the plan is cleared or applied, so that relayers can submit a Merkle
+proof of it to the counterparty chains, which verify it against their last trusted consensus state of this
+chain and upgrade the client in place.
+
 Cancelling Upgrades
 
 There are two ways to cancel a planned upgrade - with on-chain governance or off-chain social consensus.
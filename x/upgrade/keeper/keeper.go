@@ -70,13 +70,46 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	// clear the previously scheduled plan along with its upgraded client state
+	k.ClearUpgradePlan(ctx)
+
 	bz := k.cdc.MustMarshalBinaryBare(&plan)
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.PlanKey(), bz)
 
+	if len(plan.UpgradedClientState) != 0 {
+		k.SetUpgradedClient(ctx, plan.Height, plan.UpgradedClientState)
+	}
+
 	return nil
 }
 
+// SetUpgradedClient commits the encoded upgraded client state under the
+// UpgradedClientKey of the given plan height.
+func (k Keeper) SetUpgradedClient(ctx sdk.Context, planHeight int64, bz []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpgradedClientKey(planHeight), bz)
+}
+
+// GetUpgradedClient returns the encoded upgraded client state committed for the
+// given plan height, if any.
+func (k Keeper) GetUpgradedClient(ctx sdk.Context, planHeight int64) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradedClientKey(planHeight))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return bz, true
+}
+
+// ClearUpgradedClient removes the upgraded client state committed for the given
+// plan height.
+func (k Keeper) ClearUpgradedClient(ctx sdk.Context, planHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradedClientKey(planHeight))
+}
+
 // GetDoneHeight returns the height at which the given upgrade was executed
 func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte{types.DoneByte})
@@ -88,8 +121,17 @@ func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
 	return int64(binary.BigEndian.Uint64(bz))
 }
 
-// ClearUpgradePlan clears any schedule upgrade
+// ClearUpgradePlan clears any schedule upgrade along with its upgraded client state
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if len(plan.UpgradedClientState) != 0 {
+		k.ClearUpgradedClient(ctx, plan.Height)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
 }
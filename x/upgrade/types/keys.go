@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 const (
 	// ModuleName is the name of this module
 	ModuleName = "upgrade"
@@ -19,6 +21,10 @@ const (
 	PlanByte = 0x0
 	// DoneByte is a prefix for to look up completed upgrade plan by name
 	DoneByte = 0x1
+
+	// KeyUpgradedClient is the key prefix under which the upgraded client state of
+	// a plan is committed
+	KeyUpgradedClient = "upgradedClient"
 )
 
 // PlanKey is the key under which the current plan is saved
@@ -26,3 +32,10 @@ const (
 func PlanKey() []byte {
 	return []byte{PlanByte}
 }
+
+// UpgradedClientKey is the key under which the upgraded client state of a plan
+// with the given height is committed, i.e 'upgradedClient/{height}'. Counterparty
+// chains prove the client state stored under this key to upgrade their IBC clients.
+func UpgradedClientKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s/%d", KeyUpgradedClient, height))
+}
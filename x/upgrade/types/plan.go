@@ -32,6 +32,9 @@ func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() && p.Height != 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot set both time and height")
 	}
+	if len(p.UpgradedClientState) != 0 && p.Height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "an upgraded client state requires a height based plan")
+	}
 
 	return nil
 }
@@ -90,6 +90,21 @@ func TestPlanValid(t *testing.T) {
 				Info: "important",
 			},
 		},
+		"upgraded client state by height": {
+			p: Plan{
+				Name:                "all-good",
+				Height:              123450000,
+				UpgradedClientState: []byte("client state"),
+			},
+			valid: true,
+		},
+		"upgraded client state by time": {
+			p: Plan{
+				Name:                "by-time",
+				Time:                mustParseTime("2019-07-08T11:33:55Z"),
+				UpgradedClientState: []byte("client state"),
+			},
+		},
 		"negative height": {
 			p: Plan{
 				Name:   "minus",
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -44,6 +45,10 @@ type Plan struct {
 	// Any application specific upgrade info to be included on-chain
 	// such as a git commit that validators could automatically upgrade to
 	Info string `protobuf:"bytes,4,opt,name=info,proto3" json:"info,omitempty"`
+	// UpgradedClientState is the amino encoded IBC client state that counterparty
+	// chains should upgrade their light clients of this chain to. It is committed
+	// under the UpgradedClientKey of the plan height and requires a height based plan.
+	UpgradedClientState []byte `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"`
 }
 
 func (m *Plan) Reset()      { *m = Plan{} }
@@ -164,30 +169,32 @@ func init() {
 func init() { proto.RegisterFile("cosmos/upgrade/upgrade.proto", fileDescriptor_f096ad3e7ee0b803) }
 
 var fileDescriptor_f096ad3e7ee0b803 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x4e, 0xf3, 0x30,
-	0x10, 0x8e, 0xff, 0xe6, 0xaf, 0xfe, 0xba, 0xd2, 0x3f, 0x58, 0x15, 0x44, 0x15, 0x38, 0x51, 0xa7,
-	0x0e, 0xe0, 0x48, 0x65, 0x41, 0x8c, 0x65, 0x47, 0x55, 0x80, 0x05, 0x89, 0xc1, 0x4d, 0xdd, 0x34,
-	0x22, 0xc9, 0x45, 0xb1, 0x2b, 0xe0, 0x05, 0x60, 0xed, 0x23, 0xf0, 0x38, 0x1d, 0x3b, 0x76, 0x02,
-	0xda, 0x2e, 0x3c, 0x06, 0x8a, 0x9d, 0x20, 0xd8, 0x99, 0xee, 0xbe, 0xbb, 0x2f, 0xdf, 0x7d, 0x17,
-	0x1f, 0x3e, 0x08, 0x41, 0xa6, 0x20, 0xfd, 0x79, 0x1e, 0x15, 0x7c, 0x22, 0xea, 0xc8, 0xf2, 0x02,
-	0x14, 0x90, 0xff, 0xa6, 0xcb, 0xaa, 0x6a, 0xb7, 0x13, 0x41, 0x04, 0xba, 0xe5, 0x97, 0x99, 0x61,
-	0x75, 0xdd, 0x08, 0x20, 0x4a, 0x84, 0xaf, 0xd1, 0x78, 0x3e, 0xf5, 0x55, 0x9c, 0x0a, 0xa9, 0x78,
-	0x9a, 0x1b, 0x42, 0xef, 0x09, 0x61, 0x7b, 0x94, 0xf0, 0x8c, 0x10, 0x6c, 0x67, 0x3c, 0x15, 0x0e,
-	0xf2, 0x50, 0xbf, 0x15, 0xe8, 0x9c, 0x9c, 0x62, 0xbb, 0xe4, 0x3b, 0x7f, 0x3c, 0xd4, 0x6f, 0x0f,
-	0xba, 0xcc, 0x88, 0xb1, 0x5a, 0x8c, 0x5d, 0xd5, 0x62, 0xc3, 0x7f, 0xcb, 0x57, 0xd7, 0x5a, 0xbc,
-	0xb9, 0x28, 0xd0, 0x5f, 0x90, 0x3d, 0xdc, 0x9c, 0x89, 0x38, 0x9a, 0x29, 0xa7, 0xe1, 0xa1, 0x7e,
-	0x23, 0xa8, 0x50, 0x39, 0x25, 0xce, 0xa6, 0xe0, 0xd8, 0x66, 0x4a, 0x99, 0x9f, 0xd9, 0x1f, 0x2f,
-	0x2e, 0xea, 0x3d, 0x23, 0xbc, 0x7f, 0x09, 0x53, 0x75, 0xcf, 0x0b, 0x71, 0x6d, 0x76, 0x1a, 0x15,
-	0x90, 0x83, 0xe4, 0x09, 0xe9, 0xe0, 0xbf, 0x2a, 0x56, 0x49, 0x6d, 0xce, 0x00, 0xe2, 0xe1, 0xf6,
-	0x44, 0xc8, 0xb0, 0x88, 0x73, 0x15, 0x43, 0xa6, 0x4d, 0xb6, 0x82, 0xef, 0x25, 0xc2, 0xb0, 0x9d,
-	0x27, 0x3c, 0xd3, 0x1e, 0xda, 0x83, 0x0e, 0xfb, 0xf9, 0xcb, 0x58, 0xb9, 0xf7, 0xd0, 0x2e, 0x9d,
-	0x07, 0x9a, 0x57, 0x39, 0xb9, 0xc5, 0x87, 0xe7, 0x3c, 0x0b, 0x45, 0xf2, 0xcb, 0x76, 0x8c, 0xfc,
-	0xf0, 0x62, 0xb9, 0xa1, 0xd6, 0x7a, 0x43, 0xad, 0xe5, 0x96, 0xa2, 0xd5, 0x96, 0xa2, 0xf7, 0x2d,
-	0x45, 0x8b, 0x1d, 0xb5, 0x56, 0x3b, 0x6a, 0xad, 0x77, 0xd4, 0xba, 0x39, 0x8a, 0x62, 0x35, 0x9b,
-	0x8f, 0x59, 0x08, 0xa9, 0x5f, 0xdd, 0x80, 0x09, 0xc7, 0x72, 0x72, 0xe7, 0x3f, 0x7c, 0x1d, 0x84,
-	0x7a, 0xcc, 0x85, 0x1c, 0x37, 0xf5, 0x73, 0x9c, 0x7c, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x41,
-	0xa7, 0xa3, 0x2f, 0x02, 0x00, 0x00,
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x15, 0x6b, 0xd9, 0xa8, 0xe9, 0xa2, 0x03, 0xeb, 0xb6, 0x82, 0xd1, 0x52, 0x82, 0x27, 0x0d,
+	0x2d, 0x05, 0xb8, 0x4b, 0xd1, 0xd1, 0xde, 0x0b, 0x43, 0x6e, 0x97, 0x02, 0x85, 0x41, 0x4b, 0xb4,
+	0x4c, 0x44, 0x12, 0x05, 0x91, 0x46, 0x92, 0x2f, 0xc8, 0xea, 0x4f, 0xc8, 0x77, 0xe4, 0x0b, 0x3c,
+	0x7a, 0xf4, 0x94, 0xc4, 0xf6, 0x92, 0xcf, 0x08, 0x44, 0x4a, 0x41, 0xb2, 0x67, 0xe2, 0xdd, 0xbd,
+	0xa7, 0x77, 0xf7, 0x4e, 0x07, 0xbf, 0x44, 0x42, 0x66, 0x42, 0x06, 0xeb, 0x22, 0x29, 0x69, 0xcc,
+	0x9a, 0x97, 0x14, 0xa5, 0x50, 0x02, 0xbd, 0x37, 0x28, 0xa9, 0xab, 0x83, 0x7e, 0x22, 0x12, 0xa1,
+	0xa1, 0xa0, 0x8a, 0x0c, 0x6b, 0xe0, 0x26, 0x42, 0x24, 0x29, 0x0b, 0x74, 0xb6, 0x58, 0x2f, 0x03,
+	0xc5, 0x33, 0x26, 0x15, 0xcd, 0x0a, 0x43, 0x18, 0xde, 0x00, 0x68, 0x4f, 0x53, 0x9a, 0x23, 0x04,
+	0xed, 0x9c, 0x66, 0xcc, 0x01, 0x1e, 0xf0, 0xbb, 0xa1, 0x8e, 0xd1, 0x4f, 0x68, 0x57, 0x7c, 0xe7,
+	0x8d, 0x07, 0xfc, 0xde, 0x68, 0x40, 0x8c, 0x18, 0x69, 0xc4, 0xc8, 0x9f, 0x46, 0x6c, 0xfc, 0x76,
+	0x7b, 0xeb, 0x5a, 0x9b, 0x3b, 0x17, 0x84, 0xfa, 0x0b, 0xf4, 0x09, 0x76, 0x56, 0x8c, 0x27, 0x2b,
+	0xe5, 0xb4, 0x3c, 0xe0, 0xb7, 0xc2, 0x3a, 0xab, 0xba, 0xf0, 0x7c, 0x29, 0x1c, 0xdb, 0x74, 0xa9,
+	0x62, 0x34, 0x82, 0x1f, 0x6b, 0x13, 0xf1, 0x3c, 0x4a, 0x39, 0xcb, 0xd5, 0x5c, 0x2a, 0xaa, 0x98,
+	0xd3, 0xf6, 0x80, 0xff, 0x2e, 0xfc, 0xd0, 0x80, 0x13, 0x8d, 0xcd, 0x2a, 0xe8, 0x97, 0xfd, 0x70,
+	0xed, 0x82, 0xe1, 0x15, 0x80, 0x9f, 0x67, 0x62, 0xa9, 0xce, 0x69, 0xc9, 0xfe, 0x1a, 0xd6, 0xb4,
+	0x14, 0x85, 0x90, 0x34, 0x45, 0x7d, 0xd8, 0x56, 0x5c, 0xa5, 0x8d, 0x21, 0x93, 0x20, 0x0f, 0xf6,
+	0x62, 0x26, 0xa3, 0x92, 0x17, 0x8a, 0x8b, 0x5c, 0x1b, 0xeb, 0x86, 0xcf, 0x4b, 0x88, 0x40, 0xbb,
+	0x48, 0x69, 0xae, 0xe7, 0xee, 0x8d, 0xfa, 0xe4, 0xe5, 0x9a, 0x49, 0xb5, 0xab, 0xb1, 0x5d, 0xb9,
+	0x0d, 0x35, 0xaf, 0x9e, 0xe4, 0x3f, 0xfc, 0x3a, 0xa1, 0x79, 0xc4, 0xd2, 0x57, 0x1e, 0xc7, 0xc8,
+	0x8f, 0x7f, 0x6f, 0x0f, 0xd8, 0xda, 0x1f, 0xb0, 0xb5, 0x3d, 0x62, 0xb0, 0x3b, 0x62, 0x70, 0x7f,
+	0xc4, 0x60, 0x73, 0xc2, 0xd6, 0xee, 0x84, 0xad, 0xfd, 0x09, 0x5b, 0xff, 0xbe, 0x25, 0x5c, 0xad,
+	0xd6, 0x0b, 0x12, 0x89, 0x2c, 0xa8, 0xef, 0xc6, 0x3c, 0xdf, 0x65, 0x7c, 0x16, 0x5c, 0x3c, 0x1d,
+	0x91, 0xba, 0x2c, 0x98, 0x5c, 0x74, 0xf4, 0x2f, 0xfc, 0xf1, 0x38, 0x00, 0x25, 0xcd, 0xd8, 0xe9,
+	0x63, 0x02, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -221,6 +228,9 @@ func (this *Plan) Equal(that interface{}) bool {
 	if this.Info != that1.Info {
 		return false
 	}
+	if !bytes.Equal(this.UpgradedClientState, that1.UpgradedClientState) {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -300,6 +310,13 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpgradedClientState) > 0 {
+		i -= len(m.UpgradedClientState)
+		copy(dAtA[i:], m.UpgradedClientState)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.UpgradedClientState)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Info) > 0 {
 		i -= len(m.Info)
 		copy(dAtA[i:], m.Info)
@@ -444,6 +461,10 @@ func (m *Plan) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	l = len(m.UpgradedClientState)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
@@ -634,6 +655,40 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			}
 			m.Info = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradedClientState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpgradedClientState = append(m.UpgradedClientState[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpgradedClientState == nil {
+				m.UpgradedClientState = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])